@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/donaldnash/go-competitor/analytics/pb"
+	"github.com/donaldnash/go-competitor/common/db"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -93,11 +94,31 @@ func (c *AnalyticsClient) CreateRecommendation(ctx context.Context, tenantID, re
 	return c.client.CreateRecommendation(ctx, req)
 }
 
-// GetRecommendations returns recommendations filtered by status
+// GetRecommendations returns all recommendations filtered by status, reading every page into one response
 func (c *AnalyticsClient) GetRecommendations(ctx context.Context, tenantID, status string) (*pb.RecommendationsResponse, error) {
+	all := &pb.RecommendationsResponse{}
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		resp, err := c.GetRecommendationsPage(ctx, tenantID, status, page)
+		if err != nil {
+			return nil, err
+		}
+		all.Recommendations = append(all.Recommendations, resp.Recommendations...)
+		all.TotalCount = resp.TotalCount
+		if resp.NextPageToken == "" {
+			return all, nil
+		}
+		page.Token = resp.NextPageToken
+	}
+}
+
+// GetRecommendationsPage returns a page of recommendations filtered by status
+func (c *AnalyticsClient) GetRecommendationsPage(ctx context.Context, tenantID, status string, page db.Page) (*pb.RecommendationsResponse, error) {
 	req := &pb.GetRecommendationsRequest{
-		TenantId: tenantID,
-		Status:   status,
+		TenantId:  tenantID,
+		Status:    status,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	}
 
 	return c.client.GetRecommendations(ctx, req)
@@ -246,6 +267,7 @@ func (c *localAnalyticsClient) GetRecommendations(ctx context.Context, req *pb.G
 				ExpectedImprovement: 0.15,
 			},
 		},
+		TotalCount: 1,
 	}, nil
 }
 
@@ -26,7 +26,7 @@ type AnalyticsRepository interface {
 
 	// Performance tracking
 	SaveRecommendation(ctx context.Context, rec *Recommendation) (*Recommendation, error)
-	GetRecommendations(ctx context.Context, tenantID string, status string) ([]Recommendation, error)
+	GetRecommendations(ctx context.Context, tenantID string, status string, page db.Page) ([]Recommendation, db.PageInfo, error)
 	UpdateRecommendationStatus(ctx context.Context, tenantID, recID, status string) error
 }
 
@@ -147,7 +147,7 @@ func (r *SupabaseAnalyticsRepository) getHistoricalData(ctx context.Context, ten
 		Where("tenant_id", "eq", tenantID).
 		Where("posted_at", "gte", startDate.Format(time.RFC3339)).
 		Where("posted_at", "lte", endDate.Format(time.RFC3339)).
-		Execute(&metrics)
+		Execute(ctx, &metrics)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get historical metrics: %w", err)
@@ -322,7 +322,7 @@ func (r *SupabaseAnalyticsRepository) GetContentFormatRecommendations(ctx contex
 	err := r.client.Query("content_formats").
 		Select("id", "name", "description").
 		Where("tenant_id", "eq", tenantID).
-		Execute(&contentFormats)
+		Execute(ctx, &contentFormats)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get content formats: %w", err)
@@ -338,7 +338,7 @@ func (r *SupabaseAnalyticsRepository) GetContentFormatRecommendations(ctx contex
 	err = r.client.Query("audience_segments").
 		Select("id", "name", "description").
 		Where("tenant_id", "eq", tenantID).
-		Execute(&audienceSegments)
+		Execute(ctx, &audienceSegments)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get audience segments: %w", err)
@@ -425,7 +425,7 @@ func (r *SupabaseAnalyticsRepository) AnalyzeContentPerformance(ctx context.Cont
 		Where("tenant_id", "eq", tenantID).
 		Where("posted_at", "gte", startDate.Format(time.RFC3339)).
 		Where("posted_at", "lte", endDate.Format(time.RFC3339)).
-		Execute(&metrics)
+		Execute(ctx, &metrics)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get metrics for content analysis: %w", err)
@@ -441,7 +441,7 @@ func (r *SupabaseAnalyticsRepository) AnalyzeContentPerformance(ctx context.Cont
 	err = r.client.Query("content_posts").
 		Select("id", "format", "post_id").
 		Where("tenant_id", "eq", tenantID).
-		Execute(&posts)
+		Execute(ctx, &posts)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get content posts: %w", err)
@@ -534,8 +534,8 @@ func (r *SupabaseAnalyticsRepository) SaveRecommendation(ctx context.Context, re
 	return rec, nil
 }
 
-// GetRecommendations retrieves recommendations
-func (r *SupabaseAnalyticsRepository) GetRecommendations(ctx context.Context, tenantID string, status string) ([]Recommendation, error) {
+// GetRecommendations retrieves a page of recommendations, newest first. The zero page retrieves all of them.
+func (r *SupabaseAnalyticsRepository) GetRecommendations(ctx context.Context, tenantID string, status string, page db.Page) ([]Recommendation, db.PageInfo, error) {
 	var recommendations []Recommendation
 
 	query := r.client.Query("recommendations").
@@ -546,13 +546,13 @@ func (r *SupabaseAnalyticsRepository) GetRecommendations(ctx context.Context, te
 		query = query.Where("status", "eq", status)
 	}
 
-	err := query.Order("created_at", true).Execute(&recommendations)
+	info, err := query.Order("created_at", true).ExecutePage(ctx, page, &recommendations)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get recommendations: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get recommendations: %w", err)
 	}
 
-	return recommendations, nil
+	return recommendations, info, nil
 }
 
 // UpdateRecommendationStatus updates a recommendation's status
@@ -563,14 +563,14 @@ func (r *SupabaseAnalyticsRepository) UpdateRecommendationStatus(ctx context.Con
 		Select("*").
 		Where("id", "eq", recID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&recs)
+		Execute(ctx, &recs)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify recommendation: %w", err)
 	}
 
 	if len(recs) == 0 {
-		return errors.New("recommendation not found")
+		return fmt.Errorf("recommendation %w", db.ErrNotFound)
 	}
 
 	// Update the status
@@ -579,7 +579,7 @@ func (r *SupabaseAnalyticsRepository) UpdateRecommendationStatus(ctx context.Con
 		"updated_at": time.Now(),
 	}
 
-	err = r.client.Update(ctx, "recommendations", "id", recID, updateData)
+	_, err = r.client.Update(ctx, db.TenantScope(tenantID), "recommendations", "id", recID, updateData)
 	if err != nil {
 		return fmt.Errorf("failed to update recommendation status: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/donaldnash/go-competitor/analytics/pb"
 	"github.com/donaldnash/go-competitor/analytics/service"
+	"github.com/donaldnash/go-competitor/common/db"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -37,7 +38,7 @@ func (s *AnalyticsServer) GetPostingTimeRecommendations(ctx context.Context, req
 	// Call service layer
 	recommendations, err := s.service.GetPostingTimeRecommendations(ctx, req.TenantId, req.DayOfWeek)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get posting time recommendations: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get posting time recommendations: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -68,7 +69,7 @@ func (s *AnalyticsServer) GetContentFormatRecommendations(ctx context.Context, r
 	// Call service layer
 	recommendations, err := s.service.GetContentFormatRecommendations(ctx, req.TenantId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get content format recommendations: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get content format recommendations: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -110,7 +111,7 @@ func (s *AnalyticsServer) PredictEngagement(ctx context.Context, req *pb.Predict
 	// Call service layer
 	prediction, err := s.service.PredictEngagement(ctx, req.TenantId, postTime, req.ContentFormat)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to predict engagement: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to predict engagement: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -145,7 +146,7 @@ func (s *AnalyticsServer) AnalyzeContentPerformance(ctx context.Context, req *pb
 	// Call service layer
 	performances, err := s.service.AnalyzeContentPerformance(ctx, req.TenantId, startDate, endDate)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to analyze content performance: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to analyze content performance: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -185,7 +186,7 @@ func (s *AnalyticsServer) CreateRecommendation(ctx context.Context, req *pb.Crea
 	// Call service layer
 	rec, err := s.service.CreateRecommendation(ctx, req.TenantId, req.Type, req.Title, req.Description, req.ExpectedImprovement)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create recommendation: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to create recommendation: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -202,16 +203,16 @@ func (s *AnalyticsServer) CreateRecommendation(ctx context.Context, req *pb.Crea
 	}, nil
 }
 
-// GetRecommendations returns recommendations filtered by status
+// GetRecommendations returns a page of recommendations filtered by status
 func (s *AnalyticsServer) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.RecommendationsResponse, error) {
 	if req.TenantId == "" {
 		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
 	}
 
 	// Call service layer
-	recommendations, err := s.service.GetRecommendations(ctx, req.TenantId, req.Status)
+	recommendations, info, err := s.service.GetRecommendations(ctx, req.TenantId, req.Status, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get recommendations: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get recommendations: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -232,6 +233,8 @@ func (s *AnalyticsServer) GetRecommendations(ctx context.Context, req *pb.GetRec
 
 	return &pb.RecommendationsResponse{
 		Recommendations: protoRecs,
+		NextPageToken:   info.NextToken,
+		TotalCount:      int32(info.TotalCount),
 	}, nil
 }
 
@@ -252,7 +255,7 @@ func (s *AnalyticsServer) UpdateRecommendationStatus(ctx context.Context, req *p
 	// Call service layer
 	err := s.service.UpdateRecommendationStatus(ctx, req.TenantId, req.RecommendationId, req.Status)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update recommendation status: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to update recommendation status: %v", err)
 	}
 
 	return &pb.UpdateRecommendationStatusResponse{
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/donaldnash/go-competitor/analytics/repository"
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/google/uuid"
 )
 
@@ -23,7 +24,7 @@ type AnalyticsService interface {
 
 	// Recommendation management
 	CreateRecommendation(ctx context.Context, tenantID, recType, title, description string, expectedImprovement float64) (*repository.Recommendation, error)
-	GetRecommendations(ctx context.Context, tenantID string, status string) ([]repository.Recommendation, error)
+	GetRecommendations(ctx context.Context, tenantID string, status string, page db.Page) ([]repository.Recommendation, db.PageInfo, error)
 	UpdateRecommendationStatus(ctx context.Context, tenantID, recID, status string) error
 }
 
@@ -129,14 +130,14 @@ func (s *analyticsService) CreateRecommendation(ctx context.Context, tenantID, r
 	return s.repo.SaveRecommendation(ctx, rec)
 }
 
-// GetRecommendations returns recommendations filtered by status
-func (s *analyticsService) GetRecommendations(ctx context.Context, tenantID string, status string) ([]repository.Recommendation, error) {
+// GetRecommendations returns a page of recommendations filtered by status
+func (s *analyticsService) GetRecommendations(ctx context.Context, tenantID string, status string, page db.Page) ([]repository.Recommendation, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
 	// Call repository to get recommendations
-	return s.repo.GetRecommendations(ctx, tenantID, status)
+	return s.repo.GetRecommendations(ctx, tenantID, status, page)
 }
 
 // UpdateRecommendationStatus updates the status of a recommendation
@@ -7,6 +7,7 @@ import (
 
 	"github.com/donaldnash/go-competitor/audience/pb"
 	"github.com/donaldnash/go-competitor/audience/repository"
+	"github.com/donaldnash/go-competitor/common/db"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -15,6 +16,7 @@ import (
 type AudienceClient interface {
 	// Segment management
 	GetSegments(ctx context.Context, tenantID string) ([]repository.AudienceSegment, error)
+	GetSegmentsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.AudienceSegment, db.PageInfo, error)
 	GetSegment(ctx context.Context, tenantID, segmentID string) (*repository.AudienceSegment, error)
 	CreateSegment(ctx context.Context, tenantID, name, description, segmentType string) (*repository.AudienceSegment, error)
 	UpdateSegment(ctx context.Context, tenantID, segmentID, name, description, segmentType string) (*repository.AudienceSegment, error)
@@ -22,6 +24,7 @@ type AudienceClient interface {
 
 	// Segment metrics
 	GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]repository.SegmentMetric, error)
+	GetSegmentMetricsPage(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time, page db.Page) ([]repository.SegmentMetric, db.PageInfo, error)
 	UpdateSegmentMetrics(ctx context.Context, tenantID, segmentID string, metrics []repository.SegmentMetric) (int, error)
 
 	// Close the client connection
@@ -56,13 +59,32 @@ func (c *GRPCAudienceClient) Close() error {
 	return nil
 }
 
-// GetSegments retrieves all audience segments for the current tenant
+// GetSegments retrieves all audience segments for the current tenant, reading every page
 func (c *GRPCAudienceClient) GetSegments(ctx context.Context, tenantID string) ([]repository.AudienceSegment, error) {
+	var segments []repository.AudienceSegment
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetSegmentsPage(ctx, tenantID, page)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, batch...)
+		if info.NextToken == "" {
+			return segments, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetSegmentsPage retrieves a page of the audience segments for the current tenant
+func (c *GRPCAudienceClient) GetSegmentsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.AudienceSegment, db.PageInfo, error) {
 	resp, err := c.client.GetSegments(ctx, &pb.GetSegmentsRequest{
-		TenantId: tenantID,
+		TenantId:  tenantID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get segments: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get segments: %w", err)
 	}
 
 	segments := make([]repository.AudienceSegment, len(resp.Segments))
@@ -70,7 +92,7 @@ func (c *GRPCAudienceClient) GetSegments(ctx context.Context, tenantID string) (
 		segments[i] = convertFromPbSegment(s)
 	}
 
-	return segments, nil
+	return segments, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // GetSegment retrieves a specific audience segment
@@ -133,16 +155,37 @@ func (c *GRPCAudienceClient) DeleteSegment(ctx context.Context, tenantID, segmen
 	return nil
 }
 
-// GetSegmentMetrics retrieves metrics for a specific audience segment
+// GetSegmentMetrics retrieves all metrics for a specific audience segment, reading every page
 func (c *GRPCAudienceClient) GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]repository.SegmentMetric, error) {
+	var metrics []repository.SegmentMetric
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetSegmentMetricsPage(ctx, tenantID, segmentID, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, batch...)
+		if info.NextToken == "" {
+			return metrics, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetSegmentMetricsPage retrieves a page of the metrics for a specific audience segment
+func (c *GRPCAudienceClient) GetSegmentMetricsPage(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.SegmentMetric, db.PageInfo, error) {
+
 	resp, err := c.client.GetSegmentMetrics(ctx, &pb.GetSegmentMetricsRequest{
 		TenantId:  tenantID,
 		SegmentId: segmentID,
 		StartDate: startDate.Format(time.RFC3339),
 		EndDate:   endDate.Format(time.RFC3339),
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get segment metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get segment metrics: %w", err)
 	}
 
 	metrics := make([]repository.SegmentMetric, len(resp.Metrics))
@@ -150,7 +193,7 @@ func (c *GRPCAudienceClient) GetSegmentMetrics(ctx context.Context, tenantID, se
 		metrics[i] = convertFromPbMetric(m)
 	}
 
-	return metrics, nil
+	return metrics, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // UpdateSegmentMetrics updates metrics for a specific audience segment
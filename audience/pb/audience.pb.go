@@ -25,6 +25,8 @@ const (
 type GetSegmentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,10 +68,26 @@ func (x *GetSegmentsRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetSegmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSegmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // Response for getting all audience segments
 type GetSegmentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Segments      []*AudienceSegment     `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -111,6 +129,20 @@ func (x *GetSegmentsResponse) GetSegments() []*AudienceSegment {
 	return nil
 }
 
+func (x *GetSegmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSegmentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Request for getting a specific audience segment
 type GetSegmentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -550,6 +582,8 @@ type GetSegmentMetricsRequest struct {
 	SegmentId     string                 `protobuf:"bytes,2,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
 	StartDate     string                 `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // RFC3339 format
 	EndDate       string                 `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // RFC3339 format
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -612,10 +646,26 @@ func (x *GetSegmentMetricsRequest) GetEndDate() string {
 	return ""
 }
 
+func (x *GetSegmentMetricsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetSegmentMetricsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // Response for getting metrics for a specific audience segment
 type GetSegmentMetricsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metrics       []*SegmentMetric       `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -657,6 +707,20 @@ func (x *GetSegmentMetricsResponse) GetMetrics() []*SegmentMetric {
 	return nil
 }
 
+func (x *GetSegmentMetricsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSegmentMetricsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Request for updating metrics for a specific audience segment
 type UpdateSegmentMetricsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -993,11 +1057,17 @@ var File_audience_pb_audience_proto protoreflect.FileDescriptor
 
 const file_audience_pb_audience_proto_rawDesc = "" +
 	"\n" +
-	"\x1aaudience/pb/audience.proto\x12\baudience\"1\n" +
+	"\x1aaudience/pb/audience.proto\x12\baudience\"m\n" +
 	"\x12GetSegmentsRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"L\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x95\x01\n" +
 	"\x13GetSegmentsResponse\x125\n" +
-	"\bsegments\x18\x01 \x03(\v2\x19.audience.AudienceSegmentR\bsegments\"O\n" +
+	"\bsegments\x18\x01 \x03(\v2\x19.audience.AudienceSegmentR\bsegments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"O\n" +
 	"\x11GetSegmentRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
 	"\n" +
@@ -1025,16 +1095,22 @@ const file_audience_pb_audience_proto_rawDesc = "" +
 	"\n" +
 	"segment_id\x18\x02 \x01(\tR\tsegmentId\"1\n" +
 	"\x15DeleteSegmentResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x90\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xcc\x01\n" +
 	"\x18GetSegmentMetricsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
 	"\n" +
 	"segment_id\x18\x02 \x01(\tR\tsegmentId\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x04 \x01(\tR\aendDate\"N\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x97\x01\n" +
 	"\x19GetSegmentMetricsResponse\x121\n" +
-	"\ametrics\x18\x01 \x03(\v2\x17.audience.SegmentMetricR\ametrics\"\x8c\x01\n" +
+	"\ametrics\x18\x01 \x03(\v2\x17.audience.SegmentMetricR\ametrics\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x8c\x01\n" +
 	"\x1bUpdateSegmentMetricsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1d\n" +
 	"\n" +
@@ -13,20 +13,20 @@ import (
 // Common errors
 var (
 	ErrTenantIDRequired = errors.New("tenant ID is required")
-	ErrSegmentNotFound  = errors.New("audience segment not found")
+	ErrSegmentNotFound  = fmt.Errorf("audience segment %w", db.ErrNotFound)
 )
 
 // AudienceRepository defines the interface for audience data access
 type AudienceRepository interface {
 	// Segment management
-	GetSegments(ctx context.Context, tenantID string) ([]AudienceSegment, error)
+	GetSegments(ctx context.Context, tenantID string, page db.Page) ([]AudienceSegment, db.PageInfo, error)
 	GetSegment(ctx context.Context, tenantID, segmentID string) (*AudienceSegment, error)
 	CreateSegment(ctx context.Context, segment *AudienceSegment) (*AudienceSegment, error)
 	UpdateSegment(ctx context.Context, segment *AudienceSegment) (*AudienceSegment, error)
 	DeleteSegment(ctx context.Context, tenantID, segmentID string) error
 
 	// Segment metrics
-	GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]SegmentMetric, error)
+	GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time, page db.Page) ([]SegmentMetric, db.PageInfo, error)
 	UpdateSegmentMetrics(ctx context.Context, tenantID, segmentID string, metrics []SegmentMetric) (int, error)
 }
 
@@ -78,18 +78,18 @@ func NewSupabaseAudienceRepository(tenantID string) (*SupabaseAudienceRepository
 	}, nil
 }
 
-// GetSegments retrieves all audience segments for the current tenant
-func (r *SupabaseAudienceRepository) GetSegments(ctx context.Context, tenantID string) ([]AudienceSegment, error) {
+// GetSegments retrieves a page of the audience segments for the current tenant. The zero page retrieves all of them.
+func (r *SupabaseAudienceRepository) GetSegments(ctx context.Context, tenantID string, page db.Page) ([]AudienceSegment, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, ErrTenantIDRequired
+		return nil, db.PageInfo{}, ErrTenantIDRequired
 	}
 
 	var segments []AudienceSegment
-	err := r.client.Query("audience_segments").Select("*").Execute(&segments)
+	info, err := r.client.Query("audience_segments").Select("*").ExecutePage(ctx, page, &segments)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get audience segments: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get audience segments: %w", err)
 	}
-	return segments, nil
+	return segments, info, nil
 }
 
 // GetSegment retrieves a specific audience segment
@@ -106,7 +106,7 @@ func (r *SupabaseAudienceRepository) GetSegment(ctx context.Context, tenantID, s
 	err := r.client.Query("audience_segments").
 		Select("*").
 		Where("id", "eq", segmentID).
-		Execute(&segments)
+		Execute(ctx, &segments)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get audience segment: %w", err)
@@ -165,7 +165,7 @@ func (r *SupabaseAudienceRepository) UpdateSegment(ctx context.Context, segment
 	segment.CreatedAt = existing.CreatedAt
 	segment.UpdatedAt = time.Now()
 
-	err = r.client.Update(ctx, "audience_segments", "id", segment.ID, segment)
+	_, err = r.client.Update(ctx, db.TenantScope(segment.TenantID), "audience_segments", "id", segment.ID, segment)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update audience segment: %w", err)
 	}
@@ -181,7 +181,7 @@ func (r *SupabaseAudienceRepository) DeleteSegment(ctx context.Context, tenantID
 		return err
 	}
 
-	err = r.client.Delete(ctx, "audience_segments", "id", segmentID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "audience_segments", "id", segmentID)
 	if err != nil {
 		return fmt.Errorf("failed to delete audience segment: %w", err)
 	}
@@ -189,36 +189,39 @@ func (r *SupabaseAudienceRepository) DeleteSegment(ctx context.Context, tenantID
 	return nil
 }
 
-// GetSegmentMetrics retrieves metrics for a specific audience segment within a date range
-func (r *SupabaseAudienceRepository) GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]SegmentMetric, error) {
+// GetSegmentMetrics retrieves a page of the metrics for a specific audience segment within a date range.
+// The zero page retrieves all of them.
+func (r *SupabaseAudienceRepository) GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time,
+	page db.Page) ([]SegmentMetric, db.PageInfo, error) {
+
 	if tenantID == "" {
-		return nil, ErrTenantIDRequired
+		return nil, db.PageInfo{}, ErrTenantIDRequired
 	}
 
 	if segmentID == "" {
-		return nil, errors.New("segment ID is required")
+		return nil, db.PageInfo{}, errors.New("segment ID is required")
 	}
 
 	// First verify the segment exists and belongs to the tenant
 	_, err := r.GetSegment(ctx, tenantID, segmentID)
 	if err != nil {
-		return nil, err
+		return nil, db.PageInfo{}, err
 	}
 
 	var metrics []SegmentMetric
-	err = r.client.Query("segment_metrics").
+	info, err := r.client.Query("segment_metrics").
 		Select("*").
 		Where("segment_id", "eq", segmentID).
 		Where("measurement_date", "gte", startDate.Format(time.RFC3339)).
 		Where("measurement_date", "lte", endDate.Format(time.RFC3339)).
 		Order("measurement_date", false).
-		Execute(&metrics)
+		ExecutePage(ctx, page, &metrics)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get segment metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get segment metrics: %w", err)
 	}
 
-	return metrics, nil
+	return metrics, info, nil
 }
 
 // UpdateSegmentMetrics updates metrics for a specific audience segment
@@ -7,6 +7,7 @@ import (
 	"github.com/donaldnash/go-competitor/audience/pb"
 	"github.com/donaldnash/go-competitor/audience/repository"
 	"github.com/donaldnash/go-competitor/audience/service"
+	"github.com/donaldnash/go-competitor/common/db"
 )
 
 // AudienceServer implements the gRPC audience service
@@ -22,9 +23,9 @@ func NewAudienceServer(service service.AudienceService) *AudienceServer {
 	}
 }
 
-// GetSegments returns all audience segments for a tenant
+// GetSegments returns a page of the audience segments for a tenant
 func (s *AudienceServer) GetSegments(ctx context.Context, req *pb.GetSegmentsRequest) (*pb.GetSegmentsResponse, error) {
-	segments, err := s.service.GetSegments(ctx, req.TenantId)
+	segments, info, err := s.service.GetSegments(ctx, req.TenantId, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +36,9 @@ func (s *AudienceServer) GetSegments(ctx context.Context, req *pb.GetSegmentsReq
 	}
 
 	return &pb.GetSegmentsResponse{
-		Segments: pbSegments,
+		Segments:      pbSegments,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -99,7 +102,8 @@ func (s *AudienceServer) GetSegmentMetrics(ctx context.Context, req *pb.GetSegme
 		return nil, err
 	}
 
-	metrics, err := s.service.GetSegmentMetrics(ctx, req.TenantId, req.SegmentId, startDate, endDate)
+	metrics, info, err := s.service.GetSegmentMetrics(ctx, req.TenantId, req.SegmentId, startDate, endDate,
+		db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +114,9 @@ func (s *AudienceServer) GetSegmentMetrics(ctx context.Context, req *pb.GetSegme
 	}
 
 	return &pb.GetSegmentMetricsResponse{
-		Metrics: pbMetrics,
+		Metrics:       pbMetrics,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -6,19 +6,20 @@ import (
 	"time"
 
 	"github.com/donaldnash/go-competitor/audience/repository"
+	"github.com/donaldnash/go-competitor/common/db"
 )
 
 // AudienceService defines the interface for audience service operations
 type AudienceService interface {
 	// Segment management
-	GetSegments(ctx context.Context, tenantID string) ([]repository.AudienceSegment, error)
+	GetSegments(ctx context.Context, tenantID string, page db.Page) ([]repository.AudienceSegment, db.PageInfo, error)
 	GetSegment(ctx context.Context, tenantID, segmentID string) (*repository.AudienceSegment, error)
 	CreateSegment(ctx context.Context, tenantID, name, description, segmentType string) (*repository.AudienceSegment, error)
 	UpdateSegment(ctx context.Context, tenantID, segmentID, name, description, segmentType string) (*repository.AudienceSegment, error)
 	DeleteSegment(ctx context.Context, tenantID, segmentID string) error
 
 	// Segment metrics
-	GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]repository.SegmentMetric, error)
+	GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time, page db.Page) ([]repository.SegmentMetric, db.PageInfo, error)
 	UpdateSegmentMetrics(ctx context.Context, tenantID, segmentID string, metrics []repository.SegmentMetric) (int, error)
 }
 
@@ -38,13 +39,13 @@ func NewAudienceService(repo repository.AudienceRepository) (AudienceService, er
 	}, nil
 }
 
-// GetSegments retrieves all audience segments for the current tenant
-func (s *audienceService) GetSegments(ctx context.Context, tenantID string) ([]repository.AudienceSegment, error) {
+// GetSegments retrieves a page of the audience segments for the current tenant
+func (s *audienceService) GetSegments(ctx context.Context, tenantID string, page db.Page) ([]repository.AudienceSegment, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetSegments(ctx, tenantID)
+	return s.repo.GetSegments(ctx, tenantID, page)
 }
 
 // GetSegment retrieves details about a specific audience segment
@@ -158,22 +159,24 @@ func (s *audienceService) DeleteSegment(ctx context.Context, tenantID, segmentID
 	return s.repo.DeleteSegment(ctx, tenantID, segmentID)
 }
 
-// GetSegmentMetrics retrieves metrics for a specific audience segment
-func (s *audienceService) GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time) ([]repository.SegmentMetric, error) {
+// GetSegmentMetrics retrieves a page of the metrics for a specific audience segment
+func (s *audienceService) GetSegmentMetrics(ctx context.Context, tenantID, segmentID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.SegmentMetric, db.PageInfo, error) {
+
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
 	if segmentID == "" {
-		return nil, errors.New("segment ID is required")
+		return nil, db.PageInfo{}, errors.New("segment ID is required")
 	}
 
 	// Validate date range
 	if startDate.After(endDate) {
-		return nil, errors.New("start date must be before end date")
+		return nil, db.PageInfo{}, errors.New("start date must be before end date")
 	}
 
-	return s.repo.GetSegmentMetrics(ctx, tenantID, segmentID, startDate, endDate)
+	return s.repo.GetSegmentMetrics(ctx, tenantID, segmentID, startDate, endDate, page)
 }
 
 // UpdateSegmentMetrics updates metrics for a specific audience segment
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/donaldnash/go-competitor/common/db"
@@ -93,7 +94,7 @@ func NewSupabaseAuthRepository() (*SupabaseAuthRepository, error) {
 func (r *SupabaseAuthRepository) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	// In a real implementation, this would query Supabase Auth or the users table
 	// For demonstration purposes, we'll return a placeholder error
-	return nil, errors.New("user not found")
+	return nil, fmt.Errorf("user %w", db.ErrNotFound)
 }
 
 // CreateUser creates a new user
@@ -137,7 +138,7 @@ func (r *SupabaseAuthRepository) GetOrganization(ctx context.Context, orgID stri
 	err := r.client.Query("organizations").
 		Select("*").
 		Where("id", "eq", orgID).
-		Execute(&orgs)
+		Execute(ctx, &orgs)
 
 	// If we have results from the database, return the first one
 	if err == nil && len(orgs) > 0 {
@@ -185,7 +186,7 @@ func (r *SupabaseAuthRepository) GetUser(ctx context.Context, userID string) (*U
 	err := r.client.Query("users").
 		Select("*").
 		Where("id", "eq", userID).
-		Execute(&users)
+		Execute(ctx, &users)
 
 	// If we have results from the database, return the first one
 	if err == nil && len(users) > 0 {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/donaldnash/go-competitor/auth/pb"
 	"github.com/donaldnash/go-competitor/auth/service"
+	"github.com/donaldnash/go-competitor/common/db"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -198,7 +199,7 @@ func (s *AuthServer) CreateTenant(ctx context.Context, req *pb.CreateTenantReque
 
 	org, err := s.service.CreateOrganization(ctx, req.Name, ownerID, req.Plan)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert to protobuf response
@@ -227,7 +228,7 @@ func (s *AuthServer) CreateOrganization(ctx context.Context, req *pb.CreateOrgan
 	// Call the service
 	org, err := s.service.CreateOrganization(ctx, req.Name, req.AccountOwnerId, req.Plan)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert to protobuf response
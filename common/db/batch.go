@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// BatchSize is the number of rows InsertBatch and UpsertBatch send per request
+const BatchSize = 500
+
+// BatchResult reports the outcome of each row of a batch write
+type BatchResult struct {
+	Written int     // Rows that were written
+	Errors  []error // Error of each row, in the order the rows were given, nil for the rows that were written
+}
+
+// Err returns an error listing the rows that failed by index, or nil when every row was written
+func (r BatchResult) Err() error {
+	var failed []error
+	for i, err := range r.Errors {
+		if err != nil {
+			failed = append(failed, fmt.Errorf("row %d: %w", i, err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d of %d rows failed: %w", len(failed), len(r.Errors), errors.Join(failed...))
+}
+
+// InsertBatch inserts rows, which must be a slice, into a table with one request per BatchSize rows.
+// A row that fails doesn't stop the others from being written; see writeBatch.
+func (s *SupabaseClient) InsertBatch(ctx context.Context, table string, rows interface{}) (BatchResult, error) {
+	return s.writeBatch(ctx, table, rows, nil)
+}
+
+// UpsertBatch inserts rows, which must be a slice, into a table and updates the existing rows they
+// conflict with on the onConflict columns, which must make up a primary key or unique constraint.
+// Rows are sent with one request per BatchSize rows, and a row that fails doesn't stop the others
+// from being written; see writeBatch.
+func (s *SupabaseClient) UpsertBatch(ctx context.Context, table string, rows interface{}, onConflict ...string) (BatchResult, error) {
+	if len(onConflict) == 0 {
+		return BatchResult{}, errors.New("upsert requires on conflict columns")
+	}
+	return s.writeBatch(ctx, table, rows, onConflict)
+}
+
+// writeBatch writes rows in chunks of BatchSize. PostgREST writes a chunk in one transaction, so when
+// one of its rows is rejected, such as for a constraint violation, the chunk's rows are written one at
+// a time to find the rows that failed. Their errors are reported in the result. Any other failure,
+// such as a timeout or a permission error, stops the batch and is returned along with the rows
+// written so far.
+func (s *SupabaseClient) writeBatch(ctx context.Context, table string, rows interface{}, onConflict []string) (BatchResult, error) {
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice {
+		return BatchResult{}, fmt.Errorf("batch rows must be a slice, got %T", rows)
+	}
+
+	result := BatchResult{Errors: make([]error, value.Len())}
+	for start := 0; start < value.Len(); start += BatchSize {
+		end := min(start+BatchSize, value.Len())
+
+		err := s.post(ctx, table, value.Slice(start, end).Interface(), onConflict)
+		if err == nil {
+			result.Written += end - start
+			continue
+		}
+		if !isRowError(err) {
+			return result, err
+		}
+
+		for i := start; i < end; i++ {
+			err := s.post(ctx, table, value.Slice(i, i+1).Interface(), onConflict)
+			if err != nil && !isRowError(err) {
+				return result, err
+			}
+			if err != nil {
+				result.Errors[i] = err
+				continue
+			}
+			result.Written++
+		}
+	}
+
+	return result, nil
+}
+
+// isRowError reports whether the database rejected a write because of the values of a row:
+// a cardinality violation, such as a chunk that upserts the same row twice, a data exception
+// or an integrity constraint violation
+func isRowError(err error) bool {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, class := range []string{"21", "22", "23"} {
+		if strings.HasPrefix(apiErr.Code, class) {
+			return true
+		}
+	}
+	return false
+}
+
+// post inserts a chunk of rows into a table in one request. When onConflict is set, rows that
+// conflict with existing rows on those columns are merged into them instead.
+func (s *SupabaseClient) post(ctx context.Context, table string, rows interface{}, onConflict []string) error {
+	url := fmt.Sprintf("%s/rest/v1/%s", s.URL, table)
+
+	// Convert rows to JSON
+	jsonData, err := json.Marshal(rows)
+	if err != nil {
+		return err
+	}
+
+	// Create the request
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
+	if err != nil {
+		return err
+	}
+
+	// Add headers
+	req.Header.Add("apikey", s.AnonKey)
+	req.Header.Add("Authorization", "Bearer "+s.ServiceRole)
+	req.Header.Add("Content-Type", "application/json")
+	if len(onConflict) > 0 {
+		req.Header.Add("Prefer", "return=minimal,resolution=merge-duplicates")
+
+		query := req.URL.Query()
+		query.Add("on_conflict", strings.Join(onConflict, ","))
+		req.URL.RawQuery = query.Encode()
+	} else {
+		req.Header.Add("Prefer", "return=minimal")
+	}
+
+	// Execute the request
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return requestError(err)
+	}
+	defer resp.Body.Close()
+
+	// Check for errors
+	if resp.StatusCode >= 400 {
+		return decodeError(resp)
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// MetricAggregates are the totals and averages of the metrics of posts within a date range
+type MetricAggregates struct {
+	TotalLikes        int
+	TotalShares       int
+	TotalComments     int
+	AvgEngagementRate float64
+	AvgWatchTime      float64
+}
+
+// Comparison holds the aggregates of a competitor's metrics and the tenant's own personal metrics
+// over the same date range
+type Comparison struct {
+	Competitor MetricAggregates
+	Personal   MetricAggregates
+}
+
+// comparisonRow is a row returned by the get_competitor_comparison function.
+// Values are null when there are no posts to aggregate.
+type comparisonRow struct {
+	MetricType      string   `json:"metric_type"`
+	CompetitorValue *float64 `json:"competitor_value"`
+	PersonalValue   *float64 `json:"personal_value"`
+}
+
+// CompareCompetitor aggregates the metrics of a competitor's posts and the tenant's own posts within
+// a date range in the database, with the get_competitor_comparison function. It doesn't check that
+// the competitor belongs to the tenant.
+func (s *SupabaseClient) CompareCompetitor(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) (*Comparison, error) {
+	var rows []comparisonRow
+	err := s.RPC(ctx, "get_competitor_comparison", map[string]interface{}{
+		"p_tenant_id":     tenantID,
+		"p_competitor_id": competitorID,
+		"p_start_date":    startDate.Format(time.RFC3339),
+		"p_end_date":      endDate.Format(time.RFC3339),
+	}, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var comparison Comparison
+	for _, row := range rows {
+		comparison.Competitor.set(row.MetricType, row.CompetitorValue)
+		comparison.Personal.set(row.MetricType, row.PersonalValue)
+	}
+
+	return &comparison, nil
+}
+
+// set sets the aggregate of a metric type from a comparison row, leaving it zero when it is null
+func (a *MetricAggregates) set(metricType string, value *float64) {
+	if value == nil {
+		return
+	}
+
+	switch metricType {
+	case "likes":
+		a.TotalLikes = int(*value)
+	case "shares":
+		a.TotalShares = int(*value)
+	case "comments":
+		a.TotalComments = int(*value)
+	case "engagement_rate":
+		a.AvgEngagementRate = *value
+	case "watch_time":
+		a.AvgWatchTime = *value
+	}
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Kinds of failure callers can tell apart with errors.Is. A request error matches at most one of them.
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrConflict         = errors.New("conflict")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrTimeout          = errors.New("timeout")
+)
+
+// maxErrorBodySize caps how much of an error response is read
+const maxErrorBodySize = 64 << 10
+
+// Error is a failed Supabase request, with the error PostgREST reported when it sent one
+type Error struct {
+	StatusCode int
+	Code       string // PostgreSQL SQLSTATE, such as 23505, or a PostgREST code, such as PGRST116
+	Message    string
+	Details    string
+	Hint       string
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "supabase request failed with status %d", e.StatusCode)
+	if e.Code != "" {
+		fmt.Fprintf(&b, " (%s)", e.Code)
+	}
+	if e.Message != "" {
+		b.WriteString(": " + e.Message)
+	}
+	if e.Details != "" {
+		b.WriteString(": " + e.Details)
+	}
+	return b.String()
+}
+
+// Is reports whether the error is of one of the kinds callers check for
+func (e *Error) Is(target error) bool {
+	return e.kind() == target
+}
+
+// kind classifies the error by its PostgREST code, falling back to the HTTP status
+func (e *Error) kind() error {
+	switch {
+	case e.Code == "PGRST116": // A single row was requested but none matched
+		return ErrNotFound
+	case e.Code == "23505", e.Code == "23503": // Unique and foreign key violations
+		return ErrConflict
+	case e.Code == "42501", e.Code == "PGRST301", e.Code == "PGRST302": // Row-level security and JWT failures
+		return ErrPermissionDenied
+	case e.Code == "57014": // Statement timeout
+		return ErrTimeout
+	}
+
+	switch e.StatusCode {
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrPermissionDenied
+	case http.StatusRequestTimeout, http.StatusGatewayTimeout:
+		return ErrTimeout
+	}
+
+	return nil
+}
+
+// decodeError converts an error response to an *Error, keeping whatever the body reports
+func decodeError(resp *http.Response) error {
+	apiErr := &Error{StatusCode: resp.StatusCode}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	var payload struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Details string `json:"details"`
+		Hint    string `json:"hint"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		apiErr.Code = payload.Code
+		apiErr.Message = payload.Message
+		apiErr.Details = payload.Details
+		apiErr.Hint = payload.Hint
+	} else if text := strings.TrimSpace(string(body)); text != "" {
+		apiErr.Message = text
+	}
+
+	return apiErr
+}
+
+// requestError wraps an error sending a request, marking deadlines and network timeouts as ErrTimeout.
+// The original error stays wrapped, so cancellations still match context.Canceled.
+func requestError(err error) error {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return fmt.Errorf("supabase request %w: %w", ErrTimeout, err)
+	}
+	return fmt.Errorf("supabase request failed: %w", err)
+}
+
+// Code maps an error to the gRPC code a server should return for it.
+// Errors of none of the kinds callers check for are codes.Internal.
+func Code(err error) codes.Code {
+	var apiErr *Error
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, ErrInvalidPageToken), errors.Is(err, ErrTenantRequired):
+		return codes.InvalidArgument
+	case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest:
+		// Malformed filters and values, such as an ID that isn't a UUID
+		return codes.InvalidArgument
+	}
+	return codes.Internal
+}
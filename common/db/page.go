@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const (
+	// DefaultPageSize is the number of rows a list request that doesn't ask for a page size gets
+	DefaultPageSize = 50
+
+	// MaxPageSize caps the number of rows a list request can ask for
+	MaxPageSize = 1000
+)
+
+// ErrInvalidPageToken is returned for page tokens that weren't returned by a previous page
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Page selects a page of a query's results. The zero Page selects every row.
+type Page struct {
+	Size  int
+	Token string // NextToken of the previous page, empty for the first page
+}
+
+// PageInfo describes the page a query returned
+type PageInfo struct {
+	NextToken  string // Empty on the last page
+	TotalCount int    // Rows matching the query across every page
+}
+
+// RequestedPage returns the page a list request asks for, applying DefaultPageSize and MaxPageSize
+func RequestedPage(size int32, token string) Page {
+	page := Page{Size: int(size), Token: token}
+	if page.Size <= 0 {
+		page.Size = DefaultPageSize
+	}
+	if page.Size > MaxPageSize {
+		page.Size = MaxPageSize
+	}
+	return page
+}
+
+// cursor is the position a page token encodes: the sort value and ID of the last row returned
+// and how many rows came before the next page
+type cursor struct {
+	Offset int             `json:"o"`
+	Value  json.RawMessage `json:"v"`
+	ID     json.RawMessage `json:"i"`
+}
+
+// ExecutePage runs the query for one page and decodes its rows into result, which must point
+// to a slice. Pages are read by keyset rather than offset: the query is ordered by its Order
+// column, or by ID when it has none, with ties broken by ID, and each page starts after the
+// last row of the previous one. Rows inserted or deleted between pages don't shift later pages.
+// The zero Page reads every row.
+func (q *QueryBuilder) ExecutePage(ctx context.Context, page Page, result interface{}) (PageInfo, error) {
+	if page.Size <= 0 {
+		if err := q.Execute(ctx, result); err != nil {
+			return PageInfo{}, err
+		}
+		return PageInfo{TotalCount: reflect.Indirect(reflect.ValueOf(result)).Len()}, nil
+	}
+
+	if q.orderBy == "" {
+		q.orderBy = "id"
+	}
+	q.tiebreak = true
+
+	var position cursor
+	if page.Token != "" {
+		var err error
+		if position, err = decodeCursor(page.Token); err != nil {
+			return PageInfo{}, err
+		}
+		q.after(position)
+	}
+
+	body, remaining, err := q.Range(0, page.Size-1).get(ctx, true)
+	if err != nil {
+		return PageInfo{}, err
+	}
+
+	var rows []map[string]json.RawMessage
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return PageInfo{}, err
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return PageInfo{}, err
+	}
+
+	info := PageInfo{TotalCount: position.Offset + remaining}
+	more := remaining > len(rows)
+	if remaining < 0 {
+		// The count wasn't reported, so a full page may not be the last one
+		info.TotalCount = position.Offset + len(rows)
+		more = len(rows) == page.Size
+	}
+
+	if more && len(rows) > 0 {
+		last := rows[len(rows)-1]
+		info.NextToken = encodeCursor(cursor{
+			Offset: position.Offset + len(rows),
+			Value:  last[q.orderBy],
+			ID:     last["id"],
+		})
+	}
+
+	return info, nil
+}
+
+// after restricts the query to the rows ordered after a cursor. PostgREST sorts nulls last in
+// ascending order and first in descending order, so rows with a null sort value come after
+// every other row ascending and before them descending.
+func (q *QueryBuilder) after(position cursor) {
+	column, id := q.orderBy, literal(position.ID)
+	next := "gt"
+	if q.orderDesc {
+		next = "lt"
+	}
+
+	if column == "id" {
+		// Quotes are only read as syntax inside or and and conditions
+		q.Where("id", next, strings.Trim(id, `"`))
+		return
+	}
+
+	isNull := len(position.Value) == 0 || string(position.Value) == "null"
+	switch {
+	case !isNull && !q.orderDesc:
+		q.logical("or", fmt.Sprintf("(%s.%s.%s,and(%s.eq.%s,id.%s.%s),%s.is.null)",
+			column, next, literal(position.Value), column, literal(position.Value), next, id, column))
+	case !isNull:
+		q.logical("or", fmt.Sprintf("(%s.%s.%s,and(%s.eq.%s,id.%s.%s))",
+			column, next, literal(position.Value), column, literal(position.Value), next, id))
+	case !q.orderDesc:
+		q.logical("and", fmt.Sprintf("(%s.is.null,id.%s.%s)", column, next, id))
+	default:
+		q.logical("or", fmt.Sprintf("(%s.not.is.null,and(%s.is.null,id.%s.%s))", column, column, next, id))
+	}
+}
+
+// logical adds an or or and filter, whose value holds its own conditions
+func (q *QueryBuilder) logical(operator, conditions string) {
+	q.filters = append(q.filters, filter{Column: operator, Value: conditions})
+}
+
+// literal formats a JSON value for a filter inside or and and conditions. Strings are
+// double-quoted so commas, dots and parentheses in them aren't read as syntax.
+func literal(raw json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err != nil {
+		// Numbers and booleans are used as they are
+		return string(raw)
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(text) + `"`
+}
+
+// encodeCursor encodes a cursor as a page token
+func encodeCursor(position cursor) string {
+	data, _ := json.Marshal(position)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor decodes a page token returned by encodeCursor
+func decodeCursor(token string) (cursor, error) {
+	var position cursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return position, fmt.Errorf("%w: %q", ErrInvalidPageToken, token)
+	}
+	if err := json.Unmarshal(data, &position); err != nil || position.Offset < 0 ||
+		!scalar(position.ID) || string(position.ID) == "null" || (position.Value != nil && !scalar(position.Value)) {
+		return position, fmt.Errorf("%w: %q", ErrInvalidPageToken, token)
+	}
+	return position, nil
+}
+
+// scalar reports whether raw is a JSON string, number, boolean or null, which are the only
+// values a cursor holds. Anything else could inject conditions into the keyset filter.
+func scalar(raw json.RawMessage) bool {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return false
+	}
+	switch value.(type) {
+	case string, float64, bool, nil:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RPC calls a Postgres function through PostgREST's /rest/v1/rpc/<function> endpoint and decodes what
+// it returns into result: a slice for functions that return a table or set, or a single value otherwise.
+// params is encoded as a JSON object whose keys are the function's argument names, such as a map or a
+// struct with json tags, and may be nil for functions without arguments.
+//
+// Functions aren't scoped to the client's tenant, since they run whatever SQL they define. Functions
+// that read tenant data take the tenant as an argument, which callers must always pass.
+func (s *SupabaseClient) RPC(ctx context.Context, function string, params interface{}, result interface{}) error {
+	url := fmt.Sprintf("%s/rest/v1/rpc/%s", s.URL, function)
+
+	// Convert the arguments to JSON
+	if params == nil {
+		params = struct{}{}
+	}
+	jsonData, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+
+	// Create the request
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
+	if err != nil {
+		return err
+	}
+
+	// Add headers
+	req.Header.Add("apikey", s.AnonKey)
+	req.Header.Add("Authorization", "Bearer "+s.ServiceRole)
+	req.Header.Add("Content-Type", "application/json")
+
+	// Execute the request
+	resp, err := s.HTTPClient.Do(req)
+	if err != nil {
+		return requestError(err)
+	}
+	defer resp.Body.Close()
+
+	// Check for errors
+	if resp.StatusCode >= 400 {
+		return decodeError(resp)
+	}
+
+	// Decode the function's result. Functions that return void have no content.
+	if result == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	decoder := json.NewDecoder(resp.Body)
+	return decoder.Decode(result)
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"errors"
+	"net/url"
+)
+
+// ErrTenantRequired is returned for mutations scoped to a tenant without a tenant ID
+var ErrTenantRequired = errors.New("tenant ID is required")
+
+// Scope selects whose rows a mutation may touch. Requests use the service role key, which bypasses
+// row-level security, so a mutation only reaches another tenant's rows when it is explicitly a
+// system operation.
+type Scope struct {
+	tenantID string
+	system   bool
+}
+
+// TenantScope limits a mutation to the rows of a tenant
+func TenantScope(tenantID string) Scope {
+	return Scope{tenantID: tenantID}
+}
+
+// SystemScope lets a mutation touch the rows of every tenant. It is only for system operations
+// that work across tenants, never for a tenant's request.
+func SystemScope() Scope {
+	return Scope{system: true}
+}
+
+// addFilter adds the scope's tenant filter to the query parameters
+func (s Scope) addFilter(query url.Values) error {
+	if s.system {
+		return nil
+	}
+	if s.tenantID == "" {
+		return ErrTenantRequired
+	}
+
+	query.Add("tenant_id", "eq."+s.tenantID)
+	return nil
+}
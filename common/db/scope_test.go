@@ -0,0 +1,260 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeTable is a PostgREST table of tenant rows that applies the eq filters of PATCH and DELETE
+// requests the way PostgREST does, so a filter a request leaves out lets it reach more rows
+type fakeTable struct {
+	mu       sync.Mutex
+	rows     []map[string]string
+	requests int
+}
+
+func newFakeTable() *fakeTable {
+	return &fakeTable{rows: []map[string]string{
+		{"id": "a1", "tenant_id": "tenant-a", "name": "Acme"},
+		{"id": "b1", "tenant_id": "tenant-b", "name": "Bolt"},
+	}}
+}
+
+func (f *fakeTable) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests++
+
+	var data map[string]string
+	if r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	matches := func(row map[string]string) bool {
+		for column, values := range r.URL.Query() {
+			if column == "select" {
+				continue
+			}
+			for _, value := range values {
+				if row[column] != strings.TrimPrefix(value, "eq.") {
+					return false
+				}
+			}
+		}
+		return true
+	}
+
+	var changed, kept []map[string]string
+	for _, row := range f.rows {
+		if !matches(row) {
+			kept = append(kept, row)
+			continue
+		}
+
+		switch r.Method {
+		case http.MethodPatch:
+			for column, value := range data {
+				row[column] = value
+			}
+			kept = append(kept, row)
+		case http.MethodDelete:
+			// Deleted rows aren't kept
+		default:
+			http.Error(w, "unexpected method", http.StatusMethodNotAllowed)
+			return
+		}
+		changed = append(changed, map[string]string{"id": row["id"]})
+	}
+	f.rows = kept
+
+	if changed == nil {
+		changed = []map[string]string{}
+	}
+	json.NewEncoder(w).Encode(changed)
+}
+
+// row returns the stored row with an ID, or nil once it is deleted
+func (f *fakeTable) row(id string) map[string]string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, row := range f.rows {
+		if row["id"] == id {
+			return row
+		}
+	}
+	return nil
+}
+
+func newTestClient(t *testing.T, table *fakeTable) *SupabaseClient {
+	server := httptest.NewServer(table)
+	t.Cleanup(server.Close)
+
+	return &SupabaseClient{
+		URL:         server.URL,
+		AnonKey:     "anon",
+		ServiceRole: "service",
+		TenantID:    "system",
+		HTTPClient:  server.Client(),
+	}
+}
+
+func TestUpdateCannotReachAnotherTenantsRow(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+
+	n, err := client.Update(context.Background(), TenantScope("tenant-a"), "competitors", "id", "b1",
+		map[string]string{"name": "Hijacked"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Update of another tenant's row returned %v, want ErrNotFound", err)
+	}
+	if n != 0 {
+		t.Errorf("Update of another tenant's row reported %d rows, want 0", n)
+	}
+	if name := table.row("b1")["name"]; name != "Bolt" {
+		t.Errorf("another tenant's row was renamed to %q", name)
+	}
+}
+
+func TestDeleteCannotReachAnotherTenantsRow(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+
+	n, err := client.Delete(context.Background(), TenantScope("tenant-a"), "competitors", "id", "b1")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Delete of another tenant's row returned %v, want ErrNotFound", err)
+	}
+	if n != 0 {
+		t.Errorf("Delete of another tenant's row reported %d rows, want 0", n)
+	}
+	if table.row("b1") == nil {
+		t.Error("another tenant's row was deleted")
+	}
+}
+
+func TestMutationsReachOwnRow(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+	ctx := context.Background()
+
+	n, err := client.Update(ctx, TenantScope("tenant-a"), "competitors", "id", "a1", map[string]string{"name": "Acme Corp"})
+	if err != nil || n != 1 {
+		t.Fatalf("Update of own row returned %d, %v, want 1, nil", n, err)
+	}
+	if name := table.row("a1")["name"]; name != "Acme Corp" {
+		t.Errorf("own row is named %q after the update, want %q", name, "Acme Corp")
+	}
+
+	n, err = client.Delete(ctx, TenantScope("tenant-a"), "competitors", "id", "a1")
+	if err != nil || n != 1 {
+		t.Fatalf("Delete of own row returned %d, %v, want 1, nil", n, err)
+	}
+	if table.row("a1") != nil {
+		t.Error("own row still exists after the delete")
+	}
+	if table.row("b1") == nil {
+		t.Error("deleting own row deleted another tenant's row")
+	}
+}
+
+func TestMutationOfMissingRowIsNotFound(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+
+	_, err := client.Update(context.Background(), TenantScope("tenant-a"), "competitors", "id", "missing",
+		map[string]string{"name": "Ghost"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Update of a missing row returned %v, want ErrNotFound", err)
+	}
+}
+
+func TestSystemScopeReachesEveryTenant(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+
+	n, err := client.Update(context.Background(), SystemScope(), "competitors", "id", "b1", map[string]string{"name": "Bolt Inc"})
+	if err != nil || n != 1 {
+		t.Fatalf("system Update returned %d, %v, want 1, nil", n, err)
+	}
+	if name := table.row("b1")["name"]; name != "Bolt Inc" {
+		t.Errorf("row is named %q after the system update, want %q", name, "Bolt Inc")
+	}
+}
+
+func TestTenantScopeRequiresTenant(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+	ctx := context.Background()
+
+	if _, err := client.Update(ctx, TenantScope(""), "competitors", "id", "b1", map[string]string{"name": "Hijacked"}); !errors.Is(err, ErrTenantRequired) {
+		t.Errorf("Update without a tenant returned %v, want ErrTenantRequired", err)
+	}
+	if _, err := client.Delete(ctx, Scope{}, "competitors", "id", "b1"); !errors.Is(err, ErrTenantRequired) {
+		t.Errorf("Delete with the zero Scope returned %v, want ErrTenantRequired", err)
+	}
+	table.mu.Lock()
+	requests := table.requests
+	table.mu.Unlock()
+	if requests != 0 {
+		t.Errorf("%d requests were sent without a tenant, want none", requests)
+	}
+	if table.row("b1")["name"] != "Bolt" {
+		t.Error("a row was changed without a tenant")
+	}
+}
+
+func TestQueryMutationsAreScoped(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+	client.TenantID = ""
+	ctx := context.Background()
+
+	var updated []map[string]string
+	err := client.Query("competitors").Select("id").Where("name", "eq", "Bolt").
+		Update(ctx, TenantScope("tenant-a"), map[string]string{"name": "Hijacked"}, &updated)
+	if err != nil || len(updated) != 0 {
+		t.Fatalf("Update of another tenant's row returned %v, %v, want no rows", updated, err)
+	}
+	if name := table.row("b1")["name"]; name != "Bolt" {
+		t.Errorf("another tenant's row was renamed to %q", name)
+	}
+
+	var deleted []map[string]string
+	err = client.Query("competitors").Select("id").Where("name", "eq", "Bolt").Delete(ctx, TenantScope("tenant-b"), &deleted)
+	if err != nil || len(deleted) != 1 {
+		t.Fatalf("Delete of own row returned %v, %v, want 1 row", deleted, err)
+	}
+	if table.row("a1") == nil {
+		t.Error("deleting own row deleted another tenant's row")
+	}
+}
+
+func TestQueryMutationsRequireTenant(t *testing.T) {
+	table := newFakeTable()
+	client := newTestClient(t, table)
+	client.TenantID = ""
+	ctx := context.Background()
+
+	var rows []map[string]string
+	if err := client.Query("competitors").Update(ctx, Scope{}, map[string]string{"name": "Hijacked"}, &rows); !errors.Is(err, ErrTenantRequired) {
+		t.Errorf("Update with the zero Scope returned %v, want ErrTenantRequired", err)
+	}
+	if err := client.Query("competitors").Delete(ctx, TenantScope(""), &rows); !errors.Is(err, ErrTenantRequired) {
+		t.Errorf("Delete without a tenant returned %v, want ErrTenantRequired", err)
+	}
+	table.mu.Lock()
+	requests := table.requests
+	table.mu.Unlock()
+	if requests != 0 {
+		t.Errorf("%d requests were sent without a tenant, want none", requests)
+	}
+}
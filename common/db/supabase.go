@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -41,13 +44,18 @@ func NewSupabaseClient(tenantID string) (*SupabaseClient, error) {
 
 // QueryBuilder represents a builder for Supabase queries
 type QueryBuilder struct {
-	client     *SupabaseClient
-	table      string
-	selects    []string
-	filters    []filter
-	limitCount int
-	orderBy    string
-	orderDesc  bool
+	client      *SupabaseClient
+	table       string
+	selects     []string
+	filters     []filter
+	limitCount  int
+	offsetCount int
+	orderBy     string
+	orderDesc   bool
+	rangeFrom   int
+	rangeTo     int
+	hasRange    bool
+	tiebreak    bool // Order rows with the same value by ID, so pages split them consistently
 }
 
 type filter struct {
@@ -87,6 +95,21 @@ func (q *QueryBuilder) Limit(count int) *QueryBuilder {
 	return q
 }
 
+// Offset skips the first count rows of the query's results
+func (q *QueryBuilder) Offset(count int) *QueryBuilder {
+	q.offsetCount = count
+	return q
+}
+
+// Range limits the query to the rows from index from to index to, inclusive, with the
+// Range header instead of limit and offset parameters
+func (q *QueryBuilder) Range(from, to int) *QueryBuilder {
+	q.rangeFrom = from
+	q.rangeTo = to
+	q.hasRange = true
+	return q
+}
+
 // Order adds an order clause to the query
 func (q *QueryBuilder) Order(column string, desc bool) *QueryBuilder {
 	q.orderBy = column
@@ -94,22 +117,57 @@ func (q *QueryBuilder) Order(column string, desc bool) *QueryBuilder {
 	return q
 }
 
-// Execute executes the query and returns the results
-func (q *QueryBuilder) Execute(result interface{}) error {
+// Execute runs the query and decodes the matching rows into result. The request is cancelled
+// with ctx, and failures are returned as an *Error or wrap ErrTimeout.
+func (q *QueryBuilder) Execute(ctx context.Context, result interface{}) error {
+	body, _, err := q.get(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, result)
+}
+
+// ExecuteWithCount runs the query like Execute and also returns the exact number of rows
+// matching its filters, regardless of its limit and offset
+func (q *QueryBuilder) ExecuteWithCount(ctx context.Context, result interface{}) (int, error) {
+	body, total, err := q.get(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// get runs the query and returns the response body. When count is set the exact number of
+// matching rows is requested with Prefer: count=exact and read from the Content-Range header.
+func (q *QueryBuilder) get(ctx context.Context, count bool) ([]byte, int, error) {
 	// Build the URL for the query
 	url := fmt.Sprintf("%s/rest/v1/%s", q.client.URL, q.table)
 
 	// Create the request
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return err
+		return nil, 0, err
 	}
 
 	// Add headers
 	req.Header.Add("apikey", q.client.AnonKey)
 	req.Header.Add("Authorization", "Bearer "+q.client.ServiceRole)
 	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("Prefer", "return=representation")
+	if count {
+		req.Header.Add("Prefer", "return=representation,count=exact")
+	} else {
+		req.Header.Add("Prefer", "return=representation")
+	}
+	if q.hasRange {
+		req.Header.Add("Range-Unit", "items")
+		req.Header.Add("Range", fmt.Sprintf("%d-%d", q.rangeFrom, q.rangeTo))
+	}
 
 	// Add query parameters
 	query := req.URL.Query()
@@ -119,28 +177,27 @@ func (q *QueryBuilder) Execute(result interface{}) error {
 		query.Add("select", strings.Join(q.selects, ","))
 	}
 
-	// Add tenant filter
-	if q.client.TenantID != "" {
-		query.Add("tenant_id", "eq."+q.client.TenantID)
-	}
-
-	// Add filters
-	for _, f := range q.filters {
-		query.Add(f.Column, fmt.Sprintf("%s.%v", f.Operator, f.Value))
-	}
+	// Add tenant and column filters
+	q.addFilters(query)
 
-	// Add limit
+	// Add limit and offset
 	if q.limitCount > 0 {
 		query.Add("limit", fmt.Sprintf("%d", q.limitCount))
 	}
 
-	// Add order
+	if q.offsetCount > 0 {
+		query.Add("offset", fmt.Sprintf("%d", q.offsetCount))
+	}
+
+	// Add order, with ties broken by ID when the query is paginated
 	if q.orderBy != "" {
-		order := q.orderBy
+		direction := ".asc"
 		if q.orderDesc {
-			order = order + ".desc"
-		} else {
-			order = order + ".asc"
+			direction = ".desc"
+		}
+		order := q.orderBy + direction
+		if q.tiebreak && q.orderBy != "id" {
+			order += ",id" + direction
 		}
 		query.Add("order", order)
 	}
@@ -150,23 +207,51 @@ func (q *QueryBuilder) Execute(result interface{}) error {
 	// Execute the request
 	resp, err := q.client.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return nil, 0, requestError(err)
 	}
 	defer resp.Body.Close()
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("supabase request failed with status: %d", resp.StatusCode)
+		return nil, 0, decodeError(resp)
 	}
 
-	// Decode the response
-	decoder := json.NewDecoder(resp.Body)
-	return decoder.Decode(result)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, requestError(err)
+	}
+
+	total := 0
+	if count {
+		total = parseContentRange(resp.Header.Get("Content-Range"))
+	}
+
+	return body, total, nil
 }
 
-// Insert inserts a new record into the table
-func (s *SupabaseClient) Insert(ctx context.Context, table string, data interface{}) error {
-	url := fmt.Sprintf("%s/rest/v1/%s", s.URL, table)
+// parseContentRange reads the total from a Content-Range header such as "0-24/3573" or "*/0".
+// It returns -1 when the total isn't known.
+func parseContentRange(header string) int {
+	_, total, found := strings.Cut(header, "/")
+	if !found {
+		return -1
+	}
+
+	n, err := strconv.Atoi(total)
+	if err != nil {
+		return -1
+	}
+
+	return n
+}
+
+// Update applies data to every row matching the query's filters within scope and decodes the
+// updated rows into result. Since the filters are checked by the database as part of the update,
+// it can be used to change a row only while it is still in an expected state. The scope replaces
+// the client's tenant filter, and ErrTenantRequired is returned without a request when it has no
+// tenant and isn't SystemScope.
+func (q *QueryBuilder) Update(ctx context.Context, scope Scope, data interface{}, result interface{}) error {
+	url := fmt.Sprintf("%s/rest/v1/%s", q.client.URL, q.table)
 
 	// Convert data to JSON
 	jsonData, err := json.Marshal(data)
@@ -175,38 +260,114 @@ func (s *SupabaseClient) Insert(ctx context.Context, table string, data interfac
 	}
 
 	// Create the request
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(jsonData)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return err
 	}
 
-	// Add context
-	req = req.WithContext(ctx)
-
 	// Add headers
-	req.Header.Add("apikey", s.AnonKey)
-	req.Header.Add("Authorization", "Bearer "+s.ServiceRole)
+	req.Header.Add("apikey", q.client.AnonKey)
+	req.Header.Add("Authorization", "Bearer "+q.client.ServiceRole)
 	req.Header.Add("Content-Type", "application/json")
 	req.Header.Add("Prefer", "return=representation")
 
+	// Add query parameters
+	query := req.URL.Query()
+	if len(q.selects) > 0 {
+		query.Add("select", strings.Join(q.selects, ","))
+	}
+	if err := scope.addFilter(query); err != nil {
+		return err
+	}
+	q.addColumnFilters(query)
+	req.URL.RawQuery = query.Encode()
+
 	// Execute the request
-	resp, err := s.HTTPClient.Do(req)
+	resp, err := q.client.HTTPClient.Do(req)
+	if err != nil {
+		return requestError(err)
+	}
+	defer resp.Body.Close()
+
+	// Check for errors
+	if resp.StatusCode >= 400 {
+		return decodeError(resp)
+	}
+
+	// Decode the updated rows
+	decoder := json.NewDecoder(resp.Body)
+	return decoder.Decode(result)
+}
+
+// Delete removes every row matching the query's filters within scope and decodes the deleted rows
+// into result. Like Update, the scope replaces the client's tenant filter.
+func (q *QueryBuilder) Delete(ctx context.Context, scope Scope, result interface{}) error {
+	url := fmt.Sprintf("%s/rest/v1/%s", q.client.URL, q.table)
+
+	// Create the request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
 	if err != nil {
 		return err
 	}
+
+	// Add headers
+	req.Header.Add("apikey", q.client.AnonKey)
+	req.Header.Add("Authorization", "Bearer "+q.client.ServiceRole)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Prefer", "return=representation")
+
+	// Add query parameters
+	query := req.URL.Query()
+	if len(q.selects) > 0 {
+		query.Add("select", strings.Join(q.selects, ","))
+	}
+	if err := scope.addFilter(query); err != nil {
+		return err
+	}
+	q.addColumnFilters(query)
+	req.URL.RawQuery = query.Encode()
+
+	// Execute the request
+	resp, err := q.client.HTTPClient.Do(req)
+	if err != nil {
+		return requestError(err)
+	}
 	defer resp.Body.Close()
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("supabase insert failed with status: %d", resp.StatusCode)
+		return decodeError(resp)
 	}
 
-	return nil
+	// Decode the deleted rows
+	decoder := json.NewDecoder(resp.Body)
+	return decoder.Decode(result)
+}
+
+// addFilters adds the client's tenant filter and the query's filters to the query parameters
+func (q *QueryBuilder) addFilters(query url.Values) {
+	if q.client.TenantID != "" {
+		query.Add("tenant_id", "eq."+q.client.TenantID)
+	}
+
+	q.addColumnFilters(query)
+}
+
+// addColumnFilters adds the query's filters to the query parameters
+func (q *QueryBuilder) addColumnFilters(query url.Values) {
+	for _, f := range q.filters {
+		if f.Operator == "" {
+			// Logical filters such as or=(...) have no operator
+			query.Add(f.Column, fmt.Sprintf("%v", f.Value))
+			continue
+		}
+		query.Add(f.Column, fmt.Sprintf("%s.%v", f.Operator, f.Value))
+	}
 }
 
-// Update updates an existing record in the table
-func (s *SupabaseClient) Update(ctx context.Context, table, idColumn, id string, data interface{}) error {
-	url := fmt.Sprintf("%s/rest/v1/%s?%s=eq.%s", s.URL, table, idColumn, id)
+// Insert inserts a new record into the table
+func (s *SupabaseClient) Insert(ctx context.Context, table string, data interface{}) error {
+	url := fmt.Sprintf("%s/rest/v1/%s", s.URL, table)
 
 	// Convert data to JSON
 	jsonData, err := json.Marshal(data)
@@ -215,7 +376,7 @@ func (s *SupabaseClient) Update(ctx context.Context, table, idColumn, id string,
 	}
 
 	// Create the request
-	req, err := http.NewRequest("PATCH", url, strings.NewReader(string(jsonData)))
+	req, err := http.NewRequest("POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
 		return err
 	}
@@ -232,47 +393,98 @@ func (s *SupabaseClient) Update(ctx context.Context, table, idColumn, id string,
 	// Execute the request
 	resp, err := s.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return requestError(err)
 	}
 	defer resp.Body.Close()
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("supabase update failed with status: %d", resp.StatusCode)
+		return decodeError(resp)
 	}
 
 	return nil
 }
 
-// Delete deletes a record from the table
-func (s *SupabaseClient) Delete(ctx context.Context, table, idColumn, id string) error {
-	url := fmt.Sprintf("%s/rest/v1/%s?%s=eq.%s", s.URL, table, idColumn, id)
+// Update updates the row of the table whose idColumn is id within scope, and returns how many rows
+// were updated. A row that doesn't exist or is out of scope, such as another tenant's, isn't updated,
+// and ErrNotFound is returned.
+func (s *SupabaseClient) Update(ctx context.Context, scope Scope, table, idColumn, id string, data interface{}) (int, error) {
+	url := fmt.Sprintf("%s/rest/v1/%s", s.URL, table)
+
+	// Convert data to JSON
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return 0, err
+	}
 
 	// Create the request
-	req, err := http.NewRequest("DELETE", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", url, strings.NewReader(string(jsonData)))
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// Add context
-	req = req.WithContext(ctx)
+	// Add headers
+	req.Header.Add("apikey", s.AnonKey)
+	req.Header.Add("Authorization", "Bearer "+s.ServiceRole)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Prefer", "return=representation")
+
+	return s.mutate(req, scope, table, idColumn, id)
+}
+
+// Delete deletes the row of the table whose idColumn is id within scope, and returns how many rows
+// were deleted. A row that doesn't exist or is out of scope, such as another tenant's, isn't deleted,
+// and ErrNotFound is returned.
+func (s *SupabaseClient) Delete(ctx context.Context, scope Scope, table, idColumn, id string) (int, error) {
+	url := fmt.Sprintf("%s/rest/v1/%s", s.URL, table)
+
+	// Create the request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", url, nil)
+	if err != nil {
+		return 0, err
+	}
 
 	// Add headers
 	req.Header.Add("apikey", s.AnonKey)
 	req.Header.Add("Authorization", "Bearer "+s.ServiceRole)
 	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Prefer", "return=representation")
+
+	return s.mutate(req, scope, table, idColumn, id)
+}
+
+// mutate filters an update or delete request to the row whose idColumn is id within scope, executes
+// it and counts the rows it returned, which are the rows it changed
+func (s *SupabaseClient) mutate(req *http.Request, scope Scope, table, idColumn, id string) (int, error) {
+	// Add query parameters, selecting only the ID of the changed rows to count them
+	query := req.URL.Query()
+	query.Add("select", idColumn)
+	query.Add(idColumn, "eq."+id)
+	if err := scope.addFilter(query); err != nil {
+		return 0, err
+	}
+	req.URL.RawQuery = query.Encode()
 
 	// Execute the request
 	resp, err := s.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return 0, requestError(err)
 	}
 	defer resp.Body.Close()
 
 	// Check for errors
 	if resp.StatusCode >= 400 {
-		return fmt.Errorf("supabase delete failed with status: %d", resp.StatusCode)
+		return 0, decodeError(resp)
 	}
 
-	return nil
+	// Count the changed rows
+	var rows []json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, fmt.Errorf("%s row %s %w", table, id, ErrNotFound)
+	}
+
+	return len(rows), nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/competitor/pb"
 	"github.com/donaldnash/go-competitor/competitor/repository"
 	"google.golang.org/grpc"
@@ -15,8 +16,10 @@ import (
 // CompetitorClient defines the interface for client communication with the competitor service
 type CompetitorClient interface {
 	GetCompetitors(ctx context.Context, tenantID string) ([]repository.Competitor, error)
+	GetCompetitorsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.Competitor, db.PageInfo, error)
 	GetCompetitor(ctx context.Context, tenantID, competitorID string) (*repository.Competitor, error)
 	GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) ([]repository.CompetitorMetric, error)
+	GetCompetitorMetricsPage(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time, page db.Page) ([]repository.CompetitorMetric, db.PageInfo, error)
 	AddCompetitor(ctx context.Context, tenantID, name, platform string) (*repository.Competitor, error)
 	UpdateCompetitor(ctx context.Context, tenantID, competitorID, name, platform string) (*repository.Competitor, error)
 	DeleteCompetitor(ctx context.Context, tenantID, competitorID string) error
@@ -69,13 +72,32 @@ func (c *GRPCCompetitorClient) Close() error {
 	return nil
 }
 
-// GetCompetitors retrieves all competitors for the current tenant
+// GetCompetitors retrieves all competitors for the current tenant, reading every page
 func (c *GRPCCompetitorClient) GetCompetitors(ctx context.Context, tenantID string) ([]repository.Competitor, error) {
+	var competitors []repository.Competitor
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetCompetitorsPage(ctx, tenantID, page)
+		if err != nil {
+			return nil, err
+		}
+		competitors = append(competitors, batch...)
+		if info.NextToken == "" {
+			return competitors, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetCompetitorsPage retrieves a page of the competitors for the current tenant
+func (c *GRPCCompetitorClient) GetCompetitorsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.Competitor, db.PageInfo, error) {
 	resp, err := c.client.ListCompetitors(ctx, &pb.ListCompetitorsRequest{
-		TenantId: tenantID,
+		TenantId:  tenantID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get competitors: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get competitors: %w", err)
 	}
 
 	competitors := make([]repository.Competitor, len(resp.Competitors))
@@ -89,7 +111,7 @@ func (c *GRPCCompetitorClient) GetCompetitors(ctx context.Context, tenantID stri
 		}
 	}
 
-	return competitors, nil
+	return competitors, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // GetCompetitor retrieves a specific competitor
@@ -111,16 +133,37 @@ func (c *GRPCCompetitorClient) GetCompetitor(ctx context.Context, tenantID, comp
 	}, nil
 }
 
-// GetCompetitorMetrics retrieves metrics for a specific competitor
+// GetCompetitorMetrics retrieves all metrics for a specific competitor, reading every page
 func (c *GRPCCompetitorClient) GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) ([]repository.CompetitorMetric, error) {
+	var metrics []repository.CompetitorMetric
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetCompetitorMetricsPage(ctx, tenantID, competitorID, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, batch...)
+		if info.NextToken == "" {
+			return metrics, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetCompetitorMetricsPage retrieves a page of the metrics for a specific competitor
+func (c *GRPCCompetitorClient) GetCompetitorMetricsPage(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.CompetitorMetric, db.PageInfo, error) {
+
 	resp, err := c.client.GetCompetitorMetrics(ctx, &pb.GetCompetitorMetricsRequest{
 		TenantId:     tenantID,
 		CompetitorId: competitorID,
 		StartDate:    timestamppb.New(startDate),
 		EndDate:      timestamppb.New(endDate),
+		PageSize:     int32(page.Size),
+		PageToken:    page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get competitor metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get competitor metrics: %w", err)
 	}
 
 	metrics := make([]repository.CompetitorMetric, len(resp.Metrics))
@@ -139,7 +182,7 @@ func (c *GRPCCompetitorClient) GetCompetitorMetrics(ctx context.Context, tenantI
 		}
 	}
 
-	return metrics, nil
+	return metrics, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // AddCompetitor adds a new competitor
@@ -155,7 +155,9 @@ func (x *GetCompetitorRequest) GetCompetitorId() string {
 type ListCompetitorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"` // Optional, filter by platform
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`                    // Optional, filter by platform
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,9 +206,25 @@ func (x *ListCompetitorsRequest) GetPlatform() string {
 	return ""
 }
 
+func (x *ListCompetitorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCompetitorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListCompetitorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Competitors   []*Competitor          `protobuf:"bytes,1,rep,name=competitors,proto3" json:"competitors,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -248,6 +266,20 @@ func (x *ListCompetitorsResponse) GetCompetitors() []*Competitor {
 	return nil
 }
 
+func (x *ListCompetitorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCompetitorsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type UpdateCompetitorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -391,6 +423,8 @@ type GetCompetitorMetricsRequest struct {
 	CompetitorId  string                 `protobuf:"bytes,2,opt,name=competitor_id,json=competitorId,proto3" json:"competitor_id,omitempty"`
 	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -453,9 +487,25 @@ func (x *GetCompetitorMetricsRequest) GetEndDate() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GetCompetitorMetricsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCompetitorMetricsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetCompetitorMetricsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Metrics       []*CompetitorMetric    `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -497,6 +547,20 @@ func (x *GetCompetitorMetricsResponse) GetMetrics() []*CompetitorMetric {
 	return nil
 }
 
+func (x *GetCompetitorMetricsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetCompetitorMetricsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type CompareMetricsRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	TenantId        string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -1448,12 +1512,18 @@ const file_competitor_pb_competitor_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
 	"\x14GetCompetitorRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
-	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\"Q\n" +
+	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\"\x8d\x01\n" +
 	"\x16ListCompetitorsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
-	"\bplatform\x18\x02 \x01(\tR\bplatform\"S\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x9c\x01\n" +
 	"\x17ListCompetitorsResponse\x128\n" +
-	"\vcompetitors\x18\x01 \x03(\v2\x16.competitor.CompetitorR\vcompetitors\"\xb8\x02\n" +
+	"\vcompetitors\x18\x01 \x03(\v2\x16.competitor.CompetitorR\vcompetitors\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xb8\x02\n" +
 	"\x17UpdateCompetitorRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
 	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\x12\x12\n" +
@@ -1467,15 +1537,21 @@ const file_competitor_pb_competitor_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"[\n" +
 	"\x17DeleteCompetitorRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
-	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\"\xd1\x01\n" +
+	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\"\x8d\x02\n" +
 	"\x1bGetCompetitorMetricsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
 	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\x129\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"V\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x9f\x01\n" +
 	"\x1cGetCompetitorMetricsResponse\x126\n" +
-	"\ametrics\x18\x01 \x03(\v2\x1c.competitor.CompetitorMetricR\ametrics\"\x93\x02\n" +
+	"\ametrics\x18\x01 \x03(\v2\x1c.competitor.CompetitorMetricR\ametrics\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x93\x02\n" +
 	"\x15CompareMetricsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12#\n" +
 	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\x129\n" +
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/donaldnash/go-competitor/common/db"
@@ -12,13 +14,14 @@ import (
 
 // CompetitorRepository defines the interface for competitor data access
 type CompetitorRepository interface {
-	GetCompetitors(ctx context.Context, tenantID string) ([]Competitor, error)
+	GetCompetitors(ctx context.Context, tenantID string, page db.Page) ([]Competitor, db.PageInfo, error)
 	GetCompetitor(ctx context.Context, tenantID, competitorID string) (*Competitor, error)
 	AddCompetitor(ctx context.Context, competitor *Competitor) (*Competitor, error)
 	UpdateCompetitor(ctx context.Context, competitor *Competitor) (*Competitor, error)
 	DeleteCompetitor(ctx context.Context, tenantID, competitorID string) error
-	GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) ([]CompetitorMetric, error)
+	GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time, page db.Page) ([]CompetitorMetric, db.PageInfo, error)
 	UpdateCompetitorMetrics(ctx context.Context, tenantID, competitorID string, metrics []CompetitorMetric) (int, error)
+	GetComparison(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) (*db.Comparison, error)
 }
 
 // Competitor represents a competitor entity
@@ -33,6 +36,7 @@ type Competitor struct {
 // CompetitorMetric represents engagement metrics for a competitor
 type CompetitorMetric struct {
 	ID             string    `json:"id"`
+	TenantID       string    `json:"tenant_id"`
 	CompetitorID   string    `json:"competitor_id"`
 	PostID         string    `json:"post_id"`
 	Likes          int       `json:"likes"`
@@ -65,14 +69,14 @@ func NewSupabaseCompetitorRepository(tenantID string) (*SupabaseCompetitorReposi
 	}, nil
 }
 
-// GetCompetitors retrieves all competitors for the current tenant
-func (r *SupabaseCompetitorRepository) GetCompetitors(ctx context.Context, tenantID string) ([]Competitor, error) {
+// GetCompetitors retrieves a page of the competitors for the current tenant. The zero page retrieves all of them.
+func (r *SupabaseCompetitorRepository) GetCompetitors(ctx context.Context, tenantID string, page db.Page) ([]Competitor, db.PageInfo, error) {
 	var competitors []Competitor
-	err := r.client.Query("competitors").Select("*").Execute(&competitors)
+	info, err := r.client.Query("competitors").Select("*").ExecutePage(ctx, page, &competitors)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get competitors: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get competitors: %w", err)
 	}
-	return competitors, nil
+	return competitors, info, nil
 }
 
 // GetCompetitor retrieves a specific competitor
@@ -81,14 +85,14 @@ func (r *SupabaseCompetitorRepository) GetCompetitor(ctx context.Context, tenant
 	err := r.client.Query("competitors").
 		Select("*").
 		Where("id", "eq", competitorID).
-		Execute(&competitors)
+		Execute(ctx, &competitors)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get competitor: %w", err)
 	}
 
 	if len(competitors) == 0 {
-		return nil, errors.New("competitor not found")
+		return nil, fmt.Errorf("competitor %w", db.ErrNotFound)
 	}
 
 	return &competitors[0], nil
@@ -125,7 +129,7 @@ func (r *SupabaseCompetitorRepository) UpdateCompetitor(ctx context.Context, com
 	competitor.TenantID = existing.TenantID
 	competitor.CreatedAt = existing.CreatedAt
 
-	err = r.client.Update(ctx, "competitors", "id", competitor.ID, competitor)
+	_, err = r.client.Update(ctx, db.TenantScope(competitor.TenantID), "competitors", "id", competitor.ID, competitor)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update competitor: %w", err)
 	}
@@ -141,7 +145,7 @@ func (r *SupabaseCompetitorRepository) DeleteCompetitor(ctx context.Context, ten
 		return err
 	}
 
-	err = r.client.Delete(ctx, "competitors", "id", competitorID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "competitors", "id", competitorID)
 	if err != nil {
 		return fmt.Errorf("failed to delete competitor: %w", err)
 	}
@@ -149,31 +153,38 @@ func (r *SupabaseCompetitorRepository) DeleteCompetitor(ctx context.Context, ten
 	return nil
 }
 
-// GetCompetitorMetrics retrieves metrics for a specific competitor within a date range
-func (r *SupabaseCompetitorRepository) GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) ([]CompetitorMetric, error) {
+// GetCompetitorMetrics retrieves a page of the metrics for a specific competitor within a date range.
+// The zero page retrieves all of them.
+func (r *SupabaseCompetitorRepository) GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time,
+	page db.Page) ([]CompetitorMetric, db.PageInfo, error) {
+
 	// First verify the competitor exists and belongs to the tenant
 	_, err := r.GetCompetitor(ctx, tenantID, competitorID)
 	if err != nil {
-		return nil, err
+		return nil, db.PageInfo{}, err
 	}
 
 	var metrics []CompetitorMetric
-	err = r.client.Query("competitor_metrics").
+	info, err := r.client.Query("competitor_metrics").
 		Select("*").
 		Where("competitor_id", "eq", competitorID).
 		Where("posted_at", "gte", startDate.Format(time.RFC3339)).
 		Where("posted_at", "lte", endDate.Format(time.RFC3339)).
 		Order("posted_at", false).
-		Execute(&metrics)
+		ExecutePage(ctx, page, &metrics)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get competitor metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get competitor metrics: %w", err)
 	}
 
-	return metrics, nil
+	return metrics, info, nil
 }
 
-// UpdateCompetitorMetrics updates metrics for a specific competitor
+// UpdateCompetitorMetrics updates metrics for a specific competitor.
+// Metrics for a post that is already tracked replace the stored values instead of adding a duplicate.
+// The metrics are stored under the given tenant and upserted in batches on (tenant_id, competitor_id,
+// post_id), and a metric that fails doesn't stop the others from being saved. It returns how many were
+// saved, with an error listing the index of each metric that failed.
 func (r *SupabaseCompetitorRepository) UpdateCompetitorMetrics(ctx context.Context, tenantID, competitorID string, metrics []CompetitorMetric) (int, error) {
 	// Verify the competitor exists and belongs to the tenant
 	_, err := r.GetCompetitor(ctx, tenantID, competitorID)
@@ -181,19 +192,84 @@ func (r *SupabaseCompetitorRepository) UpdateCompetitorMetrics(ctx context.Conte
 		return 0, err
 	}
 
-	// Insert each metric
+	if len(metrics) == 0 {
+		return 0, nil
+	}
+
+	// Tracked posts keep their ID, since the upsert would otherwise replace it with the new one
+	existing, err := r.getMetricIDs(ctx, tenantID, competitorID, metrics)
+	if err != nil {
+		return 0, err
+	}
+
 	for i := range metrics {
-		// Set IDs and ensure competitor ID is set
-		if metrics[i].ID == "" {
+		metrics[i].CompetitorID = competitorID
+		metrics[i].TenantID = tenantID
+
+		if id, ok := existing[metrics[i].PostID]; ok {
+			metrics[i].ID = id
+		} else if metrics[i].ID == "" {
 			metrics[i].ID = uuid.New().String()
 		}
-		metrics[i].CompetitorID = competitorID
+	}
+
+	result, err := r.client.UpsertBatch(ctx, "competitor_metrics", metrics, "tenant_id", "competitor_id", "post_id")
+	if err == nil {
+		err = result.Err()
+	}
+	if err != nil {
+		return result.Written, fmt.Errorf("failed to update metrics: %w", err)
+	}
+
+	return result.Written, nil
+}
 
-		err = r.client.Insert(ctx, "competitor_metrics", metrics[i])
+// getMetricIDs looks up the IDs of the metrics a tenant already stores for the posts of a competitor, by post ID
+func (r *SupabaseCompetitorRepository) getMetricIDs(ctx context.Context, tenantID, competitorID string, metrics []CompetitorMetric) (map[string]string, error) {
+	ids := make(map[string]string)
+
+	// Post IDs are looked up in chunks to keep the request URL short
+	const chunkSize = 100
+	for start := 0; start < len(metrics); start += chunkSize {
+		end := min(start+chunkSize, len(metrics))
+
+		quoted := make([]string, 0, end-start)
+		for _, metric := range metrics[start:end] {
+			quoted = append(quoted, strconv.Quote(metric.PostID))
+		}
+
+		var existing []CompetitorMetric
+		err := r.client.Query("competitor_metrics").
+			Select("id", "post_id").
+			Where("tenant_id", "eq", tenantID).
+			Where("competitor_id", "eq", competitorID).
+			Where("post_id", "in", "("+strings.Join(quoted, ",")+")").
+			Execute(ctx, &existing)
 		if err != nil {
-			return i, fmt.Errorf("failed to update metric at index %d: %w", i, err)
+			return nil, fmt.Errorf("failed to look up metrics: %w", err)
 		}
+
+		for _, metric := range existing {
+			ids[metric.PostID] = metric.ID
+		}
+	}
+
+	return ids, nil
+}
+
+// GetComparison aggregates the metrics of a competitor's posts and the tenant's own posts within a
+// date range in the database, with the get_competitor_comparison function
+func (r *SupabaseCompetitorRepository) GetComparison(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) (*db.Comparison, error) {
+	// Verify the competitor exists and belongs to the tenant
+	_, err := r.GetCompetitor(ctx, tenantID, competitorID)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := r.client.CompareCompetitor(ctx, tenantID, competitorID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare metrics: %w", err)
 	}
 
-	return len(metrics), nil
+	return comparison, nil
 }
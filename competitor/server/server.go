@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/competitor/pb"
 	"github.com/donaldnash/go-competitor/competitor/repository"
 	"github.com/donaldnash/go-competitor/competitor/service"
@@ -42,7 +43,7 @@ func (s *CompetitorServer) AddCompetitor(ctx context.Context, req *pb.AddCompeti
 
 	competitor, err := s.service.AddCompetitor(ctx, req.TenantId, req.Name, req.Platform)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return &pb.Competitor{
@@ -67,7 +68,7 @@ func (s *CompetitorServer) GetCompetitor(ctx context.Context, req *pb.GetCompeti
 
 	competitor, err := s.service.GetCompetitor(ctx, req.TenantId, req.CompetitorId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return &pb.Competitor{
@@ -86,9 +87,9 @@ func (s *CompetitorServer) ListCompetitors(ctx context.Context, req *pb.ListComp
 		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
 	}
 
-	competitors, err := s.service.GetCompetitors(ctx, req.TenantId)
+	competitors, info, err := s.service.GetCompetitors(ctx, req.TenantId, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	pbCompetitors := make([]*pb.Competitor, len(competitors))
@@ -104,7 +105,9 @@ func (s *CompetitorServer) ListCompetitors(ctx context.Context, req *pb.ListComp
 	}
 
 	return &pb.ListCompetitorsResponse{
-		Competitors: pbCompetitors,
+		Competitors:   pbCompetitors,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -120,7 +123,7 @@ func (s *CompetitorServer) UpdateCompetitor(ctx context.Context, req *pb.UpdateC
 
 	competitor, err := s.service.UpdateCompetitor(ctx, req.TenantId, req.CompetitorId, req.Name, req.Platform)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return &pb.Competitor{
@@ -145,7 +148,7 @@ func (s *CompetitorServer) DeleteCompetitor(ctx context.Context, req *pb.DeleteC
 
 	err := s.service.DeleteCompetitor(ctx, req.TenantId, req.CompetitorId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return &emptypb.Empty{}, nil
@@ -168,9 +171,10 @@ func (s *CompetitorServer) GetCompetitorMetrics(ctx context.Context, req *pb.Get
 	startDate := req.StartDate.AsTime()
 	endDate := req.EndDate.AsTime()
 
-	metrics, err := s.service.GetCompetitorMetrics(ctx, req.TenantId, req.CompetitorId, startDate, endDate)
+	metrics, info, err := s.service.GetCompetitorMetrics(ctx, req.TenantId, req.CompetitorId, startDate, endDate,
+		db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	pbMetrics := make([]*pb.CompetitorMetric, len(metrics))
@@ -192,7 +196,9 @@ func (s *CompetitorServer) GetCompetitorMetrics(ctx context.Context, req *pb.Get
 	}
 
 	return &pb.GetCompetitorMetricsResponse{
-		Metrics: pbMetrics,
+		Metrics:       pbMetrics,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -231,8 +237,9 @@ func (s *CompetitorServer) TrackCompetitorPost(ctx context.Context, req *pb.Trac
 	metrics := []repository.CompetitorMetric{metric}
 	_, err := s.service.UpdateCompetitorMetrics(ctx, req.TenantId, req.CompetitorId, metrics)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
+	metric = metrics[0]
 
 	// Return the saved metric
 	return &pb.CompetitorMetric{
@@ -268,132 +275,44 @@ func (s *CompetitorServer) CompareMetrics(ctx context.Context, req *pb.CompareMe
 	startDate := req.StartDate.AsTime()
 	endDate := req.EndDate.AsTime()
 
-	// Get competitor metrics
-	competitorMetrics, err := s.service.GetCompetitorMetrics(ctx, req.TenantId, req.CompetitorId, startDate, endDate)
+	// Aggregate both sides in the database
+	comparison, err := s.service.CompareMetrics(ctx, req.TenantId, req.CompetitorId, startDate, endDate)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to get competitor metrics: "+err.Error())
+		return nil, status.Error(db.Code(err), "failed to compare metrics: "+err.Error())
 	}
 
-	// For this example, we'll simulate the personal metrics as just the competitor metrics
-	// In a real implementation, you would fetch the personal metrics from another service
-	var personalMetrics []repository.CompetitorMetric = competitorMetrics
-
-	// Convert competitor metrics to protobuf format
-	pbCompetitorMetrics := make([]*pb.CompetitorMetric, len(competitorMetrics))
-	for i, metric := range competitorMetrics {
-		pbCompetitorMetrics[i] = &pb.CompetitorMetric{
-			Id:               metric.ID,
-			CompetitorId:     metric.CompetitorID,
-			TenantId:         req.TenantId,
-			PostId:           metric.PostID,
-			Likes:            int32(metric.Likes),
-			Shares:           int32(metric.Shares),
-			Comments:         int32(metric.Comments),
-			ClickThroughRate: metric.CTR,
-			AvgWatchTime:     metric.AvgWatchTime,
-			EngagementRate:   metric.EngagementRate,
-			PostedAt:         timestamppb.New(metric.PostedAt),
-			CreatedAt:        timestamppb.New(time.Now()),
-		}
-	}
-
-	// Convert personal metrics to protobuf format
-	pbPersonalMetrics := make([]*pb.PersonalMetric, len(personalMetrics))
-	for i, metric := range personalMetrics {
-		pbPersonalMetrics[i] = &pb.PersonalMetric{
-			Id:               metric.ID,
-			TenantId:         req.TenantId,
-			PostId:           metric.PostID,
-			Likes:            int32(metric.Likes),
-			Shares:           int32(metric.Shares),
-			Comments:         int32(metric.Comments),
-			ClickThroughRate: metric.CTR,
-			AvgWatchTime:     metric.AvgWatchTime,
-			EngagementRate:   metric.EngagementRate,
-			PostedAt:         timestamppb.New(metric.PostedAt),
-			CreatedAt:        timestamppb.New(time.Now()),
-		}
-	}
-
-	// Calculate aggregates
-	var totalCompetitorLikes, totalCompetitorShares, totalCompetitorComments int32
-	var totalPersonalLikes, totalPersonalShares, totalPersonalComments int32
-	var totalCompetitorEngagementRate, totalCompetitorWatchTime float64
-	var totalPersonalEngagementRate, totalPersonalWatchTime float64
-
-	for _, metric := range pbCompetitorMetrics {
-		totalCompetitorLikes += metric.Likes
-		totalCompetitorShares += metric.Shares
-		totalCompetitorComments += metric.Comments
-		totalCompetitorEngagementRate += metric.EngagementRate
-		totalCompetitorWatchTime += metric.AvgWatchTime
-	}
-
-	for _, metric := range pbPersonalMetrics {
-		totalPersonalLikes += metric.Likes
-		totalPersonalShares += metric.Shares
-		totalPersonalComments += metric.Comments
-		totalPersonalEngagementRate += metric.EngagementRate
-		totalPersonalWatchTime += metric.AvgWatchTime
-	}
-
-	// Calculate averages
-	competitorCount := float64(len(pbCompetitorMetrics))
-	personalCount := float64(len(pbPersonalMetrics))
-
-	var avgCompetitorEngagementRate, avgCompetitorWatchTime float64
-	var avgPersonalEngagementRate, avgPersonalWatchTime float64
-
-	if competitorCount > 0 {
-		avgCompetitorEngagementRate = totalCompetitorEngagementRate / competitorCount
-		avgCompetitorWatchTime = totalCompetitorWatchTime / competitorCount
-	}
-
-	if personalCount > 0 {
-		avgPersonalEngagementRate = totalPersonalEngagementRate / personalCount
-		avgPersonalWatchTime = totalPersonalWatchTime / personalCount
-	}
+	// Only the aggregates are returned, so a comparison doesn't load every post's metrics. The competitor's
+	// metrics can be paged through with GetCompetitorMetrics, and personal metrics belong to the engagement service.
+	competitor, personal := comparison.Competitor, comparison.Personal
 
 	// Calculate ratios
 	var likesRatio, sharesRatio, commentsRatio, engagementRateRatio, watchTimeRatio float64
 
-	if totalCompetitorLikes > 0 {
-		likesRatio = float64(totalPersonalLikes) / float64(totalCompetitorLikes)
+	if competitor.TotalLikes > 0 {
+		likesRatio = float64(personal.TotalLikes) / float64(competitor.TotalLikes)
 	}
-	if totalCompetitorShares > 0 {
-		sharesRatio = float64(totalPersonalShares) / float64(totalCompetitorShares)
+	if competitor.TotalShares > 0 {
+		sharesRatio = float64(personal.TotalShares) / float64(competitor.TotalShares)
 	}
-	if totalCompetitorComments > 0 {
-		commentsRatio = float64(totalPersonalComments) / float64(totalCompetitorComments)
+	if competitor.TotalComments > 0 {
+		commentsRatio = float64(personal.TotalComments) / float64(competitor.TotalComments)
 	}
-	if avgCompetitorEngagementRate > 0 {
-		engagementRateRatio = avgPersonalEngagementRate / avgCompetitorEngagementRate
+	if competitor.AvgEngagementRate > 0 {
+		engagementRateRatio = personal.AvgEngagementRate / competitor.AvgEngagementRate
 	}
-	if avgCompetitorWatchTime > 0 {
-		watchTimeRatio = avgPersonalWatchTime / avgCompetitorWatchTime
+	if competitor.AvgWatchTime > 0 {
+		watchTimeRatio = personal.AvgWatchTime / competitor.AvgWatchTime
 	}
 
 	// Build response
 	return &pb.CompareMetricsResponse{
 		Competitor: &pb.CompetitorComparison{
-			Metrics: pbCompetitorMetrics,
-			Aggregates: &pb.MetricAggregates{
-				TotalLikes:        totalCompetitorLikes,
-				TotalShares:       totalCompetitorShares,
-				TotalComments:     totalCompetitorComments,
-				AvgEngagementRate: avgCompetitorEngagementRate,
-				AvgWatchTime:      avgCompetitorWatchTime,
-			},
+			Metrics:    []*pb.CompetitorMetric{},
+			Aggregates: convertAggregatesToProto(competitor),
 		},
 		Personal: &pb.PersonalComparison{
-			Metrics: pbPersonalMetrics,
-			Aggregates: &pb.MetricAggregates{
-				TotalLikes:        totalPersonalLikes,
-				TotalShares:       totalPersonalShares,
-				TotalComments:     totalPersonalComments,
-				AvgEngagementRate: avgPersonalEngagementRate,
-				AvgWatchTime:      avgPersonalWatchTime,
-			},
+			Metrics:    []*pb.PersonalMetric{},
+			Aggregates: convertAggregatesToProto(personal),
 		},
 		Ratios: &pb.ComparisonRatios{
 			LikesRatio:          likesRatio,
@@ -404,3 +323,14 @@ func (s *CompetitorServer) CompareMetrics(ctx context.Context, req *pb.CompareMe
 		},
 	}, nil
 }
+
+// convertAggregatesToProto converts metric aggregates to protobuf format
+func convertAggregatesToProto(aggregates db.MetricAggregates) *pb.MetricAggregates {
+	return &pb.MetricAggregates{
+		TotalLikes:        int32(aggregates.TotalLikes),
+		TotalShares:       int32(aggregates.TotalShares),
+		TotalComments:     int32(aggregates.TotalComments),
+		AvgEngagementRate: aggregates.AvgEngagementRate,
+		AvgWatchTime:      aggregates.AvgWatchTime,
+	}
+}
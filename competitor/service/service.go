@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/competitor/repository"
 )
 
@@ -19,9 +20,9 @@ func NewCompetitorService(repo repository.CompetitorRepository) *CompetitorServi
 	}
 }
 
-// GetCompetitors retrieves all competitors for the current tenant
-func (s *CompetitorService) GetCompetitors(ctx context.Context, tenantID string) ([]repository.Competitor, error) {
-	return s.repo.GetCompetitors(ctx, tenantID)
+// GetCompetitors retrieves a page of the competitors for the current tenant
+func (s *CompetitorService) GetCompetitors(ctx context.Context, tenantID string, page db.Page) ([]repository.Competitor, db.PageInfo, error) {
+	return s.repo.GetCompetitors(ctx, tenantID, page)
 }
 
 // GetCompetitor retrieves details about a specific competitor
@@ -29,9 +30,10 @@ func (s *CompetitorService) GetCompetitor(ctx context.Context, tenantID, competi
 	return s.repo.GetCompetitor(ctx, tenantID, competitorID)
 }
 
-// GetCompetitorMetrics retrieves metrics for a specific competitor
-func (s *CompetitorService) GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) ([]repository.CompetitorMetric, error) {
-	return s.repo.GetCompetitorMetrics(ctx, tenantID, competitorID, startDate, endDate)
+// GetCompetitorMetrics retrieves a page of the metrics for a specific competitor
+func (s *CompetitorService) GetCompetitorMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.CompetitorMetric, db.PageInfo, error) {
+	return s.repo.GetCompetitorMetrics(ctx, tenantID, competitorID, startDate, endDate, page)
 }
 
 // AddCompetitor adds a new competitor for the current tenant
@@ -79,3 +81,8 @@ func (s *CompetitorService) UpdateCompetitorMetrics(ctx context.Context, tenantI
 	// Update the metrics
 	return s.repo.UpdateCompetitorMetrics(ctx, tenantID, competitorID, metrics)
 }
+
+// CompareMetrics aggregates the metrics of a competitor and the tenant's own metrics over a date range
+func (s *CompetitorService) CompareMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) (*db.Comparison, error) {
+	return s.repo.GetComparison(ctx, tenantID, competitorID, startDate, endDate)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/content/pb"
 	"github.com/donaldnash/go-competitor/content/repository"
 	"google.golang.org/grpc"
@@ -15,6 +16,7 @@ import (
 type ContentClient interface {
 	// Content format management
 	GetContentFormats(ctx context.Context, tenantID string) ([]repository.ContentFormat, error)
+	GetContentFormatsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ContentFormat, db.PageInfo, error)
 	GetContentFormat(ctx context.Context, tenantID, formatID string) (*repository.ContentFormat, error)
 	CreateContentFormat(ctx context.Context, tenantID, name, description string) (*repository.ContentFormat, error)
 	UpdateContentFormat(ctx context.Context, tenantID, formatID, name, description string) (*repository.ContentFormat, error)
@@ -26,6 +28,7 @@ type ContentClient interface {
 
 	// Scheduled posts
 	GetScheduledPosts(ctx context.Context, tenantID string) ([]repository.ScheduledPost, error)
+	GetScheduledPostsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledPost, db.PageInfo, error)
 	GetScheduledPost(ctx context.Context, tenantID, postID string) (*repository.ScheduledPost, error)
 	SchedulePost(ctx context.Context, tenantID, content, platform, format string, scheduledTime time.Time) (*repository.ScheduledPost, error)
 	UpdateScheduledPost(ctx context.Context, tenantID, postID, content, platform, format, status string, scheduledTime time.Time) (*repository.ScheduledPost, error)
@@ -64,13 +67,32 @@ func (c *GRPCContentClient) Close() error {
 	return nil
 }
 
-// GetContentFormats retrieves all content formats for the current tenant
+// GetContentFormats retrieves all content formats for the current tenant, reading every page
 func (c *GRPCContentClient) GetContentFormats(ctx context.Context, tenantID string) ([]repository.ContentFormat, error) {
+	var formats []repository.ContentFormat
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetContentFormatsPage(ctx, tenantID, page)
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, batch...)
+		if info.NextToken == "" {
+			return formats, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetContentFormatsPage retrieves a page of the content formats for the current tenant
+func (c *GRPCContentClient) GetContentFormatsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ContentFormat, db.PageInfo, error) {
 	resp, err := c.client.GetContentFormats(ctx, &pb.GetContentFormatsRequest{
-		TenantId: tenantID,
+		TenantId:  tenantID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get content formats: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get content formats: %w", err)
 	}
 
 	formats := make([]repository.ContentFormat, len(resp.Formats))
@@ -78,7 +100,7 @@ func (c *GRPCContentClient) GetContentFormats(ctx context.Context, tenantID stri
 		formats[i] = convertFromPbFormat(f)
 	}
 
-	return formats, nil
+	return formats, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // GetContentFormat retrieves a specific content format
@@ -182,13 +204,32 @@ func (c *GRPCContentClient) UpdateFormatPerformance(ctx context.Context, tenantI
 	return int(resp.UpdatedCount), nil
 }
 
-// GetScheduledPosts retrieves all scheduled posts for the current tenant
+// GetScheduledPosts retrieves all scheduled posts for the current tenant, reading every page
 func (c *GRPCContentClient) GetScheduledPosts(ctx context.Context, tenantID string) ([]repository.ScheduledPost, error) {
+	var posts []repository.ScheduledPost
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetScheduledPostsPage(ctx, tenantID, page)
+		if err != nil {
+			return nil, err
+		}
+		posts = append(posts, batch...)
+		if info.NextToken == "" {
+			return posts, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetScheduledPostsPage retrieves a page of the scheduled posts for the current tenant
+func (c *GRPCContentClient) GetScheduledPostsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledPost, db.PageInfo, error) {
 	resp, err := c.client.GetScheduledPosts(ctx, &pb.GetScheduledPostsRequest{
-		TenantId: tenantID,
+		TenantId:  tenantID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scheduled posts: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scheduled posts: %w", err)
 	}
 
 	posts := make([]repository.ScheduledPost, len(resp.Posts))
@@ -196,7 +237,7 @@ func (c *GRPCContentClient) GetScheduledPosts(ctx context.Context, tenantID stri
 		posts[i] = convertFromPbPost(p)
 	}
 
-	return posts, nil
+	return posts, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // GetScheduledPost retrieves a specific scheduled post
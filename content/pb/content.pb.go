@@ -25,6 +25,8 @@ const (
 type GetContentFormatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,10 +68,26 @@ func (x *GetContentFormatsRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetContentFormatsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetContentFormatsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // Response for getting all content formats
 type GetContentFormatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Formats       []*ContentFormat       `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -111,6 +129,20 @@ func (x *GetContentFormatsResponse) GetFormats() []*ContentFormat {
 	return nil
 }
 
+func (x *GetContentFormatsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetContentFormatsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Request for getting a specific content format
 type GetContentFormatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -751,6 +783,8 @@ func (x *UpdateFormatPerformanceResponse) GetUpdatedCount() int32 {
 type GetScheduledPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -792,10 +826,26 @@ func (x *GetScheduledPostsRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetScheduledPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetScheduledPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 // Response for getting all scheduled posts
 type GetScheduledPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Posts         []*ScheduledPost       `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -837,6 +887,20 @@ func (x *GetScheduledPostsResponse) GetPosts() []*ScheduledPost {
 	return nil
 }
 
+func (x *GetScheduledPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetScheduledPostsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 // Request for getting a specific scheduled post
 type GetScheduledPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1682,11 +1746,17 @@ var File_content_pb_content_proto protoreflect.FileDescriptor
 
 const file_content_pb_content_proto_rawDesc = "" +
 	"\n" +
-	"\x18content/pb/content.proto\x12\acontent\"7\n" +
+	"\x18content/pb/content.proto\x12\acontent\"s\n" +
 	"\x18GetContentFormatsRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"M\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x96\x01\n" +
 	"\x19GetContentFormatsResponse\x120\n" +
-	"\aformats\x18\x01 \x03(\v2\x16.content.ContentFormatR\aformats\"S\n" +
+	"\aformats\x18\x01 \x03(\v2\x16.content.ContentFormatR\aformats\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"S\n" +
 	"\x17GetContentFormatRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
 	"\tformat_id\x18\x02 \x01(\tR\bformatId\"J\n" +
@@ -1723,11 +1793,17 @@ const file_content_pb_content_proto_rawDesc = "" +
 	"\tformat_id\x18\x02 \x01(\tR\bformatId\x12<\n" +
 	"\vperformance\x18\x03 \x03(\v2\x1a.content.FormatPerformanceR\vperformance\"F\n" +
 	"\x1fUpdateFormatPerformanceResponse\x12#\n" +
-	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"7\n" +
+	"\rupdated_count\x18\x01 \x01(\x05R\fupdatedCount\"s\n" +
 	"\x18GetScheduledPostsRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"I\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x92\x01\n" +
 	"\x19GetScheduledPostsResponse\x12,\n" +
-	"\x05posts\x18\x01 \x03(\v2\x16.content.ScheduledPostR\x05posts\"O\n" +
+	"\x05posts\x18\x01 \x03(\v2\x16.content.ScheduledPostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"O\n" +
 	"\x17GetScheduledPostRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
 	"\apost_id\x18\x02 \x01(\tR\x06postId\"F\n" +
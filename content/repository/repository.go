@@ -13,14 +13,14 @@ import (
 // Common errors
 var (
 	ErrTenantIDRequired = errors.New("tenant ID is required")
-	ErrFormatNotFound   = errors.New("content format not found")
-	ErrPostNotFound     = errors.New("scheduled post not found")
+	ErrFormatNotFound   = fmt.Errorf("content format %w", db.ErrNotFound)
+	ErrPostNotFound     = fmt.Errorf("scheduled post %w", db.ErrNotFound)
 )
 
 // ContentRepository defines the interface for content data access
 type ContentRepository interface {
 	// Content format management
-	GetContentFormats(ctx context.Context, tenantID string) ([]ContentFormat, error)
+	GetContentFormats(ctx context.Context, tenantID string, page db.Page) ([]ContentFormat, db.PageInfo, error)
 	GetContentFormat(ctx context.Context, tenantID, formatID string) (*ContentFormat, error)
 	CreateContentFormat(ctx context.Context, format *ContentFormat) (*ContentFormat, error)
 	UpdateContentFormat(ctx context.Context, format *ContentFormat) (*ContentFormat, error)
@@ -31,7 +31,7 @@ type ContentRepository interface {
 	UpdateFormatPerformance(ctx context.Context, tenantID, formatID string, performance []FormatPerformance) (int, error)
 
 	// Scheduled posts
-	GetScheduledPosts(ctx context.Context, tenantID string) ([]ScheduledPost, error)
+	GetScheduledPosts(ctx context.Context, tenantID string, page db.Page) ([]ScheduledPost, db.PageInfo, error)
 	GetScheduledPost(ctx context.Context, tenantID, postID string) (*ScheduledPost, error)
 	CreateScheduledPost(ctx context.Context, post *ScheduledPost) (*ScheduledPost, error)
 	UpdateScheduledPost(ctx context.Context, post *ScheduledPost) (*ScheduledPost, error)
@@ -94,18 +94,18 @@ func NewSupabaseContentRepository(tenantID string) (*SupabaseContentRepository,
 	}, nil
 }
 
-// GetContentFormats retrieves all content formats for the current tenant
-func (r *SupabaseContentRepository) GetContentFormats(ctx context.Context, tenantID string) ([]ContentFormat, error) {
+// GetContentFormats retrieves a page of the content formats for the current tenant. The zero page retrieves all of them.
+func (r *SupabaseContentRepository) GetContentFormats(ctx context.Context, tenantID string, page db.Page) ([]ContentFormat, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, ErrTenantIDRequired
+		return nil, db.PageInfo{}, ErrTenantIDRequired
 	}
 
 	var formats []ContentFormat
-	err := r.client.Query("content_formats").Select("*").Execute(&formats)
+	info, err := r.client.Query("content_formats").Select("*").ExecutePage(ctx, page, &formats)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get content formats: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get content formats: %w", err)
 	}
-	return formats, nil
+	return formats, info, nil
 }
 
 // GetContentFormat retrieves a specific content format
@@ -122,7 +122,7 @@ func (r *SupabaseContentRepository) GetContentFormat(ctx context.Context, tenant
 	err := r.client.Query("content_formats").
 		Select("*").
 		Where("id", "eq", formatID).
-		Execute(&formats)
+		Execute(ctx, &formats)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get content format: %w", err)
@@ -181,7 +181,7 @@ func (r *SupabaseContentRepository) UpdateContentFormat(ctx context.Context, for
 	format.CreatedAt = existing.CreatedAt
 	format.UpdatedAt = time.Now()
 
-	err = r.client.Update(ctx, "content_formats", "id", format.ID, format)
+	_, err = r.client.Update(ctx, db.TenantScope(format.TenantID), "content_formats", "id", format.ID, format)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update content format: %w", err)
 	}
@@ -205,7 +205,7 @@ func (r *SupabaseContentRepository) DeleteContentFormat(ctx context.Context, ten
 		return err
 	}
 
-	err = r.client.Delete(ctx, "content_formats", "id", formatID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "content_formats", "id", formatID)
 	if err != nil {
 		return fmt.Errorf("failed to delete content format: %w", err)
 	}
@@ -236,7 +236,7 @@ func (r *SupabaseContentRepository) GetFormatPerformance(ctx context.Context, te
 		Where("measurement_date", "gte", startDate.Format(time.RFC3339)).
 		Where("measurement_date", "lte", endDate.Format(time.RFC3339)).
 		Order("measurement_date", false).
-		Execute(&performance)
+		Execute(ctx, &performance)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get format performance: %w", err)
@@ -282,18 +282,22 @@ func (r *SupabaseContentRepository) UpdateFormatPerformance(ctx context.Context,
 	return len(performance), nil
 }
 
-// GetScheduledPosts retrieves all scheduled posts for the current tenant
-func (r *SupabaseContentRepository) GetScheduledPosts(ctx context.Context, tenantID string) ([]ScheduledPost, error) {
+// GetScheduledPosts retrieves a page of the scheduled posts for the current tenant, soonest first.
+// The zero page retrieves all of them.
+func (r *SupabaseContentRepository) GetScheduledPosts(ctx context.Context, tenantID string, page db.Page) ([]ScheduledPost, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, ErrTenantIDRequired
+		return nil, db.PageInfo{}, ErrTenantIDRequired
 	}
 
 	var posts []ScheduledPost
-	err := r.client.Query("scheduled_posts").Select("*").Execute(&posts)
+	info, err := r.client.Query("scheduled_posts").
+		Select("*").
+		Order("scheduled_time", false).
+		ExecutePage(ctx, page, &posts)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scheduled posts: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scheduled posts: %w", err)
 	}
-	return posts, nil
+	return posts, info, nil
 }
 
 // GetScheduledPost retrieves a specific scheduled post
@@ -310,7 +314,7 @@ func (r *SupabaseContentRepository) GetScheduledPost(ctx context.Context, tenant
 	err := r.client.Query("scheduled_posts").
 		Select("*").
 		Where("id", "eq", postID).
-		Execute(&posts)
+		Execute(ctx, &posts)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scheduled post: %w", err)
@@ -375,7 +379,7 @@ func (r *SupabaseContentRepository) UpdateScheduledPost(ctx context.Context, pos
 	post.CreatedAt = existing.CreatedAt
 	post.UpdatedAt = time.Now()
 
-	err = r.client.Update(ctx, "scheduled_posts", "id", post.ID, post)
+	_, err = r.client.Update(ctx, db.TenantScope(post.TenantID), "scheduled_posts", "id", post.ID, post)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update scheduled post: %w", err)
 	}
@@ -399,7 +403,7 @@ func (r *SupabaseContentRepository) DeleteScheduledPost(ctx context.Context, ten
 		return err
 	}
 
-	err = r.client.Delete(ctx, "scheduled_posts", "id", postID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "scheduled_posts", "id", postID)
 	if err != nil {
 		return fmt.Errorf("failed to delete scheduled post: %w", err)
 	}
@@ -418,7 +422,7 @@ func (r *SupabaseContentRepository) GetPostsDue(ctx context.Context, tenantID st
 		Select("*").
 		Where("status", "eq", "Pending").
 		Where("scheduled_time", "lte", before.Format(time.RFC3339)).
-		Execute(&posts)
+		Execute(ctx, &posts)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get posts due: %w", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/content/pb"
 	"github.com/donaldnash/go-competitor/content/repository"
 	"github.com/donaldnash/go-competitor/content/service"
@@ -22,9 +23,9 @@ func NewContentServer(service service.ContentService) *ContentServer {
 	}
 }
 
-// GetContentFormats returns all content formats for a tenant
+// GetContentFormats returns a page of the content formats for a tenant
 func (s *ContentServer) GetContentFormats(ctx context.Context, req *pb.GetContentFormatsRequest) (*pb.GetContentFormatsResponse, error) {
-	formats, err := s.service.GetContentFormats(ctx, req.TenantId)
+	formats, info, err := s.service.GetContentFormats(ctx, req.TenantId, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +36,9 @@ func (s *ContentServer) GetContentFormats(ctx context.Context, req *pb.GetConten
 	}
 
 	return &pb.GetContentFormatsResponse{
-		Formats: pbFormats,
+		Formats:       pbFormats,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -131,9 +134,9 @@ func (s *ContentServer) UpdateFormatPerformance(ctx context.Context, req *pb.Upd
 	}, nil
 }
 
-// GetScheduledPosts returns all scheduled posts for a tenant
+// GetScheduledPosts returns a page of the scheduled posts for a tenant
 func (s *ContentServer) GetScheduledPosts(ctx context.Context, req *pb.GetScheduledPostsRequest) (*pb.GetScheduledPostsResponse, error) {
-	posts, err := s.service.GetScheduledPosts(ctx, req.TenantId)
+	posts, info, err := s.service.GetScheduledPosts(ctx, req.TenantId, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +147,9 @@ func (s *ContentServer) GetScheduledPosts(ctx context.Context, req *pb.GetSchedu
 	}
 
 	return &pb.GetScheduledPostsResponse{
-		Posts: pbPosts,
+		Posts:         pbPosts,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
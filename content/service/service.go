@@ -5,13 +5,14 @@ import (
 	"errors"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/content/repository"
 )
 
 // ContentService defines the interface for content service operations
 type ContentService interface {
 	// Content format management
-	GetContentFormats(ctx context.Context, tenantID string) ([]repository.ContentFormat, error)
+	GetContentFormats(ctx context.Context, tenantID string, page db.Page) ([]repository.ContentFormat, db.PageInfo, error)
 	GetContentFormat(ctx context.Context, tenantID, formatID string) (*repository.ContentFormat, error)
 	CreateContentFormat(ctx context.Context, tenantID, name, description string) (*repository.ContentFormat, error)
 	UpdateContentFormat(ctx context.Context, tenantID, formatID, name, description string) (*repository.ContentFormat, error)
@@ -22,7 +23,7 @@ type ContentService interface {
 	UpdateFormatPerformance(ctx context.Context, tenantID, formatID string, performance []repository.FormatPerformance) (int, error)
 
 	// Scheduled posts
-	GetScheduledPosts(ctx context.Context, tenantID string) ([]repository.ScheduledPost, error)
+	GetScheduledPosts(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledPost, db.PageInfo, error)
 	GetScheduledPost(ctx context.Context, tenantID, postID string) (*repository.ScheduledPost, error)
 	SchedulePost(ctx context.Context, tenantID, content, platform, format string, scheduledTime time.Time) (*repository.ScheduledPost, error)
 	UpdateScheduledPost(ctx context.Context, tenantID, postID, content, platform, format, status string, scheduledTime time.Time) (*repository.ScheduledPost, error)
@@ -46,13 +47,13 @@ func NewContentService(repo repository.ContentRepository) (ContentService, error
 	}, nil
 }
 
-// GetContentFormats retrieves all content formats for the current tenant
-func (s *contentService) GetContentFormats(ctx context.Context, tenantID string) ([]repository.ContentFormat, error) {
+// GetContentFormats retrieves a page of the content formats for the current tenant
+func (s *contentService) GetContentFormats(ctx context.Context, tenantID string, page db.Page) ([]repository.ContentFormat, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetContentFormats(ctx, tenantID)
+	return s.repo.GetContentFormats(ctx, tenantID, page)
 }
 
 // GetContentFormat retrieves a specific content format
@@ -163,13 +164,13 @@ func (s *contentService) UpdateFormatPerformance(ctx context.Context, tenantID,
 	return s.repo.UpdateFormatPerformance(ctx, tenantID, formatID, performance)
 }
 
-// GetScheduledPosts retrieves all scheduled posts for the current tenant
-func (s *contentService) GetScheduledPosts(ctx context.Context, tenantID string) ([]repository.ScheduledPost, error) {
+// GetScheduledPosts retrieves a page of the scheduled posts for the current tenant
+func (s *contentService) GetScheduledPosts(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledPost, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetScheduledPosts(ctx, tenantID)
+	return s.repo.GetScheduledPosts(ctx, tenantID, page)
 }
 
 // GetScheduledPost retrieves a specific scheduled post
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/engagement/pb"
 	"github.com/donaldnash/go-competitor/engagement/repository"
 	"github.com/donaldnash/go-competitor/engagement/service"
@@ -16,6 +17,7 @@ import (
 // EngagementClient defines the interface for client communication with the engagement service
 type EngagementClient interface {
 	GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]repository.PersonalMetric, error)
+	GetPersonalMetricsPage(ctx context.Context, tenantID string, startDate, endDate time.Time, page db.Page) ([]repository.PersonalMetric, db.PageInfo, error)
 	AddPersonalMetric(ctx context.Context, tenantID string, metric *repository.PersonalMetric) (*repository.PersonalMetric, error)
 	UpdatePersonalMetric(ctx context.Context, tenantID string, metric *repository.PersonalMetric) (*repository.PersonalMetric, error)
 	DeletePersonalMetric(ctx context.Context, tenantID, metricID string) error
@@ -50,15 +52,36 @@ func (c *GrpcEngagementClient) Close() error {
 	return c.conn.Close()
 }
 
-// GetPersonalMetrics retrieves personal metrics for a date range
+// GetPersonalMetrics retrieves all personal metrics for a date range, reading every page
 func (c *GrpcEngagementClient) GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]repository.PersonalMetric, error) {
+	var metrics []repository.PersonalMetric
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetPersonalMetricsPage(ctx, tenantID, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, batch...)
+		if info.NextToken == "" {
+			return metrics, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetPersonalMetricsPage retrieves a page of the personal metrics for a date range
+func (c *GrpcEngagementClient) GetPersonalMetricsPage(ctx context.Context, tenantID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.PersonalMetric, db.PageInfo, error) {
+
 	resp, err := c.client.GetPersonalMetrics(ctx, &pb.GetPersonalMetricsRequest{
 		TenantId:  tenantID,
 		StartDate: timestamppb.New(startDate),
 		EndDate:   timestamppb.New(endDate),
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get personal metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get personal metrics: %w", err)
 	}
 
 	metrics := make([]repository.PersonalMetric, len(resp.Metrics))
@@ -78,7 +101,7 @@ func (c *GrpcEngagementClient) GetPersonalMetrics(ctx context.Context, tenantID
 		}
 	}
 
-	return metrics, nil
+	return metrics, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // AddPersonalMetric adds a new personal metric
@@ -13,7 +13,7 @@ import (
 // EngagementRepository defines the interface for engagement data access
 type EngagementRepository interface {
 	// Personal metrics
-	GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]PersonalMetric, error)
+	GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time, page db.Page) ([]PersonalMetric, db.PageInfo, error)
 	AddPersonalMetric(ctx context.Context, metric *PersonalMetric) (*PersonalMetric, error)
 	UpdatePersonalMetric(ctx context.Context, metric *PersonalMetric) (*PersonalMetric, error)
 	DeletePersonalMetric(ctx context.Context, tenantID, metricID string) error
@@ -96,22 +96,24 @@ func NewSupabaseEngagementRepository(tenantID string) (*SupabaseEngagementReposi
 	}, nil
 }
 
-// GetPersonalMetrics retrieves personal metrics for a date range
-func (r *SupabaseEngagementRepository) GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]PersonalMetric, error) {
+// GetPersonalMetrics retrieves a page of the personal metrics for a date range. The zero page retrieves all of them.
+func (r *SupabaseEngagementRepository) GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time,
+	page db.Page) ([]PersonalMetric, db.PageInfo, error) {
+
 	var metrics []PersonalMetric
-	err := r.client.Query("personal_metrics").
+	info, err := r.client.Query("personal_metrics").
 		Select("*").
 		Where("tenant_id", "eq", tenantID).
 		Where("posted_at", "gte", startDate.Format(time.RFC3339)).
 		Where("posted_at", "lte", endDate.Format(time.RFC3339)).
 		Order("posted_at", false).
-		Execute(&metrics)
+		ExecutePage(ctx, page, &metrics)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get personal metrics: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get personal metrics: %w", err)
 	}
 
-	return metrics, nil
+	return metrics, info, nil
 }
 
 // AddPersonalMetric adds a new personal metric
@@ -141,14 +143,14 @@ func (r *SupabaseEngagementRepository) UpdatePersonalMetric(ctx context.Context,
 		Select("*").
 		Where("id", "eq", metric.ID).
 		Where("tenant_id", "eq", r.client.TenantID).
-		Execute(&metrics)
+		Execute(ctx, &metrics)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify personal metric: %w", err)
 	}
 
 	if len(metrics) == 0 {
-		return nil, errors.New("personal metric not found")
+		return nil, fmt.Errorf("personal metric %w", db.ErrNotFound)
 	}
 
 	// Keep original tenant ID and created date
@@ -156,7 +158,7 @@ func (r *SupabaseEngagementRepository) UpdatePersonalMetric(ctx context.Context,
 	metric.TenantID = existing.TenantID
 	metric.CreatedAt = existing.CreatedAt
 
-	err = r.client.Update(ctx, "personal_metrics", "id", metric.ID, metric)
+	_, err = r.client.Update(ctx, db.TenantScope(metric.TenantID), "personal_metrics", "id", metric.ID, metric)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update personal metric: %w", err)
 	}
@@ -172,17 +174,17 @@ func (r *SupabaseEngagementRepository) DeletePersonalMetric(ctx context.Context,
 		Select("*").
 		Where("id", "eq", metricID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&metrics)
+		Execute(ctx, &metrics)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify personal metric: %w", err)
 	}
 
 	if len(metrics) == 0 {
-		return errors.New("personal metric not found")
+		return fmt.Errorf("personal metric %w", db.ErrNotFound)
 	}
 
-	err = r.client.Delete(ctx, "personal_metrics", "id", metricID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "personal_metrics", "id", metricID)
 	if err != nil {
 		return fmt.Errorf("failed to delete personal metric: %w", err)
 	}
@@ -190,40 +192,16 @@ func (r *SupabaseEngagementRepository) DeletePersonalMetric(ctx context.Context,
 	return nil
 }
 
-// CompareMetrics compares personal metrics with competitor metrics
+// CompareMetrics compares personal metrics with competitor metrics.
+// Both sides are aggregated in the database with the get_competitor_comparison function.
 func (r *SupabaseEngagementRepository) CompareMetrics(ctx context.Context, tenantID, competitorID string, startDate, endDate time.Time) (*ComparisonResult, error) {
-	// Get personal metrics
-	personalMetrics, err := r.GetPersonalMetrics(ctx, tenantID, startDate, endDate)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get competitor metrics
-	var competitorMetrics []struct {
-		Likes          int     `json:"likes"`
-		Shares         int     `json:"shares"`
-		Comments       int     `json:"comments"`
-		EngagementRate float64 `json:"engagement_rate"`
-		AvgWatchTime   float64 `json:"avg_watch_time"`
-	}
-
-	err = r.client.Query("competitor_metrics").
-		Select("likes", "shares", "comments", "engagement_rate", "avg_watch_time").
-		Where("tenant_id", "eq", tenantID).
-		Where("competitor_id", "eq", competitorID).
-		Where("posted_at", "gte", startDate.Format(time.RFC3339)).
-		Where("posted_at", "lte", endDate.Format(time.RFC3339)).
-		Execute(&competitorMetrics)
-
+	comparison, err := r.client.CompareCompetitor(ctx, tenantID, competitorID, startDate, endDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get competitor metrics: %w", err)
+		return nil, fmt.Errorf("failed to compare metrics: %w", err)
 	}
 
-	// Calculate aggregates for personal metrics
-	personalAggregate := calculatePersonalAggregates(personalMetrics)
-
-	// Calculate aggregates for competitor metrics
-	competitorAggregate := calculateCompetitorAggregates(competitorMetrics)
+	competitorAggregate := MetricAggregate(comparison.Competitor)
+	personalAggregate := MetricAggregate(comparison.Personal)
 
 	// Calculate ratios
 	ratios := calculateRatios(competitorAggregate, personalAggregate)
@@ -235,70 +213,6 @@ func (r *SupabaseEngagementRepository) CompareMetrics(ctx context.Context, tenan
 	}, nil
 }
 
-// calculatePersonalAggregates calculates aggregates for personal metrics
-func calculatePersonalAggregates(metrics []PersonalMetric) MetricAggregate {
-	var totalLikes, totalShares, totalComments int
-	var sumEngagementRate, sumWatchTime float64
-
-	for _, m := range metrics {
-		totalLikes += m.Likes
-		totalShares += m.Shares
-		totalComments += m.Comments
-		sumEngagementRate += m.EngagementRate
-		sumWatchTime += m.AvgWatchTime
-	}
-
-	count := float64(len(metrics))
-	var avgEngagementRate, avgWatchTime float64
-	if count > 0 {
-		avgEngagementRate = sumEngagementRate / count
-		avgWatchTime = sumWatchTime / count
-	}
-
-	return MetricAggregate{
-		TotalLikes:        totalLikes,
-		TotalShares:       totalShares,
-		TotalComments:     totalComments,
-		AvgEngagementRate: avgEngagementRate,
-		AvgWatchTime:      avgWatchTime,
-	}
-}
-
-// calculateCompetitorAggregates calculates aggregates for competitor metrics
-func calculateCompetitorAggregates(metrics []struct {
-	Likes          int     `json:"likes"`
-	Shares         int     `json:"shares"`
-	Comments       int     `json:"comments"`
-	EngagementRate float64 `json:"engagement_rate"`
-	AvgWatchTime   float64 `json:"avg_watch_time"`
-}) MetricAggregate {
-	var totalLikes, totalShares, totalComments int
-	var sumEngagementRate, sumWatchTime float64
-
-	for _, m := range metrics {
-		totalLikes += m.Likes
-		totalShares += m.Shares
-		totalComments += m.Comments
-		sumEngagementRate += m.EngagementRate
-		sumWatchTime += m.AvgWatchTime
-	}
-
-	count := float64(len(metrics))
-	var avgEngagementRate, avgWatchTime float64
-	if count > 0 {
-		avgEngagementRate = sumEngagementRate / count
-		avgWatchTime = sumWatchTime / count
-	}
-
-	return MetricAggregate{
-		TotalLikes:        totalLikes,
-		TotalShares:       totalShares,
-		TotalComments:     totalComments,
-		AvgEngagementRate: avgEngagementRate,
-		AvgWatchTime:      avgWatchTime,
-	}
-}
-
 // calculateRatios calculates ratios between competitor and personal metrics
 func calculateRatios(competitor, personal MetricAggregate) ComparisonRatio {
 	var likesRatio, sharesRatio, commentsRatio, engagementRateRatio, watchTimeRatio float64
@@ -335,7 +249,7 @@ func calculateRatios(competitor, personal MetricAggregate) ComparisonRatio {
 // GetEngagementTrends retrieves engagement trends over time
 func (r *SupabaseEngagementRepository) GetEngagementTrends(ctx context.Context, tenantID, period string, startDate, endDate time.Time) ([]EngagementTrend, error) {
 	// Get all metrics for the period
-	personalMetrics, err := r.GetPersonalMetrics(ctx, tenantID, startDate, endDate)
+	personalMetrics, _, err := r.GetPersonalMetrics(ctx, tenantID, startDate, endDate, db.Page{})
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/engagement/pb"
 	"github.com/donaldnash/go-competitor/engagement/repository"
 	"github.com/donaldnash/go-competitor/engagement/service"
@@ -56,7 +57,7 @@ func (s *EngagementServer) TrackPost(ctx context.Context, req *pb.TrackPostReque
 	// Add the metric to the repository
 	result, err := s.service.AddPersonalMetric(ctx, req.TenantId, metric)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert the result to a protobuf response
@@ -77,9 +78,9 @@ func (s *EngagementServer) GetPersonalMetrics(ctx context.Context, req *pb.GetPe
 	endDate := req.EndDate.AsTime()
 
 	// Call the service to get the metrics
-	metrics, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate)
+	metrics, info, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert to protobuf response
@@ -89,7 +90,9 @@ func (s *EngagementServer) GetPersonalMetrics(ctx context.Context, req *pb.GetPe
 	}
 
 	return &pb.GetPersonalMetricsResponse{
-		Metrics: protoMetrics,
+		Metrics:       protoMetrics,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -104,9 +107,9 @@ func (s *EngagementServer) UpdatePostMetrics(ctx context.Context, req *pb.Update
 	}
 
 	// Get existing metrics for the post
-	metrics, err := s.service.GetPersonalMetrics(ctx, req.TenantId, time.Time{}, time.Now().Add(24*time.Hour))
+	metrics, _, err := s.service.GetPersonalMetrics(ctx, req.TenantId, time.Time{}, time.Now().Add(24*time.Hour), db.Page{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Find the metric for the specified post ID
@@ -133,7 +136,7 @@ func (s *EngagementServer) UpdatePostMetrics(ctx context.Context, req *pb.Update
 	// Update the metric
 	updatedMetric, err := s.service.UpdatePersonalMetric(ctx, req.TenantId, existingMetric)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return convertToProtoMetric(updatedMetric), nil
@@ -150,9 +153,9 @@ func (s *EngagementServer) DeletePostMetrics(ctx context.Context, req *pb.Delete
 	}
 
 	// Get existing metrics for the post
-	metrics, err := s.service.GetPersonalMetrics(ctx, req.TenantId, time.Time{}, time.Now().Add(24*time.Hour))
+	metrics, _, err := s.service.GetPersonalMetrics(ctx, req.TenantId, time.Time{}, time.Now().Add(24*time.Hour), db.Page{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Find and delete metrics for the specified post ID
@@ -161,7 +164,7 @@ func (s *EngagementServer) DeletePostMetrics(ctx context.Context, req *pb.Delete
 		if metric.PostID == req.PostId {
 			err = s.service.DeletePersonalMetric(ctx, req.TenantId, metric.ID)
 			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
+				return nil, status.Error(db.Code(err), err.Error())
 			}
 			deleted = true
 		}
@@ -191,7 +194,7 @@ func (s *EngagementServer) GetEngagementTrends(ctx context.Context, req *pb.GetE
 	// Get engagement trends from the service
 	trends, err := s.service.GetEngagementTrends(ctx, req.TenantId, req.Interval, startDate, endDate)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert to protobuf response
@@ -260,9 +263,9 @@ func (s *EngagementServer) GetTopPerformingPosts(ctx context.Context, req *pb.Ge
 	endDate := req.EndDate.AsTime()
 
 	// Get metrics for the time period
-	metrics, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate)
+	metrics, _, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate, db.Page{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Sort by the requested metric (simple implementation)
@@ -305,9 +308,9 @@ func (s *EngagementServer) GetEngagementByDayTime(ctx context.Context, req *pb.G
 	endDate := req.EndDate.AsTime()
 
 	// Get metrics for the time period
-	metrics, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate)
+	metrics, _, err := s.service.GetPersonalMetrics(ctx, req.TenantId, startDate, endDate, db.Page{})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Group by day and hour (simple implementation)
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/engagement/repository"
 )
 
@@ -21,9 +22,10 @@ func NewEngagementService(repo repository.EngagementRepository) *EngagementServi
 	}
 }
 
-// GetPersonalMetrics retrieves personal metrics for a date range
-func (s *EngagementService) GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]repository.PersonalMetric, error) {
-	return s.repo.GetPersonalMetrics(ctx, tenantID, startDate, endDate)
+// GetPersonalMetrics retrieves a page of the personal metrics for a date range
+func (s *EngagementService) GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time,
+	page db.Page) ([]repository.PersonalMetric, db.PageInfo, error) {
+	return s.repo.GetPersonalMetrics(ctx, tenantID, startDate, endDate, page)
 }
 
 // AddPersonalMetric adds a new personal metric
@@ -79,7 +81,7 @@ func (s *EngagementService) GetEngagementInsights(ctx context.Context, tenantID
 	}
 
 	// Get personal metrics
-	metrics, err := s.GetPersonalMetrics(ctx, tenantID, startDate, endDate)
+	metrics, _, err := s.GetPersonalMetrics(ctx, tenantID, startDate, endDate, db.Page{})
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/notification/pb"
 	"github.com/donaldnash/go-competitor/notification/repository"
 	"github.com/donaldnash/go-competitor/notification/service"
@@ -17,6 +18,7 @@ type NotificationClient interface {
 	// Notification management
 	CreateNotification(ctx context.Context, userID, notificationType, title, message, priority, metadata string) (*repository.Notification, error)
 	GetNotifications(ctx context.Context, tenantID, userID, status string) ([]repository.Notification, error)
+	GetNotificationsPage(ctx context.Context, tenantID, userID, status string, page db.Page) ([]repository.Notification, db.PageInfo, error)
 	MarkNotificationAsRead(ctx context.Context, tenantID, notificationID string) error
 	ArchiveNotification(ctx context.Context, tenantID, notificationID string) error
 	DeleteNotification(ctx context.Context, tenantID, notificationID string) error
@@ -24,12 +26,14 @@ type NotificationClient interface {
 	// Alert threshold management
 	CreateAlertThreshold(ctx context.Context, userID, name, metricType, comparisonType string, value float64, percentage bool, period string) (*repository.AlertThreshold, error)
 	GetAlertThresholds(ctx context.Context, tenantID, metricType string) ([]repository.AlertThreshold, error)
+	GetAlertThresholdsPage(ctx context.Context, tenantID, metricType string, page db.Page) ([]repository.AlertThreshold, db.PageInfo, error)
 	UpdateAlertThreshold(ctx context.Context, thresholdID, tenantID, userID, name, metricType, comparisonType string, value float64, percentage bool, period, status string) (*repository.AlertThreshold, error)
 	DeleteAlertThreshold(ctx context.Context, tenantID, thresholdID string) error
 
 	// Scheduled report management
 	CreateScheduledReport(ctx context.Context, userID, name, description, reportType, schedule, filters, deliveryType, emailAddresses string) (*repository.ScheduledReport, error)
 	GetScheduledReports(ctx context.Context, tenantID string) ([]repository.ScheduledReport, error)
+	GetScheduledReportsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledReport, db.PageInfo, error)
 	UpdateScheduledReport(ctx context.Context, reportID, tenantID, userID, name, description, reportType, schedule, filters, deliveryType, emailAddresses, status string) (*repository.ScheduledReport, error)
 	DeleteScheduledReport(ctx context.Context, tenantID, reportID string) error
 
@@ -117,22 +121,41 @@ func (c *grpcNotificationClient) CreateNotification(ctx context.Context, userID,
 	}, nil
 }
 
-// GetNotifications retrieves notifications based on filters
+// GetNotifications retrieves notifications based on filters, reading every page
 func (c *grpcNotificationClient) GetNotifications(ctx context.Context, tenantID, userID, status string) ([]repository.Notification, error) {
+	var notifications []repository.Notification
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetNotificationsPage(ctx, tenantID, userID, status, page)
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, batch...)
+		if info.NextToken == "" {
+			return notifications, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetNotificationsPage retrieves a page of notifications based on filters
+func (c *grpcNotificationClient) GetNotificationsPage(ctx context.Context, tenantID, userID, status string, page db.Page) ([]repository.Notification, db.PageInfo, error) {
 	if c.service != nil {
-		return c.service.GetNotifications(ctx, tenantID, userID, status)
+		return c.service.GetNotifications(ctx, tenantID, userID, status, page)
 	}
 
 	// Use gRPC client
 	req := &pb.GetNotificationsRequest{
-		TenantId: tenantID,
-		UserId:   userID,
-		Status:   status,
+		TenantId:  tenantID,
+		UserId:    userID,
+		Status:    status,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	}
 
 	resp, err := c.client.GetNotifications(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get notifications: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get notifications: %w", err)
 	}
 
 	// Convert proto notifications to repository notifications
@@ -153,7 +176,7 @@ func (c *grpcNotificationClient) GetNotifications(ctx context.Context, tenantID,
 		}
 	}
 
-	return notifications, nil
+	return notifications, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // MarkNotificationAsRead marks a notification as read
@@ -256,21 +279,40 @@ func (c *grpcNotificationClient) CreateAlertThreshold(ctx context.Context, userI
 	}, nil
 }
 
-// GetAlertThresholds retrieves alert thresholds based on filters
+// GetAlertThresholds retrieves alert thresholds based on filters, reading every page
 func (c *grpcNotificationClient) GetAlertThresholds(ctx context.Context, tenantID, metricType string) ([]repository.AlertThreshold, error) {
+	var thresholds []repository.AlertThreshold
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetAlertThresholdsPage(ctx, tenantID, metricType, page)
+		if err != nil {
+			return nil, err
+		}
+		thresholds = append(thresholds, batch...)
+		if info.NextToken == "" {
+			return thresholds, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetAlertThresholdsPage retrieves a page of alert thresholds based on filters
+func (c *grpcNotificationClient) GetAlertThresholdsPage(ctx context.Context, tenantID, metricType string, page db.Page) ([]repository.AlertThreshold, db.PageInfo, error) {
 	if c.service != nil {
-		return c.service.GetAlertThresholds(ctx, tenantID, metricType)
+		return c.service.GetAlertThresholds(ctx, tenantID, metricType, page)
 	}
 
 	// Use gRPC client
 	req := &pb.GetAlertThresholdsRequest{
 		TenantId:   tenantID,
 		MetricType: metricType,
+		PageSize:   int32(page.Size),
+		PageToken:  page.Token,
 	}
 
 	resp, err := c.client.GetAlertThresholds(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get alert thresholds: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get alert thresholds: %w", err)
 	}
 
 	// Convert proto thresholds to repository thresholds
@@ -292,7 +334,7 @@ func (c *grpcNotificationClient) GetAlertThresholds(ctx context.Context, tenantI
 		}
 	}
 
-	return thresholds, nil
+	return thresholds, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // UpdateAlertThreshold updates an alert threshold
@@ -385,20 +427,39 @@ func (c *grpcNotificationClient) CreateScheduledReport(ctx context.Context, user
 	return convertScheduledReportFromProto(resp), nil
 }
 
-// GetScheduledReports retrieves scheduled reports
+// GetScheduledReports retrieves scheduled reports, reading every page
 func (c *grpcNotificationClient) GetScheduledReports(ctx context.Context, tenantID string) ([]repository.ScheduledReport, error) {
+	var reports []repository.ScheduledReport
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetScheduledReportsPage(ctx, tenantID, page)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, batch...)
+		if info.NextToken == "" {
+			return reports, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetScheduledReportsPage retrieves a page of scheduled reports
+func (c *grpcNotificationClient) GetScheduledReportsPage(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledReport, db.PageInfo, error) {
 	if c.service != nil {
-		return c.service.GetScheduledReports(ctx, tenantID)
+		return c.service.GetScheduledReports(ctx, tenantID, page)
 	}
 
 	// Use gRPC client
 	req := &pb.GetScheduledReportsRequest{
-		TenantId: tenantID,
+		TenantId:  tenantID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	}
 
 	resp, err := c.client.GetScheduledReports(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scheduled reports: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scheduled reports: %w", err)
 	}
 
 	// Convert proto reports to repository reports
@@ -407,7 +468,7 @@ func (c *grpcNotificationClient) GetScheduledReports(ctx context.Context, tenant
 		reports[i] = *convertScheduledReportFromProto(r)
 	}
 
-	return reports, nil
+	return reports, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // UpdateScheduledReport updates a scheduled report
@@ -244,6 +244,8 @@ type GetNotificationsRequest struct {
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -299,9 +301,25 @@ func (x *GetNotificationsRequest) GetStatus() string {
 	return ""
 }
 
+func (x *GetNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type NotificationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -343,6 +361,20 @@ func (x *NotificationsResponse) GetNotifications() []*Notification {
 	return nil
 }
 
+func (x *NotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *NotificationsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type NotificationStatusRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	TenantId       string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -684,6 +716,8 @@ type GetAlertThresholdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 	MetricType    string                 `protobuf:"bytes,2,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -732,9 +766,25 @@ func (x *GetAlertThresholdsRequest) GetMetricType() string {
 	return ""
 }
 
+func (x *GetAlertThresholdsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetAlertThresholdsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type AlertThresholdsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Thresholds    []*AlertThreshold      `protobuf:"bytes,1,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -776,6 +826,20 @@ func (x *AlertThresholdsResponse) GetThresholds() []*AlertThreshold {
 	return nil
 }
 
+func (x *AlertThresholdsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *AlertThresholdsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type UpdateAlertThresholdRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ThresholdId    string                 `protobuf:"bytes,1,opt,name=threshold_id,json=thresholdId,proto3" json:"threshold_id,omitempty"`
@@ -1212,6 +1276,8 @@ func (x *CreateScheduledReportRequest) GetEmailAddresses() string {
 type GetScheduledReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1253,9 +1319,25 @@ func (x *GetScheduledReportsRequest) GetTenantId() string {
 	return ""
 }
 
+func (x *GetScheduledReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetScheduledReportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ScheduledReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reports       []*ScheduledReport     `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1297,6 +1379,20 @@ func (x *ScheduledReportsResponse) GetReports() []*ScheduledReport {
 	return nil
 }
 
+func (x *ScheduledReportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ScheduledReportsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type UpdateScheduledReportRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ReportId       string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
@@ -1589,13 +1685,19 @@ const file_notification_pb_notification_proto_rawDesc = "" +
 	"\x05title\x18\x04 \x01(\tR\x05title\x12\x18\n" +
 	"\amessage\x18\x05 \x01(\tR\amessage\x12\x1a\n" +
 	"\bpriority\x18\x06 \x01(\tR\bpriority\x12\x1a\n" +
-	"\bmetadata\x18\a \x01(\tR\bmetadata\"g\n" +
+	"\bmetadata\x18\a \x01(\tR\bmetadata\"\xa3\x01\n" +
 	"\x17GetNotificationsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\tR\x06status\"Y\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xa2\x01\n" +
 	"\x15NotificationsResponse\x12@\n" +
-	"\rnotifications\x18\x01 \x03(\v2\x1a.notification.NotificationR\rnotifications\"a\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x1a.notification.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"a\n" +
 	"\x19NotificationStatusRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12'\n" +
 	"\x0fnotification_id\x18\x02 \x01(\tR\x0enotificationId\"J\n" +
@@ -1632,15 +1734,21 @@ const file_notification_pb_notification_proto_rawDesc = "" +
 	"\n" +
 	"percentage\x18\a \x01(\bR\n" +
 	"percentage\x12\x16\n" +
-	"\x06period\x18\b \x01(\tR\x06period\"Y\n" +
+	"\x06period\x18\b \x01(\tR\x06period\"\x95\x01\n" +
 	"\x19GetAlertThresholdsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1f\n" +
 	"\vmetric_type\x18\x02 \x01(\tR\n" +
-	"metricType\"W\n" +
+	"metricType\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xa0\x01\n" +
 	"\x17AlertThresholdsResponse\x12<\n" +
 	"\n" +
 	"thresholds\x18\x01 \x03(\v2\x1c.notification.AlertThresholdR\n" +
-	"thresholds\"\xba\x02\n" +
+	"thresholds\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xba\x02\n" +
 	"\x1bUpdateAlertThresholdRequest\x12!\n" +
 	"\fthreshold_id\x18\x01 \x01(\tR\vthresholdId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
@@ -1689,11 +1797,17 @@ const file_notification_pb_notification_proto_rawDesc = "" +
 	"\bschedule\x18\x06 \x01(\tR\bschedule\x12\x18\n" +
 	"\afilters\x18\a \x01(\tR\afilters\x12#\n" +
 	"\rdelivery_type\x18\b \x01(\tR\fdeliveryType\x12'\n" +
-	"\x0femail_addresses\x18\t \x01(\tR\x0eemailAddresses\"9\n" +
+	"\x0femail_addresses\x18\t \x01(\tR\x0eemailAddresses\"u\n" +
 	"\x1aGetScheduledReportsRequest\x12\x1b\n" +
-	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"S\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9c\x01\n" +
 	"\x18ScheduledReportsResponse\x127\n" +
-	"\areports\x18\x01 \x03(\v2\x1d.notification.ScheduledReportR\areports\"\xe4\x02\n" +
+	"\areports\x18\x01 \x03(\v2\x1d.notification.ScheduledReportR\areports\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xe4\x02\n" +
 	"\x1cUpdateScheduledReportRequest\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
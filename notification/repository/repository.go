@@ -15,19 +15,19 @@ import (
 type NotificationRepository interface {
 	// Notification management
 	CreateNotification(ctx context.Context, notification *Notification) (*Notification, error)
-	GetNotifications(ctx context.Context, tenantID string, userID string, status string) ([]Notification, error)
+	GetNotifications(ctx context.Context, tenantID string, userID string, status string, page db.Page) ([]Notification, db.PageInfo, error)
 	UpdateNotificationStatus(ctx context.Context, tenantID, notificationID, status string) error
 	DeleteNotification(ctx context.Context, tenantID, notificationID string) error
 
 	// Alert threshold management
 	CreateAlertThreshold(ctx context.Context, threshold *AlertThreshold) (*AlertThreshold, error)
-	GetAlertThresholds(ctx context.Context, tenantID string, metricType string) ([]AlertThreshold, error)
+	GetAlertThresholds(ctx context.Context, tenantID string, metricType string, page db.Page) ([]AlertThreshold, db.PageInfo, error)
 	UpdateAlertThreshold(ctx context.Context, threshold *AlertThreshold) (*AlertThreshold, error)
 	DeleteAlertThreshold(ctx context.Context, tenantID, thresholdID string) error
 
 	// Scheduled report management
 	CreateScheduledReport(ctx context.Context, report *ScheduledReport) (*ScheduledReport, error)
-	GetScheduledReports(ctx context.Context, tenantID string) ([]ScheduledReport, error)
+	GetScheduledReports(ctx context.Context, tenantID string, page db.Page) ([]ScheduledReport, db.PageInfo, error)
 	UpdateScheduledReport(ctx context.Context, report *ScheduledReport) (*ScheduledReport, error)
 	DeleteScheduledReport(ctx context.Context, tenantID, reportID string) error
 
@@ -132,8 +132,10 @@ func (r *SupabaseNotificationRepository) CreateNotification(ctx context.Context,
 	return notification, nil
 }
 
-// GetNotifications retrieves notifications based on filters
-func (r *SupabaseNotificationRepository) GetNotifications(ctx context.Context, tenantID string, userID string, status string) ([]Notification, error) {
+// GetNotifications retrieves a page of notifications based on filters. The zero page retrieves all of them.
+func (r *SupabaseNotificationRepository) GetNotifications(ctx context.Context, tenantID string, userID string, status string,
+	page db.Page) ([]Notification, db.PageInfo, error) {
+
 	var notifications []Notification
 	query := r.client.Query("notifications").Select("*")
 
@@ -153,12 +155,12 @@ func (r *SupabaseNotificationRepository) GetNotifications(ctx context.Context, t
 	// Order by creation time, newest first
 	query = query.Order("created_at", true)
 
-	err := query.Execute(&notifications)
+	info, err := query.ExecutePage(ctx, page, &notifications)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get notifications: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get notifications: %w", err)
 	}
 
-	return notifications, nil
+	return notifications, info, nil
 }
 
 // UpdateNotificationStatus updates a notification's status
@@ -169,14 +171,14 @@ func (r *SupabaseNotificationRepository) UpdateNotificationStatus(ctx context.Co
 		Select("*").
 		Where("id", "eq", notificationID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&notifications)
+		Execute(ctx, &notifications)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify notification: %w", err)
 	}
 
 	if len(notifications) == 0 {
-		return errors.New("notification not found")
+		return fmt.Errorf("notification %w", db.ErrNotFound)
 	}
 
 	// Update the status
@@ -185,7 +187,7 @@ func (r *SupabaseNotificationRepository) UpdateNotificationStatus(ctx context.Co
 		"updated_at": time.Now(),
 	}
 
-	err = r.client.Update(ctx, "notifications", "id", notificationID, updateData)
+	_, err = r.client.Update(ctx, db.TenantScope(tenantID), "notifications", "id", notificationID, updateData)
 	if err != nil {
 		return fmt.Errorf("failed to update notification status: %w", err)
 	}
@@ -201,18 +203,18 @@ func (r *SupabaseNotificationRepository) DeleteNotification(ctx context.Context,
 		Select("*").
 		Where("id", "eq", notificationID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&notifications)
+		Execute(ctx, &notifications)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify notification: %w", err)
 	}
 
 	if len(notifications) == 0 {
-		return errors.New("notification not found")
+		return fmt.Errorf("notification %w", db.ErrNotFound)
 	}
 
 	// First, try to perform a hard delete
-	err = r.client.Delete(ctx, "notifications", "id", notificationID)
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "notifications", "id", notificationID)
 
 	// If the delete operation is not supported or fails, fall back to soft delete by updating status
 	if err != nil {
@@ -223,7 +225,7 @@ func (r *SupabaseNotificationRepository) DeleteNotification(ctx context.Context,
 			"updated_at": time.Now(),
 		}
 
-		err = r.client.Update(ctx, "notifications", "id", notificationID, updateData)
+		_, err = r.client.Update(ctx, db.TenantScope(tenantID), "notifications", "id", notificationID, updateData)
 		if err != nil {
 			return fmt.Errorf("failed to soft delete notification: %w", err)
 		}
@@ -255,8 +257,10 @@ func (r *SupabaseNotificationRepository) CreateAlertThreshold(ctx context.Contex
 	return threshold, nil
 }
 
-// GetAlertThresholds retrieves alert thresholds based on filters
-func (r *SupabaseNotificationRepository) GetAlertThresholds(ctx context.Context, tenantID string, metricType string) ([]AlertThreshold, error) {
+// GetAlertThresholds retrieves a page of alert thresholds based on filters. The zero page retrieves all of them.
+func (r *SupabaseNotificationRepository) GetAlertThresholds(ctx context.Context, tenantID string, metricType string,
+	page db.Page) ([]AlertThreshold, db.PageInfo, error) {
+
 	var thresholds []AlertThreshold
 	query := r.client.Query("alert_thresholds").Select("*")
 
@@ -271,12 +275,12 @@ func (r *SupabaseNotificationRepository) GetAlertThresholds(ctx context.Context,
 	// Only get active thresholds
 	query = query.Where("status", "eq", "active")
 
-	err := query.Execute(&thresholds)
+	info, err := query.ExecutePage(ctx, page, &thresholds)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get alert thresholds: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get alert thresholds: %w", err)
 	}
 
-	return thresholds, nil
+	return thresholds, info, nil
 }
 
 // UpdateAlertThreshold updates an alert threshold
@@ -287,20 +291,20 @@ func (r *SupabaseNotificationRepository) UpdateAlertThreshold(ctx context.Contex
 		Select("*").
 		Where("id", "eq", threshold.ID).
 		Where("tenant_id", "eq", threshold.TenantID).
-		Execute(&thresholds)
+		Execute(ctx, &thresholds)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify alert threshold: %w", err)
 	}
 
 	if len(thresholds) == 0 {
-		return nil, errors.New("alert threshold not found")
+		return nil, fmt.Errorf("alert threshold %w", db.ErrNotFound)
 	}
 
 	// Update the threshold
 	threshold.UpdatedAt = time.Now()
 
-	err = r.client.Update(ctx, "alert_thresholds", "id", threshold.ID, threshold)
+	_, err = r.client.Update(ctx, db.TenantScope(threshold.TenantID), "alert_thresholds", "id", threshold.ID, threshold)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update alert threshold: %w", err)
 	}
@@ -316,14 +320,14 @@ func (r *SupabaseNotificationRepository) DeleteAlertThreshold(ctx context.Contex
 		Select("*").
 		Where("id", "eq", thresholdID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&thresholds)
+		Execute(ctx, &thresholds)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify alert threshold: %w", err)
 	}
 
 	if len(thresholds) == 0 {
-		return errors.New("alert threshold not found")
+		return fmt.Errorf("alert threshold %w", db.ErrNotFound)
 	}
 
 	// Update status to inactive instead of deleting
@@ -332,7 +336,7 @@ func (r *SupabaseNotificationRepository) DeleteAlertThreshold(ctx context.Contex
 		"updated_at": time.Now(),
 	}
 
-	err = r.client.Update(ctx, "alert_thresholds", "id", thresholdID, updateData)
+	_, err = r.client.Update(ctx, db.TenantScope(tenantID), "alert_thresholds", "id", thresholdID, updateData)
 	if err != nil {
 		return fmt.Errorf("failed to delete alert threshold: %w", err)
 	}
@@ -370,20 +374,20 @@ func (r *SupabaseNotificationRepository) CreateScheduledReport(ctx context.Conte
 	return report, nil
 }
 
-// GetScheduledReports retrieves scheduled reports
-func (r *SupabaseNotificationRepository) GetScheduledReports(ctx context.Context, tenantID string) ([]ScheduledReport, error) {
+// GetScheduledReports retrieves a page of scheduled reports. The zero page retrieves all of them.
+func (r *SupabaseNotificationRepository) GetScheduledReports(ctx context.Context, tenantID string, page db.Page) ([]ScheduledReport, db.PageInfo, error) {
 	var reports []ScheduledReport
 	query := r.client.Query("scheduled_reports").Select("*")
 
 	// Add tenant filter
 	query = query.Where("tenant_id", "eq", tenantID)
 
-	err := query.Execute(&reports)
+	info, err := query.ExecutePage(ctx, page, &reports)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scheduled reports: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scheduled reports: %w", err)
 	}
 
-	return reports, nil
+	return reports, info, nil
 }
 
 // UpdateScheduledReport updates a scheduled report
@@ -394,14 +398,14 @@ func (r *SupabaseNotificationRepository) UpdateScheduledReport(ctx context.Conte
 		Select("*").
 		Where("id", "eq", report.ID).
 		Where("tenant_id", "eq", report.TenantID).
-		Execute(&reports)
+		Execute(ctx, &reports)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to verify scheduled report: %w", err)
 	}
 
 	if len(reports) == 0 {
-		return nil, errors.New("scheduled report not found")
+		return nil, fmt.Errorf("scheduled report %w", db.ErrNotFound)
 	}
 
 	// Update the report
@@ -416,7 +420,7 @@ func (r *SupabaseNotificationRepository) UpdateScheduledReport(ctx context.Conte
 		report.NextRunAt = nextRun
 	}
 
-	err = r.client.Update(ctx, "scheduled_reports", "id", report.ID, report)
+	_, err = r.client.Update(ctx, db.TenantScope(report.TenantID), "scheduled_reports", "id", report.ID, report)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update scheduled report: %w", err)
 	}
@@ -432,14 +436,14 @@ func (r *SupabaseNotificationRepository) DeleteScheduledReport(ctx context.Conte
 		Select("*").
 		Where("id", "eq", reportID).
 		Where("tenant_id", "eq", tenantID).
-		Execute(&reports)
+		Execute(ctx, &reports)
 
 	if err != nil {
 		return fmt.Errorf("failed to verify scheduled report: %w", err)
 	}
 
 	if len(reports) == 0 {
-		return errors.New("scheduled report not found")
+		return fmt.Errorf("scheduled report %w", db.ErrNotFound)
 	}
 
 	// Update status to inactive instead of deleting
@@ -448,7 +452,7 @@ func (r *SupabaseNotificationRepository) DeleteScheduledReport(ctx context.Conte
 		"updated_at": time.Now(),
 	}
 
-	err = r.client.Update(ctx, "scheduled_reports", "id", reportID, updateData)
+	_, err = r.client.Update(ctx, db.TenantScope(tenantID), "scheduled_reports", "id", reportID, updateData)
 	if err != nil {
 		return fmt.Errorf("failed to delete scheduled report: %w", err)
 	}
@@ -459,7 +463,7 @@ func (r *SupabaseNotificationRepository) DeleteScheduledReport(ctx context.Conte
 // CheckAlertThresholds checks all active alert thresholds and creates notifications if triggered
 func (r *SupabaseNotificationRepository) CheckAlertThresholds(ctx context.Context, tenantID string) ([]Notification, error) {
 	// Get all active alert thresholds
-	thresholds, err := r.GetAlertThresholds(ctx, tenantID, "")
+	thresholds, _, err := r.GetAlertThresholds(ctx, tenantID, "", db.Page{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get alert thresholds: %w", err)
 	}
@@ -506,7 +510,7 @@ func (r *SupabaseNotificationRepository) CheckAlertThresholds(ctx context.Contex
 // ProcessScheduledReports processes all scheduled reports that are due to run
 func (r *SupabaseNotificationRepository) ProcessScheduledReports(ctx context.Context, tenantID string) ([]Notification, error) {
 	// Get all active scheduled reports
-	reports, err := r.GetScheduledReports(ctx, tenantID)
+	reports, _, err := r.GetScheduledReports(ctx, tenantID, db.Page{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scheduled reports: %w", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/notification/pb"
 	"github.com/donaldnash/go-competitor/notification/repository"
 	"github.com/donaldnash/go-competitor/notification/service"
@@ -50,7 +51,7 @@ func (s *NotificationServer) CreateNotification(ctx context.Context, req *pb.Cre
 	// Call service to create notification
 	notification, err := s.service.CreateNotification(ctx, req.UserId, req.Type, req.Title, req.Message, req.Priority, req.Metadata)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create notification: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to create notification: %v", err)
 	}
 
 	// Convert to protobuf message
@@ -64,14 +65,16 @@ func (s *NotificationServer) GetNotifications(ctx context.Context, req *pb.GetNo
 	}
 
 	// Call service to get notifications
-	notifications, err := s.service.GetNotifications(ctx, req.TenantId, req.UserId, req.Status)
+	notifications, info, err := s.service.GetNotifications(ctx, req.TenantId, req.UserId, req.Status, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get notifications: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get notifications: %v", err)
 	}
 
 	// Convert to protobuf response
 	response := &pb.NotificationsResponse{
 		Notifications: make([]*pb.Notification, 0, len(notifications)),
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}
 
 	for _, notification := range notifications {
@@ -94,7 +97,7 @@ func (s *NotificationServer) MarkNotificationAsRead(ctx context.Context, req *pb
 	// Call service to mark notification as read
 	err := s.service.MarkNotificationAsRead(ctx, req.TenantId, req.NotificationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to mark notification as read: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to mark notification as read: %v", err)
 	}
 
 	return &pb.UpdateStatusResponse{
@@ -116,7 +119,7 @@ func (s *NotificationServer) ArchiveNotification(ctx context.Context, req *pb.No
 	// Call service to archive notification
 	err := s.service.ArchiveNotification(ctx, req.TenantId, req.NotificationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to archive notification: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to archive notification: %v", err)
 	}
 
 	return &pb.UpdateStatusResponse{
@@ -138,7 +141,7 @@ func (s *NotificationServer) DeleteNotification(ctx context.Context, req *pb.Not
 	// Call service to delete notification
 	err := s.service.DeleteNotification(ctx, req.TenantId, req.NotificationId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete notification: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to delete notification: %v", err)
 	}
 
 	return &pb.UpdateStatusResponse{
@@ -172,7 +175,7 @@ func (s *NotificationServer) CreateAlertThreshold(ctx context.Context, req *pb.C
 	// Call service to create alert threshold
 	threshold, err := s.service.CreateAlertThreshold(ctx, req.UserId, req.Name, req.MetricType, req.ComparisonType, req.Value, req.Percentage, req.Period)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create alert threshold: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to create alert threshold: %v", err)
 	}
 
 	// Convert to protobuf message
@@ -186,14 +189,16 @@ func (s *NotificationServer) GetAlertThresholds(ctx context.Context, req *pb.Get
 	}
 
 	// Call service to get alert thresholds
-	thresholds, err := s.service.GetAlertThresholds(ctx, req.TenantId, req.MetricType)
+	thresholds, info, err := s.service.GetAlertThresholds(ctx, req.TenantId, req.MetricType, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get alert thresholds: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get alert thresholds: %v", err)
 	}
 
 	// Convert to protobuf response
 	response := &pb.AlertThresholdsResponse{
-		Thresholds: make([]*pb.AlertThreshold, 0, len(thresholds)),
+		Thresholds:    make([]*pb.AlertThreshold, 0, len(thresholds)),
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}
 
 	for _, threshold := range thresholds {
@@ -216,7 +221,7 @@ func (s *NotificationServer) UpdateAlertThreshold(ctx context.Context, req *pb.U
 	// Call service to update alert threshold
 	threshold, err := s.service.UpdateAlertThreshold(ctx, req.ThresholdId, req.TenantId, req.UserId, req.Name, req.MetricType, req.ComparisonType, req.Value, req.Percentage, req.Period, req.Status)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update alert threshold: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to update alert threshold: %v", err)
 	}
 
 	// Convert to protobuf message
@@ -236,7 +241,7 @@ func (s *NotificationServer) DeleteAlertThreshold(ctx context.Context, req *pb.D
 	// Call service to delete alert threshold
 	err := s.service.DeleteAlertThreshold(ctx, req.TenantId, req.ThresholdId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete alert threshold: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to delete alert threshold: %v", err)
 	}
 
 	return &pb.UpdateStatusResponse{
@@ -266,7 +271,7 @@ func (s *NotificationServer) CreateScheduledReport(ctx context.Context, req *pb.
 	// Call service to create scheduled report
 	report, err := s.service.CreateScheduledReport(ctx, req.UserId, req.Name, req.Description, req.ReportType, req.Schedule, req.Filters, req.DeliveryType, req.EmailAddresses)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create scheduled report: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to create scheduled report: %v", err)
 	}
 
 	// Convert to protobuf message
@@ -280,14 +285,16 @@ func (s *NotificationServer) GetScheduledReports(ctx context.Context, req *pb.Ge
 	}
 
 	// Call service to get scheduled reports
-	reports, err := s.service.GetScheduledReports(ctx, req.TenantId)
+	reports, info, err := s.service.GetScheduledReports(ctx, req.TenantId, db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get scheduled reports: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to get scheduled reports: %v", err)
 	}
 
 	// Convert to protobuf response
 	response := &pb.ScheduledReportsResponse{
-		Reports: make([]*pb.ScheduledReport, 0, len(reports)),
+		Reports:       make([]*pb.ScheduledReport, 0, len(reports)),
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}
 
 	for _, report := range reports {
@@ -323,7 +330,7 @@ func (s *NotificationServer) UpdateScheduledReport(ctx context.Context, req *pb.
 		req.Status,
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update scheduled report: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to update scheduled report: %v", err)
 	}
 
 	// Convert to protobuf message
@@ -343,7 +350,7 @@ func (s *NotificationServer) DeleteScheduledReport(ctx context.Context, req *pb.
 	// Call service to delete scheduled report
 	err := s.service.DeleteScheduledReport(ctx, req.TenantId, req.ReportId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete scheduled report: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to delete scheduled report: %v", err)
 	}
 
 	return &pb.UpdateStatusResponse{
@@ -361,7 +368,7 @@ func (s *NotificationServer) CheckAlertThresholds(ctx context.Context, req *pb.C
 	// Call service to check alert thresholds
 	notifications, err := s.service.CheckAlertThresholds(ctx, req.TenantId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check alert thresholds: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to check alert thresholds: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -385,7 +392,7 @@ func (s *NotificationServer) ProcessScheduledReports(ctx context.Context, req *p
 	// Call service to process scheduled reports
 	notifications, err := s.service.ProcessScheduledReports(ctx, req.TenantId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to process scheduled reports: %v", err)
+		return nil, status.Errorf(db.Code(err), "failed to process scheduled reports: %v", err)
 	}
 
 	// Convert to protobuf response
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/notification/repository"
 )
 
@@ -12,20 +13,20 @@ import (
 type NotificationService interface {
 	// Notification management
 	CreateNotification(ctx context.Context, userID, notificationType, title, message, priority string, metadata string) (*repository.Notification, error)
-	GetNotifications(ctx context.Context, tenantID, userID, status string) ([]repository.Notification, error)
+	GetNotifications(ctx context.Context, tenantID, userID, status string, page db.Page) ([]repository.Notification, db.PageInfo, error)
 	MarkNotificationAsRead(ctx context.Context, tenantID, notificationID string) error
 	ArchiveNotification(ctx context.Context, tenantID, notificationID string) error
 	DeleteNotification(ctx context.Context, tenantID, notificationID string) error
 
 	// Alert threshold management
 	CreateAlertThreshold(ctx context.Context, userID, name, metricType, comparisonType string, value float64, percentage bool, period string) (*repository.AlertThreshold, error)
-	GetAlertThresholds(ctx context.Context, tenantID, metricType string) ([]repository.AlertThreshold, error)
+	GetAlertThresholds(ctx context.Context, tenantID, metricType string, page db.Page) ([]repository.AlertThreshold, db.PageInfo, error)
 	UpdateAlertThreshold(ctx context.Context, thresholdID, tenantID, userID, name, metricType, comparisonType string, value float64, percentage bool, period, status string) (*repository.AlertThreshold, error)
 	DeleteAlertThreshold(ctx context.Context, tenantID, thresholdID string) error
 
 	// Scheduled report management
 	CreateScheduledReport(ctx context.Context, userID, name, description, reportType, schedule, filters, deliveryType, emailAddresses string) (*repository.ScheduledReport, error)
-	GetScheduledReports(ctx context.Context, tenantID string) ([]repository.ScheduledReport, error)
+	GetScheduledReports(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledReport, db.PageInfo, error)
 	UpdateScheduledReport(ctx context.Context, reportID, tenantID, userID, name, description, reportType, schedule, filters, deliveryType, emailAddresses, status string) (*repository.ScheduledReport, error)
 	DeleteScheduledReport(ctx context.Context, tenantID, reportID string) error
 
@@ -91,13 +92,13 @@ func (s *notificationService) CreateNotification(ctx context.Context, userID, no
 	return s.repo.CreateNotification(ctx, notification)
 }
 
-// GetNotifications retrieves notifications based on filters
-func (s *notificationService) GetNotifications(ctx context.Context, tenantID, userID, status string) ([]repository.Notification, error) {
+// GetNotifications retrieves a page of notifications based on filters
+func (s *notificationService) GetNotifications(ctx context.Context, tenantID, userID, status string, page db.Page) ([]repository.Notification, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetNotifications(ctx, tenantID, userID, status)
+	return s.repo.GetNotifications(ctx, tenantID, userID, status, page)
 }
 
 // MarkNotificationAsRead marks a notification as read
@@ -180,13 +181,13 @@ func (s *notificationService) CreateAlertThreshold(ctx context.Context, userID,
 	return s.repo.CreateAlertThreshold(ctx, threshold)
 }
 
-// GetAlertThresholds retrieves alert thresholds based on filters
-func (s *notificationService) GetAlertThresholds(ctx context.Context, tenantID, metricType string) ([]repository.AlertThreshold, error) {
+// GetAlertThresholds retrieves a page of alert thresholds based on filters
+func (s *notificationService) GetAlertThresholds(ctx context.Context, tenantID, metricType string, page db.Page) ([]repository.AlertThreshold, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetAlertThresholds(ctx, tenantID, metricType)
+	return s.repo.GetAlertThresholds(ctx, tenantID, metricType, page)
 }
 
 // UpdateAlertThreshold updates an alert threshold
@@ -201,7 +202,7 @@ func (s *notificationService) UpdateAlertThreshold(ctx context.Context, threshol
 	}
 
 	// Get existing threshold to preserve any fields not being updated
-	thresholds, err := s.repo.GetAlertThresholds(ctx, tenantID, "")
+	thresholds, _, err := s.repo.GetAlertThresholds(ctx, tenantID, "", db.Page{})
 	if err != nil {
 		return nil, err
 	}
@@ -303,13 +304,13 @@ func (s *notificationService) CreateScheduledReport(ctx context.Context, userID,
 	return s.repo.CreateScheduledReport(ctx, report)
 }
 
-// GetScheduledReports retrieves scheduled reports
-func (s *notificationService) GetScheduledReports(ctx context.Context, tenantID string) ([]repository.ScheduledReport, error) {
+// GetScheduledReports retrieves a page of scheduled reports
+func (s *notificationService) GetScheduledReports(ctx context.Context, tenantID string, page db.Page) ([]repository.ScheduledReport, db.PageInfo, error) {
 	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
+		return nil, db.PageInfo{}, errors.New("tenant ID is required")
 	}
 
-	return s.repo.GetScheduledReports(ctx, tenantID)
+	return s.repo.GetScheduledReports(ctx, tenantID, page)
 }
 
 // UpdateScheduledReport updates a scheduled report
@@ -324,7 +325,7 @@ func (s *notificationService) UpdateScheduledReport(ctx context.Context, reportI
 	}
 
 	// Get existing reports
-	reports, err := s.repo.GetScheduledReports(ctx, tenantID)
+	reports, _, err := s.repo.GetScheduledReports(ctx, tenantID, db.Page{})
 	if err != nil {
 		return nil, err
 	}
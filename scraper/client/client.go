@@ -2,9 +2,12 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/scraper/pb"
 	"github.com/donaldnash/go-competitor/scraper/repository"
 	"google.golang.org/grpc"
@@ -15,23 +18,63 @@ import (
 // ScraperClient defines the interface for client communication with the scraper service
 type ScraperClient interface {
 	// Job management
-	CreateScraperJob(ctx context.Context, tenantID, platform, targetID string, jobType repository.JobType, schedule repository.ScraperSchedule, metadata map[string]string) (*repository.ScraperJob, error)
+	CreateScraperJob(ctx context.Context, tenantID, platform, targetID string, jobType repository.JobType, schedule repository.ScraperSchedule, priority int, metadata map[string]string, backfillUntil time.Time) (*repository.ScraperJob, error)
 	GetScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error)
+	GetScraperJobTree(ctx context.Context, tenantID, jobID string) (*JobTree, error)
 	ListScraperJobs(ctx context.Context, tenantID, platform string, jobType repository.JobType, status repository.JobStatus) ([]repository.ScraperJob, error)
+	ListScraperJobsPage(ctx context.Context, tenantID, platform string, jobType repository.JobType, status repository.JobStatus, page db.Page) ([]repository.ScraperJob, db.PageInfo, error)
 	CancelScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error)
 	DeleteScraperJob(ctx context.Context, tenantID, jobID string) error
+	RequeueScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error)
+	WatchScraperJobs(ctx context.Context, tenantID, platform, jobID string, handle func(JobEvent) error) error
 
 	// Platform operations
 	ListSupportedPlatforms(ctx context.Context, tenantID string) ([]PlatformInfo, error)
 	GetPlatformStatus(ctx context.Context, tenantID, platform string) (*PlatformStatus, error)
+	GetQueueStatus(ctx context.Context, tenantID string) (*QueueStatus, error)
 
 	// Scraper results
 	GetScrapedData(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time) ([]repository.ScrapedDataItem, error)
+	GetScrapedDataPage(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time, page db.Page) ([]repository.ScrapedDataItem, db.PageInfo, error)
+	ListJobRuns(ctx context.Context, tenantID, jobID string) ([]repository.JobRun, error)
+	ListJobRunsPage(ctx context.Context, tenantID, jobID string, page db.Page) ([]repository.JobRun, db.PageInfo, error)
+	ListComments(ctx context.Context, tenantID, platform, postID string, startDate, endDate time.Time) ([]repository.Comment, error)
+	ListCommentsPage(ctx context.Context, tenantID, platform, postID string, startDate, endDate time.Time, page db.Page) ([]repository.Comment, db.PageInfo, error)
+	ListMentions(ctx context.Context, tenantID, platform string, startDate, endDate time.Time) ([]repository.Mention, error)
+	ListMentionsPage(ctx context.Context, tenantID, platform string, startDate, endDate time.Time, page db.Page) ([]repository.Mention, db.PageInfo, error)
+	GetShareOfVoice(ctx context.Context, tenantID, platform, interval string, startDate, endDate time.Time) ([]ShareOfVoice, error)
+	GetPostVelocity(ctx context.Context, tenantID, platform, targetID, postID string, startDate, endDate time.Time) ([]PostVelocity, error)
+
+	// Captured media
+	ListMediaAssets(ctx context.Context, tenantID, platform, targetID, postID string) ([]repository.MediaAsset, error)
+	ListMediaAssetsPage(ctx context.Context, tenantID, platform, targetID, postID string, page db.Page) ([]repository.MediaAsset, db.PageInfo, error)
+	FindSimilarMedia(ctx context.Context, tenantID, assetID string, maxDistance int) ([]SimilarMedia, error)
+
+	// Data retention
+	ListRetentionPolicies(ctx context.Context, tenantID string) ([]RetentionPolicy, error)
+	SetRetentionPolicy(ctx context.Context, tenantID string, dataType repository.DataType, retentionDays int) (*RetentionPolicy, error)
+	GetStorageUsage(ctx context.Context, tenantID string) ([]repository.StorageUsage, error)
 
 	// Close closes the client connection
 	Close() error
 }
 
+// JobTree contains a job along with the child jobs spawned by its latest run
+type JobTree struct {
+	Job          *repository.ScraperJob
+	Children     []JobTree
+	RollupStatus repository.JobStatus // Status of the job and its children taken together
+}
+
+// JobEvent is a change to a scraper job pushed by WatchScraperJobs
+type JobEvent struct {
+	Job          *repository.ScraperJob // The job after the change
+	RunID        string                 // Run the change belongs to, if any
+	ItemsScraped int                    // Items stored by the run, once it has finished
+	Error        string
+	OccurredAt   time.Time
+}
+
 // PlatformInfo contains information about a supported platform
 type PlatformInfo struct {
 	Name              string
@@ -59,6 +102,80 @@ type PlatformStatus struct {
 	LastChecked   time.Time
 }
 
+// QueueStatus contains the state of the job queue
+type QueueStatus struct {
+	Workers int
+	Queued  int // Across all tenants
+	Running int // Across all tenants
+	Tenants []TenantQueueStatus
+}
+
+// TenantQueueStatus contains the queue state of one tenant
+type TenantQueueStatus struct {
+	TenantID      string
+	Tier          string
+	Weight        int
+	MaxConcurrent int
+	Queued        int
+	Running       int
+	OldestWait    time.Duration
+	AvgWait       time.Duration
+	Dispatched    int
+}
+
+// ShareOfVoice is one brand's share of the attributed mentions in a period
+type ShareOfVoice struct {
+	PeriodStart     time.Time
+	CompetitorID    string // Empty for the tenant's own brand
+	OwnBrand        bool
+	Mentions        int
+	Engagement      int
+	MentionShare    float64
+	EngagementShare float64
+}
+
+// PostVelocity is how fast a post has gained engagement since it was published
+type PostVelocity struct {
+	Platform        string
+	TargetID        string
+	PostID          string
+	PostedAt        time.Time // Zero when unknown, in which case rates start from the first snapshot
+	Likes           int       // Counters of the latest snapshot
+	Shares          int
+	Comments        int
+	Views           int
+	LikesPerHour    float64
+	SharesPerHour   float64
+	CommentsPerHour float64
+	Snapshots       []PostSnapshot // Oldest first
+}
+
+// PostSnapshot is a post's counters when they last changed, with the rates since the previous snapshot
+type PostSnapshot struct {
+	ScrapedAt       time.Time
+	Likes           int
+	Shares          int
+	Comments        int
+	Views           int
+	LikesPerHour    float64
+	SharesPerHour   float64
+	CommentsPerHour float64
+}
+
+// SimilarMedia is a captured image that looks like the one searched for
+type SimilarMedia struct {
+	Asset    repository.MediaAsset
+	Distance int // Bits the perceptual hashes differ in, 0 for the same image
+}
+
+// RetentionPolicy is how long a tenant keeps one type of scraped data
+type RetentionPolicy struct {
+	DataType      repository.DataType
+	RetentionDays int  // 0 keeps the data forever
+	Default       bool // The tenant hasn't set a policy, so the default applies
+	UpdatedAt     time.Time
+}
+
 // GRPCScraperClient implements ScraperClient using gRPC
 type GRPCScraperClient struct {
 	conn   *grpc.ClientConn
@@ -87,9 +204,11 @@ func (c *GRPCScraperClient) Close() error {
 	return nil
 }
 
-// CreateScraperJob creates a new scraper job
+// CreateScraperJob creates a new scraper job. A non-zero backfillUntil makes a posts job
+// walk back through the target's post history to that date first.
 func (c *GRPCScraperClient) CreateScraperJob(ctx context.Context, tenantID, platform, targetID string,
-	jobType repository.JobType, schedule repository.ScraperSchedule, metadata map[string]string) (*repository.ScraperJob, error) {
+	jobType repository.JobType, schedule repository.ScraperSchedule, priority int, metadata map[string]string,
+	backfillUntil time.Time) (*repository.ScraperJob, error) {
 
 	// Convert job type to protobuf format
 	protoJobType := convertJobTypeToProto(jobType)
@@ -117,6 +236,11 @@ func (c *GRPCScraperClient) CreateScraperJob(ctx context.Context, tenantID, plat
 		JobType:  protoJobType,
 		Schedule: protoSchedule,
 		Metadata: metadata,
+		Priority: int32(priority),
+	}
+
+	if !backfillUntil.IsZero() {
+		req.BackfillUntil = timestamppb.New(backfillUntil)
 	}
 
 	// Call the service
@@ -144,20 +268,56 @@ func (c *GRPCScraperClient) GetScraperJob(ctx context.Context, tenantID, jobID s
 	return convertJobFromProto(resp), nil
 }
 
-// ListScraperJobs retrieves all scraper jobs matching the filters
+// GetScraperJobTree retrieves a job along with the child jobs spawned by its latest run and their rolled up status
+func (c *GRPCScraperClient) GetScraperJobTree(ctx context.Context, tenantID, jobID string) (*JobTree, error) {
+	req := &pb.GetScraperJobRequest{
+		TenantId: tenantID,
+		JobId:    jobID,
+	}
+
+	resp, err := c.client.GetScraperJob(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scraper job: %w", err)
+	}
+
+	return convertJobTreeFromProto(resp), nil
+}
+
+// ListScraperJobs retrieves all scraper jobs matching the filters, reading every page
 func (c *GRPCScraperClient) ListScraperJobs(ctx context.Context, tenantID, platform string,
 	jobType repository.JobType, status repository.JobStatus) ([]repository.ScraperJob, error) {
 
+	var jobs []repository.ScraperJob
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.ListScraperJobsPage(ctx, tenantID, platform, jobType, status, page)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, batch...)
+		if info.NextToken == "" {
+			return jobs, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// ListScraperJobsPage retrieves a page of the scraper jobs matching the filters
+func (c *GRPCScraperClient) ListScraperJobsPage(ctx context.Context, tenantID, platform string,
+	jobType repository.JobType, status repository.JobStatus, page db.Page) ([]repository.ScraperJob, db.PageInfo, error) {
+
 	req := &pb.ListScraperJobsRequest{
-		TenantId: tenantID,
-		Platform: platform,
-		JobType:  convertJobTypeToProto(jobType),
-		Status:   convertJobStatusToProto(status),
+		TenantId:  tenantID,
+		Platform:  platform,
+		JobType:   convertJobTypeToProto(jobType),
+		Status:    convertJobStatusToProto(status),
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	}
 
 	resp, err := c.client.ListScraperJobs(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list scraper jobs: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to list scraper jobs: %w", err)
 	}
 
 	// Convert the response to repository format
@@ -166,7 +326,7 @@ func (c *GRPCScraperClient) ListScraperJobs(ctx context.Context, tenantID, platf
 		jobs[i] = *convertJobFromProto(job)
 	}
 
-	return jobs, nil
+	return jobs, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
 }
 
 // CancelScraperJob cancels a scraper job
@@ -199,6 +359,56 @@ func (c *GRPCScraperClient) DeleteScraperJob(ctx context.Context, tenantID, jobI
 	return nil
 }
 
+// RequeueScraperJob moves a dead-lettered job back into the queue
+func (c *GRPCScraperClient) RequeueScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error) {
+	req := &pb.RequeueScraperJobRequest{
+		TenantId: tenantID,
+		JobId:    jobID,
+	}
+
+	resp, err := c.client.RequeueScraperJob(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue scraper job: %w", err)
+	}
+
+	return convertJobFromProto(resp), nil
+}
+
+// WatchScraperJobs calls handle with every change to the tenant's jobs, optionally filtered by platform
+// or job ID, until ctx is cancelled, the stream ends or handle returns an error. Watching a single job
+// starts with its current state.
+func (c *GRPCScraperClient) WatchScraperJobs(ctx context.Context, tenantID, platform, jobID string,
+	handle func(JobEvent) error) error {
+
+	req := &pb.WatchScraperJobsRequest{
+		TenantId: tenantID,
+		Platform: platform,
+		JobId:    jobID,
+	}
+
+	stream, err := c.client.WatchScraperJobs(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to watch scraper jobs: %w", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("failed to receive scraper job event: %w", err)
+		}
+
+		if err := handle(convertJobEventFromProto(event)); err != nil {
+			return err
+		}
+	}
+}
+
 // ListSupportedPlatforms retrieves all supported platforms
 func (c *GRPCScraperClient) ListSupportedPlatforms(ctx context.Context, tenantID string) ([]PlatformInfo, error) {
 	req := &pb.ListSupportedPlatformsRequest{
@@ -267,13 +477,69 @@ func (c *GRPCScraperClient) GetPlatformStatus(ctx context.Context, tenantID, pla
 	return status, nil
 }
 
-// GetScrapedData retrieves scraped data for a specific job
+// GetQueueStatus retrieves the tenant's queued and running jobs along with the totals across all tenants
+func (c *GRPCScraperClient) GetQueueStatus(ctx context.Context, tenantID string) (*QueueStatus, error) {
+	req := &pb.GetQueueStatusRequest{
+		TenantId: tenantID,
+	}
+
+	resp, err := c.client.GetQueueStatus(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue status: %w", err)
+	}
+
+	status := &QueueStatus{
+		Workers: int(resp.Workers),
+		Queued:  int(resp.Queued),
+		Running: int(resp.Running),
+		Tenants: make([]TenantQueueStatus, len(resp.Tenants)),
+	}
+
+	for i, tenant := range resp.Tenants {
+		status.Tenants[i] = TenantQueueStatus{
+			TenantID:      tenant.TenantId,
+			Tier:          tenant.Tier,
+			Weight:        int(tenant.Weight),
+			MaxConcurrent: int(tenant.MaxConcurrent),
+			Queued:        int(tenant.Queued),
+			Running:       int(tenant.Running),
+			OldestWait:    time.Duration(tenant.OldestWaitSeconds * float64(time.Second)),
+			AvgWait:       time.Duration(tenant.AvgWaitSeconds * float64(time.Second)),
+			Dispatched:    int(tenant.Dispatched),
+		}
+	}
+
+	return status, nil
+}
+
+// GetScrapedData retrieves all scraped data for a specific job, reading every page
 func (c *GRPCScraperClient) GetScrapedData(ctx context.Context, tenantID, jobID string,
 	startDate, endDate time.Time) ([]repository.ScrapedDataItem, error) {
 
+	var items []repository.ScrapedDataItem
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.GetScrapedDataPage(ctx, tenantID, jobID, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, batch...)
+		if info.NextToken == "" {
+			return items, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// GetScrapedDataPage retrieves a page of the scraped data for a specific job
+func (c *GRPCScraperClient) GetScrapedDataPage(ctx context.Context, tenantID, jobID string,
+	startDate, endDate time.Time, page db.Page) ([]repository.ScrapedDataItem, db.PageInfo, error) {
+
 	req := &pb.GetScrapedDataRequest{
-		TenantId: tenantID,
-		JobId:    jobID,
+		TenantId:  tenantID,
+		JobId:     jobID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
 	}
 
 	if !startDate.IsZero() {
@@ -286,7 +552,7 @@ func (c *GRPCScraperClient) GetScrapedData(ctx context.Context, tenantID, jobID
 
 	resp, err := c.client.GetScrapedData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scraped data: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scraped data: %w", err)
 	}
 
 	// Convert the response to repository format
@@ -295,7 +561,362 @@ func (c *GRPCScraperClient) GetScrapedData(ctx context.Context, tenantID, jobID
 		items[i] = *convertDataItemFromProto(item)
 	}
 
-	return items, nil
+	return items, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
+}
+
+// ListComments retrieves the comments scraped from a post, oldest first, reading every page
+func (c *GRPCScraperClient) ListComments(ctx context.Context, tenantID, platform, postID string,
+	startDate, endDate time.Time) ([]repository.Comment, error) {
+
+	var comments []repository.Comment
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.ListCommentsPage(ctx, tenantID, platform, postID, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, batch...)
+		if info.NextToken == "" {
+			return comments, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// ListCommentsPage retrieves a page of the comments scraped from a post, oldest first
+func (c *GRPCScraperClient) ListCommentsPage(ctx context.Context, tenantID, platform, postID string,
+	startDate, endDate time.Time, page db.Page) ([]repository.Comment, db.PageInfo, error) {
+
+	req := &pb.ListCommentsRequest{
+		TenantId:  tenantID,
+		PostId:    postID,
+		Platform:  platform,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
+	}
+
+	if !startDate.IsZero() {
+		req.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		req.EndDate = timestamppb.New(endDate)
+	}
+
+	resp, err := c.client.ListComments(ctx, req)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	// Convert the response to repository format
+	comments := make([]repository.Comment, len(resp.Comments))
+	for i, comment := range resp.Comments {
+		comments[i] = *convertCommentFromProto(comment)
+	}
+
+	return comments, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
+}
+
+// ListJobRuns retrieves the run history of a job, most recent first, reading every page
+func (c *GRPCScraperClient) ListJobRuns(ctx context.Context, tenantID, jobID string) ([]repository.JobRun, error) {
+	var runs []repository.JobRun
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.ListJobRunsPage(ctx, tenantID, jobID, page)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, batch...)
+		if info.NextToken == "" {
+			return runs, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// ListJobRunsPage retrieves a page of the run history of a job, most recent first
+func (c *GRPCScraperClient) ListJobRunsPage(ctx context.Context, tenantID, jobID string, page db.Page) ([]repository.JobRun, db.PageInfo, error) {
+	req := &pb.ListJobRunsRequest{
+		TenantId:  tenantID,
+		JobId:     jobID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
+	}
+
+	resp, err := c.client.ListJobRuns(ctx, req)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to list job runs: %w", err)
+	}
+
+	// Convert the response to repository format
+	runs := make([]repository.JobRun, len(resp.Runs))
+	for i, run := range resp.Runs {
+		runs[i] = *convertJobRunFromProto(run)
+	}
+
+	return runs, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
+}
+
+// ListMentions retrieves the mentions found by keyword, hashtag and mention tracking jobs, oldest first,
+// reading every page
+func (c *GRPCScraperClient) ListMentions(ctx context.Context, tenantID, platform string,
+	startDate, endDate time.Time) ([]repository.Mention, error) {
+
+	var mentions []repository.Mention
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.ListMentionsPage(ctx, tenantID, platform, startDate, endDate, page)
+		if err != nil {
+			return nil, err
+		}
+		mentions = append(mentions, batch...)
+		if info.NextToken == "" {
+			return mentions, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// ListMentionsPage retrieves a page of the mentions found by keyword, hashtag and mention tracking jobs, oldest first
+func (c *GRPCScraperClient) ListMentionsPage(ctx context.Context, tenantID, platform string,
+	startDate, endDate time.Time, page db.Page) ([]repository.Mention, db.PageInfo, error) {
+
+	req := &pb.ListMentionsRequest{
+		TenantId:  tenantID,
+		Platform:  platform,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
+	}
+
+	if !startDate.IsZero() {
+		req.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		req.EndDate = timestamppb.New(endDate)
+	}
+
+	resp, err := c.client.ListMentions(ctx, req)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to list mentions: %w", err)
+	}
+
+	// Convert the response to repository format
+	mentions := make([]repository.Mention, len(resp.Mentions))
+	for i, mention := range resp.Mentions {
+		mentions[i] = *convertMentionFromProto(mention)
+	}
+
+	return mentions, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
+}
+
+// GetShareOfVoice compares mentions of the tenant's brand and its competitors per day, week or month
+func (c *GRPCScraperClient) GetShareOfVoice(ctx context.Context, tenantID, platform, interval string,
+	startDate, endDate time.Time) ([]ShareOfVoice, error) {
+
+	req := &pb.GetShareOfVoiceRequest{
+		TenantId: tenantID,
+		Platform: platform,
+		Interval: interval,
+	}
+
+	if !startDate.IsZero() {
+		req.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		req.EndDate = timestamppb.New(endDate)
+	}
+
+	resp, err := c.client.GetShareOfVoice(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share of voice: %w", err)
+	}
+
+	shares := make([]ShareOfVoice, len(resp.Shares))
+	for i, share := range resp.Shares {
+		shares[i] = ShareOfVoice{
+			CompetitorID:    share.CompetitorId,
+			OwnBrand:        share.OwnBrand,
+			Mentions:        int(share.Mentions),
+			Engagement:      int(share.Engagement),
+			MentionShare:    share.MentionShare,
+			EngagementShare: share.EngagementShare,
+		}
+		if share.PeriodStart != nil {
+			shares[i].PeriodStart = share.PeriodStart.AsTime()
+		}
+	}
+
+	return shares, nil
+}
+
+// GetPostVelocity retrieves the snapshots of a target's posts and how fast each gained likes, shares and comments
+func (c *GRPCScraperClient) GetPostVelocity(ctx context.Context, tenantID, platform, targetID, postID string,
+	startDate, endDate time.Time) ([]PostVelocity, error) {
+
+	req := &pb.GetPostVelocityRequest{
+		TenantId: tenantID,
+		Platform: platform,
+		TargetId: targetID,
+		PostId:   postID,
+	}
+
+	if !startDate.IsZero() {
+		req.StartDate = timestamppb.New(startDate)
+	}
+
+	if !endDate.IsZero() {
+		req.EndDate = timestamppb.New(endDate)
+	}
+
+	resp, err := c.client.GetPostVelocity(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post velocity: %w", err)
+	}
+
+	velocities := make([]PostVelocity, len(resp.Posts))
+	for i, post := range resp.Posts {
+		velocities[i] = convertPostVelocityFromProto(post)
+	}
+
+	return velocities, nil
+}
+
+// ListMediaAssets retrieves the media captured for the tenant, optionally filtered by platform, target and post,
+// reading every page
+func (c *GRPCScraperClient) ListMediaAssets(ctx context.Context, tenantID, platform, targetID, postID string) ([]repository.MediaAsset, error) {
+	var assets []repository.MediaAsset
+	page := db.Page{Size: db.MaxPageSize}
+	for {
+		batch, info, err := c.ListMediaAssetsPage(ctx, tenantID, platform, targetID, postID, page)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, batch...)
+		if info.NextToken == "" {
+			return assets, nil
+		}
+		page.Token = info.NextToken
+	}
+}
+
+// ListMediaAssetsPage retrieves a page of the media captured for the tenant, optionally filtered by platform, target and post
+func (c *GRPCScraperClient) ListMediaAssetsPage(ctx context.Context, tenantID, platform, targetID, postID string,
+	page db.Page) ([]repository.MediaAsset, db.PageInfo, error) {
+
+	req := &pb.ListMediaAssetsRequest{
+		TenantId:  tenantID,
+		Platform:  platform,
+		TargetId:  targetID,
+		PostId:    postID,
+		PageSize:  int32(page.Size),
+		PageToken: page.Token,
+	}
+
+	resp, err := c.client.ListMediaAssets(ctx, req)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to list media assets: %w", err)
+	}
+
+	assets := make([]repository.MediaAsset, len(resp.Assets))
+	for i, asset := range resp.Assets {
+		assets[i] = *convertMediaAssetFromProto(asset)
+	}
+
+	return assets, db.PageInfo{NextToken: resp.NextPageToken, TotalCount: int(resp.TotalCount)}, nil
+}
+
+// FindSimilarMedia finds the tenant's captured images within maxDistance bits of an asset's perceptual hash
+func (c *GRPCScraperClient) FindSimilarMedia(ctx context.Context, tenantID, assetID string, maxDistance int) ([]SimilarMedia, error) {
+	req := &pb.FindSimilarMediaRequest{
+		TenantId:    tenantID,
+		AssetId:     assetID,
+		MaxDistance: int32(maxDistance),
+	}
+
+	resp, err := c.client.FindSimilarMedia(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find similar media: %w", err)
+	}
+
+	matches := make([]SimilarMedia, len(resp.Matches))
+	for i, match := range resp.Matches {
+		matches[i] = SimilarMedia{
+			Asset:    *convertMediaAssetFromProto(match.Asset),
+			Distance: int(match.Distance),
+		}
+	}
+
+	return matches, nil
+}
+
+// ListRetentionPolicies retrieves the tenant's retention policy for every data type
+func (c *GRPCScraperClient) ListRetentionPolicies(ctx context.Context, tenantID string) ([]RetentionPolicy, error) {
+	req := &pb.ListRetentionPoliciesRequest{
+		TenantId: tenantID,
+	}
+
+	resp, err := c.client.ListRetentionPolicies(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retention policies: %w", err)
+	}
+
+	policies := make([]RetentionPolicy, len(resp.Policies))
+	for i, policy := range resp.Policies {
+		policies[i] = *convertRetentionPolicyFromProto(policy)
+	}
+
+	return policies, nil
+}
+
+// SetRetentionPolicy sets how many days the tenant keeps a type of scraped data
+func (c *GRPCScraperClient) SetRetentionPolicy(ctx context.Context, tenantID string, dataType repository.DataType,
+	retentionDays int) (*RetentionPolicy, error) {
+
+	req := &pb.SetRetentionPolicyRequest{
+		TenantId:      tenantID,
+		DataType:      convertDataTypeToProto(dataType),
+		RetentionDays: int32(retentionDays),
+	}
+
+	resp, err := c.client.SetRetentionPolicy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set retention policy: %w", err)
+	}
+
+	return convertRetentionPolicyFromProto(resp), nil
+}
+
+// GetStorageUsage reports how many rows the tenant stores in each scraper table
+func (c *GRPCScraperClient) GetStorageUsage(ctx context.Context, tenantID string) ([]repository.StorageUsage, error) {
+	req := &pb.GetStorageUsageRequest{
+		TenantId: tenantID,
+	}
+
+	resp, err := c.client.GetStorageUsage(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage usage: %w", err)
+	}
+
+	usage := make([]repository.StorageUsage, len(resp.Usage))
+	for i, u := range resp.Usage {
+		usage[i] = repository.StorageUsage{
+			Table:    u.Table,
+			DataType: convertDataTypeFromProto(u.DataType),
+			Rows:     int(u.Rows),
+		}
+
+		if u.OldestAt != nil {
+			usage[i].OldestAt = u.OldestAt.AsTime()
+		}
+
+		if u.NewestAt != nil {
+			usage[i].NewestAt = u.NewestAt.AsTime()
+		}
+	}
+
+	return usage, nil
 }
 
 // Helper functions for type conversions
@@ -313,6 +934,12 @@ func convertJobTypeToProto(jobType repository.JobType) pb.ScraperJobType {
 		return pb.ScraperJobType_JOB_TYPE_COMMENTS
 	case repository.JobTypeFollowers:
 		return pb.ScraperJobType_JOB_TYPE_FOLLOWERS
+	case repository.JobTypeKeyword:
+		return pb.ScraperJobType_JOB_TYPE_KEYWORD
+	case repository.JobTypeHashtag:
+		return pb.ScraperJobType_JOB_TYPE_HASHTAG
+	case repository.JobTypeMentions:
+		return pb.ScraperJobType_JOB_TYPE_MENTIONS
 	default:
 		return pb.ScraperJobType_JOB_TYPE_UNSPECIFIED
 	}
@@ -331,6 +958,12 @@ func convertJobTypeFromProto(jobType pb.ScraperJobType) repository.JobType {
 		return repository.JobTypeComments
 	case pb.ScraperJobType_JOB_TYPE_FOLLOWERS:
 		return repository.JobTypeFollowers
+	case pb.ScraperJobType_JOB_TYPE_KEYWORD:
+		return repository.JobTypeKeyword
+	case pb.ScraperJobType_JOB_TYPE_HASHTAG:
+		return repository.JobTypeHashtag
+	case pb.ScraperJobType_JOB_TYPE_MENTIONS:
+		return repository.JobTypeMentions
 	default:
 		return repository.JobTypeUnspecified
 	}
@@ -360,6 +993,8 @@ func convertJobStatusToProto(status repository.JobStatus) pb.ScraperJobStatus {
 		return pb.ScraperJobStatus_JOB_STATUS_FAILED
 	case repository.JobStatusCancelled:
 		return pb.ScraperJobStatus_JOB_STATUS_CANCELLED
+	case repository.JobStatusDeadLetter:
+		return pb.ScraperJobStatus_JOB_STATUS_DEAD_LETTER
 	default:
 		return pb.ScraperJobStatus_JOB_STATUS_UNSPECIFIED
 	}
@@ -380,6 +1015,8 @@ func convertJobStatusFromProto(status pb.ScraperJobStatus) repository.JobStatus
 		return repository.JobStatusFailed
 	case pb.ScraperJobStatus_JOB_STATUS_CANCELLED:
 		return repository.JobStatusCancelled
+	case pb.ScraperJobStatus_JOB_STATUS_DEAD_LETTER:
+		return repository.JobStatusDeadLetter
 	default:
 		return repository.JobStatusUnspecified
 	}
@@ -417,6 +1054,26 @@ func convertScheduleFrequencyFromProto(frequency pb.ScheduleFrequency) repositor
 	}
 }
 
+// convertDataTypeToProto converts a data type from repository to protobuf format
+func convertDataTypeToProto(dataType repository.DataType) pb.ScraperDataType {
+	switch dataType {
+	case repository.DataTypeProfile:
+		return pb.ScraperDataType_DATA_TYPE_PROFILE
+	case repository.DataTypePost:
+		return pb.ScraperDataType_DATA_TYPE_POST
+	case repository.DataTypeStory:
+		return pb.ScraperDataType_DATA_TYPE_STORY
+	case repository.DataTypeComment:
+		return pb.ScraperDataType_DATA_TYPE_COMMENT
+	case repository.DataTypeFollower:
+		return pb.ScraperDataType_DATA_TYPE_FOLLOWER
+	case repository.DataTypeMention:
+		return pb.ScraperDataType_DATA_TYPE_MENTION
+	default:
+		return pb.ScraperDataType_DATA_TYPE_UNSPECIFIED
+	}
+}
+
 // convertDataTypeFromProto converts a data type from protobuf to repository format
 func convertDataTypeFromProto(dataType pb.ScraperDataType) repository.DataType {
 	switch dataType {
@@ -430,11 +1087,29 @@ func convertDataTypeFromProto(dataType pb.ScraperDataType) repository.DataType {
 		return repository.DataTypeComment
 	case pb.ScraperDataType_DATA_TYPE_FOLLOWER:
 		return repository.DataTypeFollower
+	case pb.ScraperDataType_DATA_TYPE_MENTION:
+		return repository.DataTypeMention
 	default:
 		return repository.DataTypeUnspecified
 	}
 }
 
+// convertRunStatusFromProto converts a run status from protobuf to repository format
+func convertRunStatusFromProto(runStatus pb.JobRunStatus) repository.RunStatus {
+	switch runStatus {
+	case pb.JobRunStatus_RUN_STATUS_RUNNING:
+		return repository.RunStatusRunning
+	case pb.JobRunStatus_RUN_STATUS_COMPLETED:
+		return repository.RunStatusCompleted
+	case pb.JobRunStatus_RUN_STATUS_FAILED:
+		return repository.RunStatusFailed
+	case pb.JobRunStatus_RUN_STATUS_DEFERRED:
+		return repository.RunStatusDeferred
+	default:
+		return repository.RunStatusUnspecified
+	}
+}
+
 // convertJobFromProto converts a job from protobuf to repository format
 func convertJobFromProto(job *pb.ScraperJob) *repository.ScraperJob {
 	if job == nil {
@@ -442,15 +1117,20 @@ func convertJobFromProto(job *pb.ScraperJob) *repository.ScraperJob {
 	}
 
 	repoJob := &repository.ScraperJob{
-		ID:        job.Id,
-		TenantID:  job.TenantId,
-		Platform:  job.Platform,
-		TargetID:  job.TargetId,
-		JobType:   convertJobTypeFromProto(job.JobType),
-		Status:    convertJobStatusFromProto(job.Status),
-		LastError: job.LastError,
-		RunCount:  int(job.RunCount),
-		Metadata:  job.Metadata,
+		ID:          job.Id,
+		TenantID:    job.TenantId,
+		Platform:    job.Platform,
+		TargetID:    job.TargetId,
+		JobType:     convertJobTypeFromProto(job.JobType),
+		Status:      convertJobStatusFromProto(job.Status),
+		LastError:   job.LastError,
+		RunCount:    int(job.RunCount),
+		Attempts:    int(job.Attempts),
+		Priority:    int(job.Priority),
+		Metadata:    job.Metadata,
+		ClaimedBy:   job.ClaimedBy,
+		ParentID:    job.ParentId,
+		ParentRunID: job.ParentRunId,
 	}
 
 	if job.Schedule != nil {
@@ -484,9 +1164,73 @@ func convertJobFromProto(job *pb.ScraperJob) *repository.ScraperJob {
 		repoJob.UpdatedAt = job.UpdatedAt.AsTime()
 	}
 
+	if job.LeaseExpiresAt != nil {
+		repoJob.LeaseExpiresAt = job.LeaseExpiresAt.AsTime()
+	}
+
+	if job.Backfill != nil {
+		repoJob.Backfill = convertBackfillFromProto(job.Backfill)
+	}
+
 	return repoJob
 }
 
+// convertBackfillFromProto converts a backfill's progress from protobuf to repository format
+func convertBackfillFromProto(progress *pb.BackfillProgress) *repository.BackfillState {
+	backfill := &repository.BackfillState{
+		Pages: int(progress.Pages),
+		Posts: int(progress.Posts),
+	}
+
+	if progress.Until != nil {
+		backfill.Until = progress.Until.AsTime()
+	}
+
+	if progress.StartedAt != nil {
+		backfill.StartedAt = progress.StartedAt.AsTime()
+	}
+
+	if progress.OldestPostAt != nil {
+		backfill.OldestPostAt = progress.OldestPostAt.AsTime()
+	}
+
+	if progress.CompletedAt != nil {
+		backfill.CompletedAt = progress.CompletedAt.AsTime()
+	}
+
+	return backfill
+}
+
+// convertJobEventFromProto converts a job event from protobuf format
+func convertJobEventFromProto(event *pb.ScraperJobEvent) JobEvent {
+	converted := JobEvent{
+		Job:          convertJobFromProto(event.Job),
+		RunID:        event.RunId,
+		ItemsScraped: int(event.ItemsScraped),
+		Error:        event.Error,
+	}
+
+	if event.OccurredAt != nil {
+		converted.OccurredAt = event.OccurredAt.AsTime()
+	}
+
+	return converted
+}
+
+// convertJobTreeFromProto converts a job and its children from protobuf format
+func convertJobTreeFromProto(job *pb.ScraperJob) *JobTree {
+	tree := &JobTree{
+		Job:          convertJobFromProto(job),
+		RollupStatus: convertJobStatusFromProto(job.RollupStatus),
+	}
+
+	for _, child := range job.Children {
+		tree.Children = append(tree.Children, *convertJobTreeFromProto(child))
+	}
+
+	return tree
+}
+
 // convertDataItemFromProto converts a data item from protobuf to repository format
 func convertDataItemFromProto(item *pb.ScrapedDataItem) *repository.ScrapedDataItem {
 	if item == nil {
@@ -510,6 +1254,7 @@ func convertDataItemFromProto(item *pb.ScrapedDataItem) *repository.ScrapedDataI
 		ContentType:       item.ContentType,
 		ContentURL:        item.ContentUrl,
 		ContentAttributes: item.ContentAttributes,
+		RunID:             item.RunId,
 	}
 
 	if item.PostedAt != nil {
@@ -526,3 +1271,187 @@ func convertDataItemFromProto(item *pb.ScrapedDataItem) *repository.ScrapedDataI
 
 	return repoItem
 }
+
+// convertJobRunFromProto converts a job run from protobuf to repository format
+func convertJobRunFromProto(run *pb.JobRun) *repository.JobRun {
+	if run == nil {
+		return nil
+	}
+
+	repoRun := &repository.JobRun{
+		ID:           run.Id,
+		JobID:        run.JobId,
+		TenantID:     run.TenantId,
+		RunNumber:    int(run.RunNumber),
+		Attempt:      int(run.Attempt),
+		Status:       convertRunStatusFromProto(run.Status),
+		ItemsScraped: int(run.ItemsScraped),
+		RequestsUsed: int(run.RequestsUsed),
+		Error:        run.Error,
+	}
+
+	if run.StartedAt != nil {
+		repoRun.StartedAt = run.StartedAt.AsTime()
+	}
+
+	if run.FinishedAt != nil {
+		repoRun.FinishedAt = run.FinishedAt.AsTime()
+	}
+
+	return repoRun
+}
+
+// convertCommentFromProto converts a comment from protobuf to repository format
+func convertCommentFromProto(comment *pb.Comment) *repository.Comment {
+	if comment == nil {
+		return nil
+	}
+
+	repoComment := &repository.Comment{
+		ID:         comment.Id,
+		TenantID:   comment.TenantId,
+		JobID:      comment.JobId,
+		RunID:      comment.RunId,
+		Platform:   comment.Platform,
+		TargetID:   comment.TargetId,
+		PostID:     comment.PostId,
+		CommentID:  comment.CommentId,
+		ParentID:   comment.ParentId,
+		AuthorID:   comment.AuthorId,
+		AuthorName: comment.AuthorName,
+		Text:       comment.Text,
+		Likes:      int(comment.Likes),
+	}
+
+	if comment.PostedAt != nil {
+		repoComment.PostedAt = comment.PostedAt.AsTime()
+	}
+
+	if comment.ScrapedAt != nil {
+		repoComment.ScrapedAt = comment.ScrapedAt.AsTime()
+	}
+
+	return repoComment
+}
+
+// convertMentionFromProto converts a mention from protobuf to repository format
+func convertMentionFromProto(mention *pb.Mention) *repository.Mention {
+	if mention == nil {
+		return nil
+	}
+
+	repoMention := &repository.Mention{
+		ID:           mention.Id,
+		TenantID:     mention.TenantId,
+		JobID:        mention.JobId,
+		RunID:        mention.RunId,
+		Platform:     mention.Platform,
+		JobType:      convertJobTypeFromProto(mention.JobType),
+		Query:        mention.Query,
+		PostID:       mention.PostId,
+		URL:          mention.Url,
+		AuthorID:     mention.AuthorId,
+		AuthorName:   mention.AuthorName,
+		Text:         mention.Text,
+		Likes:        int(mention.Likes),
+		Shares:       int(mention.Shares),
+		Comments:     int(mention.Comments),
+		Views:        int(mention.Views),
+		CompetitorID: mention.CompetitorId,
+		OwnBrand:     mention.OwnBrand,
+	}
+
+	if mention.PostedAt != nil {
+		repoMention.PostedAt = mention.PostedAt.AsTime()
+	}
+
+	if mention.ScrapedAt != nil {
+		repoMention.ScrapedAt = mention.ScrapedAt.AsTime()
+	}
+
+	return repoMention
+}
+
+// convertPostVelocityFromProto converts a post's velocity from protobuf format
+func convertPostVelocityFromProto(post *pb.PostVelocity) PostVelocity {
+	velocity := PostVelocity{
+		Platform:        post.Platform,
+		TargetID:        post.TargetId,
+		PostID:          post.PostId,
+		Likes:           int(post.Likes),
+		Shares:          int(post.Shares),
+		Comments:        int(post.Comments),
+		Views:           int(post.Views),
+		LikesPerHour:    post.LikesPerHour,
+		SharesPerHour:   post.SharesPerHour,
+		CommentsPerHour: post.CommentsPerHour,
+		Snapshots:       make([]PostSnapshot, len(post.Snapshots)),
+	}
+
+	if post.PostedAt != nil {
+		velocity.PostedAt = post.PostedAt.AsTime()
+	}
+
+	for i, snapshot := range post.Snapshots {
+		velocity.Snapshots[i] = PostSnapshot{
+			Likes:           int(snapshot.Likes),
+			Shares:          int(snapshot.Shares),
+			Comments:        int(snapshot.Comments),
+			Views:           int(snapshot.Views),
+			LikesPerHour:    snapshot.LikesPerHour,
+			SharesPerHour:   snapshot.SharesPerHour,
+			CommentsPerHour: snapshot.CommentsPerHour,
+		}
+		if snapshot.ScrapedAt != nil {
+			velocity.Snapshots[i].ScrapedAt = snapshot.ScrapedAt.AsTime()
+		}
+	}
+
+	return velocity
+}
+
+// convertRetentionPolicyFromProto converts a retention policy from protobuf format
+func convertRetentionPolicyFromProto(policy *pb.RetentionPolicy) *RetentionPolicy {
+	retention := &RetentionPolicy{
+		DataType:      convertDataTypeFromProto(policy.DataType),
+		RetentionDays: int(policy.RetentionDays),
+		Default:       policy.IsDefault,
+	}
+
+	if policy.UpdatedAt != nil {
+		retention.UpdatedAt = policy.UpdatedAt.AsTime()
+	}
+
+	return retention
+}
+
+// convertMediaAssetFromProto converts a media asset from protobuf to repository format
+func convertMediaAssetFromProto(asset *pb.MediaAsset) *repository.MediaAsset {
+	if asset == nil {
+		return &repository.MediaAsset{}
+	}
+
+	repoAsset := &repository.MediaAsset{
+		ID:             asset.Id,
+		TenantID:       asset.TenantId,
+		JobID:          asset.JobId,
+		Platform:       asset.Platform,
+		TargetID:       asset.TargetId,
+		PostID:         asset.PostId,
+		SourceURL:      asset.SourceUrl,
+		StorageKey:     asset.StorageKey,
+		ContentType:    asset.ContentType,
+		SizeBytes:      asset.SizeBytes,
+		Width:          int(asset.Width),
+		Height:         int(asset.Height),
+		Duration:       asset.Duration,
+		SHA256:         asset.Sha256,
+		PerceptualHash: asset.PerceptualHash,
+	}
+
+	if asset.CapturedAt != nil {
+		repoAsset.CapturedAt = asset.CapturedAt.AsTime()
+	}
+
+	return repoAsset
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
@@ -9,11 +10,17 @@ import (
 	"os/signal"
 	"syscall"
 
+	authclient "github.com/donaldnash/go-competitor/auth/client"
 	"github.com/donaldnash/go-competitor/common/config"
+	competitorclient "github.com/donaldnash/go-competitor/competitor/client"
+	engagementclient "github.com/donaldnash/go-competitor/engagement/client"
+	"github.com/donaldnash/go-competitor/scraper/media"
 	"github.com/donaldnash/go-competitor/scraper/pb"
+	"github.com/donaldnash/go-competitor/scraper/platform"
 	"github.com/donaldnash/go-competitor/scraper/repository"
 	"github.com/donaldnash/go-competitor/scraper/server"
 	"github.com/donaldnash/go-competitor/scraper/service"
+	"github.com/donaldnash/go-competitor/scraper/webhook"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -31,15 +38,72 @@ func main() {
 		// In a real implementation, we would get the port from the configuration
 	}
 
-	// Create repository (using a temporary tenant ID for demonstration)
-	// In a real implementation, we would use different tenant IDs based on the request
-	repo, err := repository.NewSupabaseScraperRepository("system")
+	// Create repository. It serves every tenant, scoping each query to the tenant it is for.
+	repo, err := repository.NewSupabaseScraperRepository()
 	if err != nil {
 		log.Fatalf("Failed to create repository: %v", err)
 	}
 
+	// Create clients for the services that receive normalized metrics
+	competitorAddr := os.Getenv("COMPETITOR_SERVICE_URL")
+	if competitorAddr == "" {
+		competitorAddr = "localhost:9003"
+	}
+	competitorClient, err := competitorclient.NewGRPCCompetitorClient(competitorAddr)
+	if err != nil {
+		log.Fatalf("Failed to create competitor client: %v", err)
+	}
+	defer competitorClient.Close()
+
+	engagementAddr := os.Getenv("ENGAGEMENT_SERVICE_URL")
+	if engagementAddr == "" {
+		engagementAddr = "localhost:9004"
+	}
+	engagementClient, err := engagementclient.NewEngagementClient(engagementAddr)
+	if err != nil {
+		log.Fatalf("Failed to create engagement client: %v", err)
+	}
+	defer engagementClient.Close()
+
+	// Tenant tiers decide each tenant's share of the job queue
+	authAddr := os.Getenv("AUTH_SERVICE_URL")
+	if authAddr == "" {
+		authAddr = "localhost:9001"
+	}
+	authClient, err := authclient.NewAuthClient(authAddr)
+	if err != nil {
+		log.Fatalf("Failed to create auth client: %v", err)
+	}
+	defer authClient.Close()
+
+	// Platform metadata can be overridden from a JSON config file
+	platformConfig := platform.ConfigFromEnv()
+	if path := os.Getenv("SCRAPER_PLATFORMS_CONFIG"); path != "" {
+		platformConfig.Overrides, err = platform.LoadOverrides(path)
+		if err != nil {
+			log.Fatalf("Failed to load platform config: %v", err)
+		}
+	}
+	platforms, err := platform.NewRegistry(platformConfig)
+	if err != nil {
+		log.Fatalf("Failed to create platform registry: %v", err)
+	}
+
+	// Post media is copied to the local filesystem for jobs that ask for it
+	mediaStore, err := media.StoreFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to create media store: %v", err)
+	}
+
 	// Create service
-	svc := service.NewScraperService(repo)
+	normalizer := service.NewNormalizer(repo, competitorClient, engagementClient)
+	capturer := service.NewMediaCapturer(repo, mediaStore)
+	svc := service.NewScraperService(repo, normalizer, capturer, authClient, platforms)
+
+	// Pick up jobs that were pending or running before the last shutdown
+	if err := svc.ResumeJobs(context.Background()); err != nil {
+		log.Printf("Failed to resume scraper jobs: %v", err)
+	}
 
 	// Create server
 	srv := server.NewScraperServer(svc)
@@ -61,7 +125,7 @@ func main() {
 
 	log.Printf("Starting scraper service on port %d", port)
 
-	// Set up HTTP server for health check
+	// Set up HTTP server for health check and platform webhooks
 	httpPort := os.Getenv("PORT")
 	if httpPort == "" {
 		httpPort = "9008" // Default HTTP port
@@ -73,6 +137,7 @@ func main() {
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`{"status":"UP"}`))
 		})
+		mux.Handle("/webhooks/", webhook.NewHandler(svc, webhook.ConfigFromEnv()))
 
 		httpServer := &http.Server{
 			Addr:    ":" + httpPort,
@@ -99,4 +164,5 @@ func main() {
 
 	log.Println("Shutting down scraper service...")
 	grpcServer.GracefulStop()
+	svc.Stop()
 }
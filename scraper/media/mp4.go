@@ -0,0 +1,145 @@
+package media
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// maxHeaderBox bounds the mvhd and tkhd boxes that are read into memory. Both are around 100 bytes,
+// so anything larger is malformed.
+const maxHeaderBox = 1 << 10
+
+// section is the offset and size of a box body within a file
+type section struct {
+	offset int64
+	size   int64
+}
+
+// mp4Info reads the frame size and duration from the moov box of an MP4 or QuickTime file.
+// Other containers, and files whose moov box is missing, report zeroes. Boxes are located
+// from their headers, so the media data itself is skipped over rather than read.
+func mp4Info(r io.ReaderAt, size int64) (width, height int, duration float64) {
+	moov, ok := findBox(r, section{size: size}, "moov")
+	if !ok {
+		return 0, 0, 0
+	}
+
+	if mvhd, ok := findBox(r, moov, "mvhd"); ok {
+		if body := readBox(r, mvhd); len(body) >= 4 {
+			duration = movieDuration(body)
+		}
+	}
+
+	// The first track with a frame size is the video
+	eachBox(r, moov, func(kind string, trak section) bool {
+		if kind != "trak" {
+			return true
+		}
+		tkhd, ok := findBox(r, trak, "tkhd")
+		if !ok {
+			return true
+		}
+		body := readBox(r, tkhd)
+		if len(body) < 8 {
+			return true
+		}
+		// Width and height are 16.16 fixed-point numbers at the end of the box
+		w := binary.BigEndian.Uint32(body[len(body)-8:]) >> 16
+		h := binary.BigEndian.Uint32(body[len(body)-4:]) >> 16
+		if w == 0 || h == 0 {
+			return true
+		}
+		width, height = int(w), int(h)
+		return false
+	})
+
+	return width, height, duration
+}
+
+// movieDuration converts the duration in an mvhd box from its timescale to seconds
+func movieDuration(mvhd []byte) float64 {
+	var timescale uint32
+	var units uint64
+	switch mvhd[0] {
+	case 0: // 32-bit creation and modification times and duration
+		if len(mvhd) < 20 {
+			return 0
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[12:16])
+		units = uint64(binary.BigEndian.Uint32(mvhd[16:20]))
+	case 1: // 64-bit
+		if len(mvhd) < 32 {
+			return 0
+		}
+		timescale = binary.BigEndian.Uint32(mvhd[20:24])
+		units = binary.BigEndian.Uint64(mvhd[24:32])
+	}
+
+	if timescale == 0 {
+		return 0
+	}
+	return float64(units) / float64(timescale)
+}
+
+// readBox reads the body of a box, or returns nil if it is larger than maxHeaderBox or can't be read
+func readBox(r io.ReaderAt, box section) []byte {
+	if box.size > maxHeaderBox {
+		return nil
+	}
+	body := make([]byte, box.size)
+	if _, err := r.ReadAt(body, box.offset); err != nil {
+		return nil
+	}
+	return body
+}
+
+// findBox returns the body of the first box of a kind directly inside a section
+func findBox(r io.ReaderAt, within section, kind string) (section, bool) {
+	var found section
+	ok := false
+	eachBox(r, within, func(k string, body section) bool {
+		if k == kind {
+			found, ok = body, true
+			return false
+		}
+		return true
+	})
+	return found, ok
+}
+
+// eachBox calls fn with the kind and body of each box directly inside a section until fn returns false
+func eachBox(r io.ReaderAt, within section, fn func(kind string, body section) bool) {
+	offset, end := within.offset, within.offset+within.size
+
+	var header [16]byte
+	for end-offset >= 8 {
+		if _, err := r.ReadAt(header[:8], offset); err != nil {
+			return
+		}
+		size := uint64(binary.BigEndian.Uint32(header[0:4]))
+		kind := string(header[4:8])
+		headerSize := uint64(8)
+
+		switch size {
+		case 0: // The box runs to the end of the file
+			size = uint64(end - offset)
+		case 1: // 64-bit size after the kind
+			if end-offset < 16 {
+				return
+			}
+			if _, err := r.ReadAt(header[8:16], offset+8); err != nil {
+				return
+			}
+			size = binary.BigEndian.Uint64(header[8:16])
+			headerSize = 16
+		}
+
+		if size < headerSize || size > uint64(end-offset) {
+			return
+		}
+		if !fn(kind, section{offset: offset + int64(headerSize), size: int64(size - headerSize)}) {
+			return
+		}
+		offset += int64(size)
+	}
+}
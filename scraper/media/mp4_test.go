@@ -0,0 +1,101 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+// box builds an MP4 box of a kind around the concatenated bodies
+func box(kind string, bodies ...[]byte) []byte {
+	body := bytes.Join(bodies, nil)
+	out := binary.BigEndian.AppendUint32(nil, uint32(8+len(body)))
+	return append(append(out, kind...), body...)
+}
+
+// largeBox builds a box with a 64-bit size
+func largeBox(kind string, body []byte) []byte {
+	out := binary.BigEndian.AppendUint32(nil, 1)
+	out = append(out, kind...)
+	out = binary.BigEndian.AppendUint64(out, uint64(16+len(body)))
+	return append(out, body...)
+}
+
+// mvhd builds a movie header of version 0 or 1 with a duration in units of the timescale
+func mvhd(version byte, timescale uint32, units uint64) []byte {
+	body := []byte{version, 0, 0, 0}
+	if version == 0 {
+		body = append(body, make([]byte, 8)...) // Creation and modification times
+		body = binary.BigEndian.AppendUint32(body, timescale)
+		body = binary.BigEndian.AppendUint32(body, uint32(units))
+	} else {
+		body = append(body, make([]byte, 16)...)
+		body = binary.BigEndian.AppendUint32(body, timescale)
+		body = binary.BigEndian.AppendUint64(body, units)
+	}
+	return box("mvhd", body, make([]byte, 80))
+}
+
+// trak builds a track whose header ends with a 16.16 fixed-point frame size
+func trak(width, height uint32) []byte {
+	tkhd := make([]byte, 76)
+	tkhd = binary.BigEndian.AppendUint32(tkhd, width<<16)
+	tkhd = binary.BigEndian.AppendUint32(tkhd, height<<16)
+	return box("trak", box("tkhd", tkhd), box("mdia"))
+}
+
+func TestMP4Info(t *testing.T) {
+	ftyp := box("ftyp", []byte("isom\x00\x00\x02\x00isomiso2mp41"))
+	mdat := box("mdat", make([]byte, 4096))
+
+	tests := []struct {
+		name         string
+		data         []byte
+		wantWidth    int
+		wantHeight   int
+		wantDuration float64
+	}{
+		{
+			name:      "moov after the media data",
+			data:      bytes.Join([][]byte{ftyp, mdat, box("moov", mvhd(0, 1000, 12500), trak(0, 0), trak(1920, 1080))}, nil),
+			wantWidth: 1920, wantHeight: 1080, wantDuration: 12.5,
+		},
+		{
+			name:      "moov before the media data",
+			data:      bytes.Join([][]byte{ftyp, box("moov", mvhd(0, 600, 1800), trak(720, 1280)), mdat}, nil),
+			wantWidth: 720, wantHeight: 1280, wantDuration: 3,
+		},
+		{
+			name:      "64-bit header and box sizes",
+			data:      bytes.Join([][]byte{ftyp, largeBox("mdat", make([]byte, 1024)), box("moov", mvhd(1, 90000, 5400000), trak(1280, 720))}, nil),
+			wantWidth: 1280, wantHeight: 720, wantDuration: 60,
+		},
+		{
+			name:         "audio only",
+			data:         bytes.Join([][]byte{ftyp, box("moov", mvhd(0, 44100, 441000), trak(0, 0)), mdat}, nil),
+			wantDuration: 10,
+		},
+		{
+			name: "no moov",
+			data: bytes.Join([][]byte{ftyp, mdat}, nil),
+		},
+		{
+			name: "truncated moov",
+			data: append(ftyp, box("moov", mvhd(0, 1000, 12500), trak(1920, 1080))[:60]...),
+		},
+		{
+			name: "not an mp4",
+			data: []byte("RIFF\x00\x00\x00\x00WEBPVP8 "),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height, duration := mp4Info(bytes.NewReader(tt.data), int64(len(tt.data)))
+			if width != tt.wantWidth || height != tt.wantHeight || duration != tt.wantDuration {
+				t.Errorf("mp4Info() = %dx%d %vs, want %dx%d %vs",
+					width, height, duration, tt.wantWidth, tt.wantHeight, tt.wantDuration)
+			}
+		})
+	}
+}
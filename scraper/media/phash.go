@@ -0,0 +1,91 @@
+package media
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"math/bits"
+)
+
+// hashWidth and hashHeight are the size of the grid an image is reduced to before hashing.
+// Comparing each cell with its right neighbour gives 8x8 bits.
+const (
+	hashWidth  = 9
+	hashHeight = 8
+)
+
+// Hash computes the difference hash (dHash) of an image: the image is reduced to a 9x8 grid of
+// average brightness and each bit records whether a cell is brighter than the next one in its row.
+// Resizing, recompressing and small colour changes keep most bits, so reused creatives have hashes
+// a small Distance apart. The hash is returned as 16 hex digits.
+func Hash(img image.Image) string {
+	bounds := img.Bounds()
+	if bounds.Empty() {
+		return ""
+	}
+
+	var sums [hashHeight][hashWidth]float64
+	var counts [hashHeight][hashWidth]int
+	width, height := bounds.Dx(), bounds.Dy()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := (y - bounds.Min.Y) * hashHeight / height
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			col := (x - bounds.Min.X) * hashWidth / width
+			r, g, b, _ := img.At(x, y).RGBA()
+			sums[row][col] += 0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)
+			counts[row][col]++
+		}
+	}
+
+	// Images narrower than the grid leave cells empty, which take their left neighbour's brightness
+	var grid [hashHeight][hashWidth]float64
+	for row := range grid {
+		for col := range grid[row] {
+			switch {
+			case counts[row][col] > 0:
+				grid[row][col] = sums[row][col] / float64(counts[row][col])
+			case col > 0:
+				grid[row][col] = grid[row][col-1]
+			case row > 0:
+				grid[row][col] = grid[row-1][col]
+			}
+		}
+	}
+
+	var hash uint64
+	for row := 0; row < hashHeight; row++ {
+		for col := 0; col < hashWidth-1; col++ {
+			hash <<= 1
+			if grid[row][col] > grid[row][col+1] {
+				hash |= 1
+			}
+		}
+	}
+
+	var out [8]byte
+	binary.BigEndian.PutUint64(out[:], hash)
+	return hex.EncodeToString(out[:])
+}
+
+// Distance returns the number of bits two hashes differ in, from 0 for the same image to 64
+func Distance(a, b string) (int, error) {
+	x, err := parseHash(a)
+	if err != nil {
+		return 0, err
+	}
+	y, err := parseHash(b)
+	if err != nil {
+		return 0, err
+	}
+	return bits.OnesCount64(x ^ y), nil
+}
+
+// parseHash parses a hash returned by Hash
+func parseHash(hash string) (uint64, error) {
+	raw, err := hex.DecodeString(hash)
+	if err != nil || len(raw) != 8 {
+		return 0, fmt.Errorf("invalid perceptual hash: %q", hash)
+	}
+	return binary.BigEndian.Uint64(raw), nil
+}
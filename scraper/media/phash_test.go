@@ -0,0 +1,79 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// pattern draws a 9x8 grid of distinct grey cells, each cell scale pixels wide
+func pattern(scale int, invert bool) image.Image {
+	img := image.NewGray(image.Rect(0, 0, hashWidth*scale, hashHeight*scale))
+	for y := 0; y < hashHeight*scale; y++ {
+		for x := 0; x < hashWidth*scale; x++ {
+			cell := y/scale*hashWidth + x/scale
+			v := uint8(cell * 37 % 256)
+			if invert {
+				v = 255 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	return img
+}
+
+func TestHash(t *testing.T) {
+	original := Hash(pattern(10, false))
+	if len(original) != 16 {
+		t.Fatalf("Hash() = %q, want 16 hex digits", original)
+	}
+
+	var recompressed bytes.Buffer
+	if err := jpeg.Encode(&recompressed, pattern(10, false), &jpeg.Options{Quality: 60}); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := jpeg.Decode(&recompressed)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		img         image.Image
+		maxDistance int
+		minDistance int
+	}{
+		{name: "same image", img: pattern(10, false)},
+		{name: "resized", img: pattern(25, false)},
+		{name: "recompressed", img: decoded, maxDistance: 4},
+		{name: "inverted", img: pattern(10, true), minDistance: 60, maxDistance: 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance, err := Distance(original, Hash(tt.img))
+			if err != nil {
+				t.Fatalf("Distance() error = %v", err)
+			}
+			if distance < tt.minDistance || distance > tt.maxDistance {
+				t.Errorf("Distance() = %d, want %d to %d", distance, tt.minDistance, tt.maxDistance)
+			}
+		})
+	}
+}
+
+func TestHashOfEmptyImage(t *testing.T) {
+	if hash := Hash(image.NewGray(image.Rectangle{})); hash != "" {
+		t.Errorf("Hash() = %q, want empty", hash)
+	}
+}
+
+func TestDistanceRejectsInvalidHashes(t *testing.T) {
+	for _, hash := range []string{"", "abc", "zzzzzzzzzzzzzzzz", "0123456789abcdef00"} {
+		if _, err := Distance("0123456789abcdef", hash); err == nil {
+			t.Errorf("Distance(%q) error = nil, want an error", hash)
+		}
+	}
+}
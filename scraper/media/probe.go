@@ -0,0 +1,155 @@
+package media
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif" // Register the decoders probed images are read with
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// maxDecodePixels bounds the images that are decoded for a perceptual hash. A small file can declare
+// an enormous canvas, so the size is read from the header first and larger images only get dimensions.
+const maxDecodePixels = 40_000_000
+
+// Info describes a piece of media
+type Info struct {
+	ContentType    string
+	Size           int64
+	Width          int
+	Height         int
+	Duration       float64 // Seconds, for video and audio whose container reports it
+	SHA256         string  // Hex digest of the content, identical files share it
+	PerceptualHash string  // Hex dHash of images that could be decoded, see Hash
+}
+
+// Probe inspects media content of the given size. The content type the server sent is used unless
+// it's missing or generic, in which case it's sniffed from the content. Formats that can't be read
+// are still described by their size, type and digest. Only the parts of the content each format
+// needs are read, so large videos are never held in memory.
+func Probe(r io.ReaderAt, size int64, contentType string) (Info, error) {
+	digest := sha256.New()
+	if _, err := io.Copy(digest, io.NewSectionReader(r, 0, size)); err != nil {
+		return Info{}, fmt.Errorf("failed to read media: %w", err)
+	}
+
+	head := make([]byte, min(size, 512))
+	if _, err := r.ReadAt(head, 0); err != nil && err != io.EOF {
+		return Info{}, fmt.Errorf("failed to read media: %w", err)
+	}
+
+	info := Info{
+		ContentType: mediaType(head, contentType),
+		Size:        size,
+		SHA256:      hex.EncodeToString(digest.Sum(nil)),
+	}
+
+	switch {
+	case info.ContentType == "image/webp":
+		info.Width, info.Height = webpDimensions(head)
+
+	case strings.HasPrefix(info.ContentType, "image/"):
+		info.Width, info.Height, info.PerceptualHash = imageInfo(io.NewSectionReader(r, 0, size))
+
+	case strings.HasPrefix(info.ContentType, "video/"), strings.HasPrefix(info.ContentType, "audio/"):
+		info.Width, info.Height, info.Duration = mp4Info(r, size)
+	}
+
+	return info, nil
+}
+
+// imageInfo reads the dimensions of an image from its header and decodes it for a perceptual hash.
+// Images with more than maxDecodePixels pixels aren't decoded and only report their dimensions.
+func imageInfo(r *io.SectionReader) (width, height int, hash string) {
+	config, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return 0, 0, ""
+	}
+	if int64(config.Width)*int64(config.Height) > maxDecodePixels {
+		return config.Width, config.Height, ""
+	}
+
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return config.Width, config.Height, ""
+	}
+	img, _, err := image.Decode(r)
+	if err != nil {
+		// The header can't be trusted if the rest of the image is unreadable
+		return 0, 0, ""
+	}
+
+	return img.Bounds().Dx(), img.Bounds().Dy(), Hash(img)
+}
+
+// mediaType returns the media type of content without parameters
+func mediaType(data []byte, contentType string) string {
+	if parsed, _, err := mime.ParseMediaType(contentType); err == nil &&
+		parsed != "application/octet-stream" && parsed != "binary/octet-stream" {
+		return parsed
+	}
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	return sniffed
+}
+
+// Extension returns the file extension media of a content type is stored with
+func Extension(contentType string) string {
+	switch contentType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	case "video/mp4":
+		return ".mp4"
+	case "video/quicktime":
+		return ".mov"
+	case "video/webm":
+		return ".webm"
+	case "audio/mpeg":
+		return ".mp3"
+	case "audio/mp4", "audio/x-m4a":
+		return ".m4a"
+	}
+
+	if extensions, err := mime.ExtensionsByType(contentType); err == nil && len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
+
+// webpDimensions reads the canvas size from a WebP header, which the standard library can't decode
+func webpDimensions(data []byte) (int, int) {
+	if len(data) < 30 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return 0, 0
+	}
+
+	chunk := data[12:16]
+	switch string(chunk) {
+	case "VP8X": // Extended format, 24-bit canvas size minus one
+		width := int(data[24]) | int(data[25])<<8 | int(data[26])<<16
+		height := int(data[27]) | int(data[28])<<8 | int(data[29])<<16
+		return width + 1, height + 1
+
+	case "VP8 ": // Lossy, 14-bit sizes after the frame tag and start code
+		width := int(binary.LittleEndian.Uint16(data[26:28]) & 0x3fff)
+		height := int(binary.LittleEndian.Uint16(data[28:30]) & 0x3fff)
+		return width, height
+
+	case "VP8L": // Lossless, 14-bit sizes minus one after the signature byte
+		bits := binary.LittleEndian.Uint32(data[21:25])
+		return int(bits&0x3fff) + 1, int(bits>>14&0x3fff) + 1
+	}
+
+	return 0, 0
+}
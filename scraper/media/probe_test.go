@@ -0,0 +1,128 @@
+package media
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash/crc32"
+	"image/png"
+	"testing"
+)
+
+// webp builds the start of a WebP file with one chunk
+func webp(chunk string, body []byte) []byte {
+	out := []byte("RIFF")
+	out = binary.LittleEndian.AppendUint32(out, uint32(12+len(body)))
+	out = append(out, "WEBP"+chunk...)
+	out = binary.LittleEndian.AppendUint32(out, uint32(len(body)))
+	return append(out, body...)
+}
+
+// pngHeader builds a PNG signature and header chunk declaring a size, without any image data
+func pngHeader(width, height uint32) []byte {
+	ihdr := []byte("IHDR")
+	ihdr = binary.BigEndian.AppendUint32(ihdr, width)
+	ihdr = binary.BigEndian.AppendUint32(ihdr, height)
+	ihdr = append(ihdr, 8, 0, 0, 0, 0) // 8-bit greyscale
+
+	out := []byte("\x89PNG\r\n\x1a\n")
+	out = binary.BigEndian.AppendUint32(out, uint32(len(ihdr)-4))
+	out = append(out, ihdr...)
+	return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(ihdr))
+}
+
+func TestWebPDimensions(t *testing.T) {
+	extended := []byte{0x10, 0, 0, 0} // Flags and reserved bytes
+	extended = append(extended, 0x7f, 0x07, 0x00, 0x37, 0x04, 0x00)
+
+	lossy := []byte{0x30, 0x01, 0x00, 0x9d, 0x01, 0x2a} // Frame tag and start code
+	lossy = binary.LittleEndian.AppendUint16(lossy, 1080)
+	lossy = binary.LittleEndian.AppendUint16(lossy, 1350)
+
+	lossless := []byte{0x2f}
+	lossless = binary.LittleEndian.AppendUint32(lossless, (640-1)|(480-1)<<14)
+	lossless = append(lossless, 0, 0, 0, 0, 0)
+
+	tests := []struct {
+		name       string
+		data       []byte
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "extended", data: webp("VP8X", extended), wantWidth: 1920, wantHeight: 1080},
+		{name: "lossy", data: webp("VP8 ", lossy), wantWidth: 1080, wantHeight: 1350},
+		{name: "lossless", data: webp("VP8L", lossless), wantWidth: 640, wantHeight: 480},
+		{name: "unknown chunk", data: webp("ALPH", make([]byte, 10))},
+		{name: "truncated", data: webp("VP8X", extended)[:20]},
+		{name: "not a webp", data: pngHeader(10, 10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width, height := webpDimensions(tt.data)
+			if width != tt.wantWidth || height != tt.wantHeight {
+				t.Errorf("webpDimensions() = %dx%d, want %dx%d", width, height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	var image bytes.Buffer
+	if err := png.Encode(&image, pattern(10, false)); err != nil {
+		t.Fatal(err)
+	}
+	video := bytes.Join([][]byte{box("ftyp", []byte("isom")), box("moov", mvhd(0, 1000, 4000), trak(1080, 1920))}, nil)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		want        Info
+		wantHash    bool
+	}{
+		{
+			name: "image with a generic content type", data: image.Bytes(), contentType: "application/octet-stream",
+			want: Info{ContentType: "image/png", Width: 90, Height: 80}, wantHash: true,
+		},
+		{
+			name: "image too large to decode", data: pngHeader(10000, 8000), contentType: "image/png",
+			want: Info{ContentType: "image/png", Width: 10000, Height: 8000},
+		},
+		{
+			name: "webp", data: webp("VP8X", []byte{0, 0, 0, 0, 0x7f, 0x07, 0x00, 0x37, 0x04, 0x00}), contentType: "image/webp",
+			want: Info{ContentType: "image/webp", Width: 1920, Height: 1080},
+		},
+		{
+			name: "video with parameters", data: video, contentType: "video/mp4; codecs=avc1",
+			want: Info{ContentType: "video/mp4", Width: 1080, Height: 1920, Duration: 4},
+		},
+		{
+			name: "unreadable image", data: []byte("<html>not an image</html>"), contentType: "image/gif",
+			want: Info{ContentType: "image/gif"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := Probe(bytes.NewReader(tt.data), int64(len(tt.data)), tt.contentType)
+			if err != nil {
+				t.Fatalf("Probe() error = %v", err)
+			}
+
+			digest := sha256.Sum256(tt.data)
+			want := tt.want
+			want.Size = int64(len(tt.data))
+			want.SHA256 = hex.EncodeToString(digest[:])
+
+			if (info.PerceptualHash != "") != tt.wantHash {
+				t.Errorf("Probe() perceptual hash = %q, want one: %v", info.PerceptualHash, tt.wantHash)
+			}
+			info.PerceptualHash = ""
+			if info != want {
+				t.Errorf("Probe() = %+v, want %+v", info, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package media keeps copies of the images and videos attached to scraped posts, so the creative
+// survives the post being deleted. Media is written to a pluggable blob store and probed for its
+// size, dimensions, duration and, for images, a perceptual hash that matches reused creatives.
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotFound is returned when a store has nothing under a key
+var ErrNotFound = errors.New("media not found")
+
+// Store is a blob store media is captured to. Keys are slash-separated paths.
+type Store interface {
+	// Put writes the content of r under key, replacing anything already there
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Open reads the content under key, returning ErrNotFound when there is none
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the content under key. Deleting a missing key isn't an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// FileStore is a Store that keeps media on the local filesystem
+type FileStore struct {
+	root string
+}
+
+// NewFileStore creates a FileStore rooted at the given directory, creating it if needed
+func NewFileStore(root string) (*FileStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create media directory: %w", err)
+	}
+	return &FileStore{root: root}, nil
+}
+
+// StoreFromEnv creates the store configured by MEDIA_STORE_DIR, which defaults to ./media
+func StoreFromEnv() (Store, error) {
+	root := os.Getenv("MEDIA_STORE_DIR")
+	if root == "" {
+		root = "media"
+	}
+	return NewFileStore(root)
+}
+
+// path maps a key to a file under the root, rejecting keys that would escape it
+func (f *FileStore) path(key string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(key))
+	if key == "" || filepath.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid media key: %q", key)
+	}
+	return filepath.Join(f.root, clean), nil
+}
+
+// Put implements Store. The content is written to a temporary file first, so a failed
+// write never leaves a partial file under the key.
+func (f *FileStore) Put(ctx context.Context, key string, r io.Reader) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create media directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create media file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write media file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write media file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store media file: %w", err)
+	}
+
+	return nil
+}
+
+// Open implements Store
+func (f *FileStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open media file: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete implements Store
+func (f *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete media file: %w", err)
+	}
+
+	return nil
+}
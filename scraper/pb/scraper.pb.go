@@ -33,6 +33,9 @@ const (
 	ScraperJobType_JOB_TYPE_ENGAGEMENT  ScraperJobType = 3 // Scrape engagement metrics
 	ScraperJobType_JOB_TYPE_COMMENTS    ScraperJobType = 4 // Scrape comments
 	ScraperJobType_JOB_TYPE_FOLLOWERS   ScraperJobType = 5 // Scrape followers information
+	ScraperJobType_JOB_TYPE_KEYWORD     ScraperJobType = 6 // Search posts matching a keyword
+	ScraperJobType_JOB_TYPE_HASHTAG     ScraperJobType = 7 // Scrape posts using a hashtag
+	ScraperJobType_JOB_TYPE_MENTIONS    ScraperJobType = 8 // Scrape posts mentioning an account
 )
 
 // Enum value maps for ScraperJobType.
@@ -44,6 +47,9 @@ var (
 		3: "JOB_TYPE_ENGAGEMENT",
 		4: "JOB_TYPE_COMMENTS",
 		5: "JOB_TYPE_FOLLOWERS",
+		6: "JOB_TYPE_KEYWORD",
+		7: "JOB_TYPE_HASHTAG",
+		8: "JOB_TYPE_MENTIONS",
 	}
 	ScraperJobType_value = map[string]int32{
 		"JOB_TYPE_UNSPECIFIED": 0,
@@ -52,6 +58,9 @@ var (
 		"JOB_TYPE_ENGAGEMENT":  3,
 		"JOB_TYPE_COMMENTS":    4,
 		"JOB_TYPE_FOLLOWERS":   5,
+		"JOB_TYPE_KEYWORD":     6,
+		"JOB_TYPE_HASHTAG":     7,
+		"JOB_TYPE_MENTIONS":    8,
 	}
 )
 
@@ -92,6 +101,7 @@ const (
 	ScraperJobStatus_JOB_STATUS_COMPLETED   ScraperJobStatus = 4
 	ScraperJobStatus_JOB_STATUS_FAILED      ScraperJobStatus = 5
 	ScraperJobStatus_JOB_STATUS_CANCELLED   ScraperJobStatus = 6
+	ScraperJobStatus_JOB_STATUS_DEAD_LETTER ScraperJobStatus = 7 // Ran out of retries, waiting to be requeued
 )
 
 // Enum value maps for ScraperJobStatus.
@@ -104,6 +114,7 @@ var (
 		4: "JOB_STATUS_COMPLETED",
 		5: "JOB_STATUS_FAILED",
 		6: "JOB_STATUS_CANCELLED",
+		7: "JOB_STATUS_DEAD_LETTER",
 	}
 	ScraperJobStatus_value = map[string]int32{
 		"JOB_STATUS_UNSPECIFIED": 0,
@@ -113,6 +124,7 @@ var (
 		"JOB_STATUS_COMPLETED":   4,
 		"JOB_STATUS_FAILED":      5,
 		"JOB_STATUS_CANCELLED":   6,
+		"JOB_STATUS_DEAD_LETTER": 7,
 	}
 )
 
@@ -143,6 +155,61 @@ func (ScraperJobStatus) EnumDescriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{1}
 }
 
+type JobRunStatus int32
+
+const (
+	JobRunStatus_RUN_STATUS_UNSPECIFIED JobRunStatus = 0
+	JobRunStatus_RUN_STATUS_RUNNING     JobRunStatus = 1
+	JobRunStatus_RUN_STATUS_COMPLETED   JobRunStatus = 2
+	JobRunStatus_RUN_STATUS_FAILED      JobRunStatus = 3
+	JobRunStatus_RUN_STATUS_DEFERRED    JobRunStatus = 4 // Stopped by the platform rate limit and rescheduled
+)
+
+// Enum value maps for JobRunStatus.
+var (
+	JobRunStatus_name = map[int32]string{
+		0: "RUN_STATUS_UNSPECIFIED",
+		1: "RUN_STATUS_RUNNING",
+		2: "RUN_STATUS_COMPLETED",
+		3: "RUN_STATUS_FAILED",
+		4: "RUN_STATUS_DEFERRED",
+	}
+	JobRunStatus_value = map[string]int32{
+		"RUN_STATUS_UNSPECIFIED": 0,
+		"RUN_STATUS_RUNNING":     1,
+		"RUN_STATUS_COMPLETED":   2,
+		"RUN_STATUS_FAILED":      3,
+		"RUN_STATUS_DEFERRED":    4,
+	}
+)
+
+func (x JobRunStatus) Enum() *JobRunStatus {
+	p := new(JobRunStatus)
+	*p = x
+	return p
+}
+
+func (x JobRunStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobRunStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_scraper_pb_scraper_proto_enumTypes[2].Descriptor()
+}
+
+func (JobRunStatus) Type() protoreflect.EnumType {
+	return &file_scraper_pb_scraper_proto_enumTypes[2]
+}
+
+func (x JobRunStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobRunStatus.Descriptor instead.
+func (JobRunStatus) EnumDescriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{2}
+}
+
 type ScheduleFrequency int32
 
 const (
@@ -182,11 +249,11 @@ func (x ScheduleFrequency) String() string {
 }
 
 func (ScheduleFrequency) Descriptor() protoreflect.EnumDescriptor {
-	return file_scraper_pb_scraper_proto_enumTypes[2].Descriptor()
+	return file_scraper_pb_scraper_proto_enumTypes[3].Descriptor()
 }
 
 func (ScheduleFrequency) Type() protoreflect.EnumType {
-	return &file_scraper_pb_scraper_proto_enumTypes[2]
+	return &file_scraper_pb_scraper_proto_enumTypes[3]
 }
 
 func (x ScheduleFrequency) Number() protoreflect.EnumNumber {
@@ -195,7 +262,7 @@ func (x ScheduleFrequency) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScheduleFrequency.Descriptor instead.
 func (ScheduleFrequency) EnumDescriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{2}
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{3}
 }
 
 type ScraperDataType int32
@@ -207,6 +274,7 @@ const (
 	ScraperDataType_DATA_TYPE_STORY       ScraperDataType = 3
 	ScraperDataType_DATA_TYPE_COMMENT     ScraperDataType = 4
 	ScraperDataType_DATA_TYPE_FOLLOWER    ScraperDataType = 5
+	ScraperDataType_DATA_TYPE_MENTION     ScraperDataType = 6
 )
 
 // Enum value maps for ScraperDataType.
@@ -218,6 +286,7 @@ var (
 		3: "DATA_TYPE_STORY",
 		4: "DATA_TYPE_COMMENT",
 		5: "DATA_TYPE_FOLLOWER",
+		6: "DATA_TYPE_MENTION",
 	}
 	ScraperDataType_value = map[string]int32{
 		"DATA_TYPE_UNSPECIFIED": 0,
@@ -226,6 +295,7 @@ var (
 		"DATA_TYPE_STORY":       3,
 		"DATA_TYPE_COMMENT":     4,
 		"DATA_TYPE_FOLLOWER":    5,
+		"DATA_TYPE_MENTION":     6,
 	}
 )
 
@@ -240,11 +310,11 @@ func (x ScraperDataType) String() string {
 }
 
 func (ScraperDataType) Descriptor() protoreflect.EnumDescriptor {
-	return file_scraper_pb_scraper_proto_enumTypes[3].Descriptor()
+	return file_scraper_pb_scraper_proto_enumTypes[4].Descriptor()
 }
 
 func (ScraperDataType) Type() protoreflect.EnumType {
-	return &file_scraper_pb_scraper_proto_enumTypes[3]
+	return &file_scraper_pb_scraper_proto_enumTypes[4]
 }
 
 func (x ScraperDataType) Number() protoreflect.EnumNumber {
@@ -253,7 +323,7 @@ func (x ScraperDataType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ScraperDataType.Descriptor instead.
 func (ScraperDataType) EnumDescriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{3}
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{4}
 }
 
 // Scraper management
@@ -265,6 +335,8 @@ type CreateScraperJobRequest struct {
 	JobType       ScraperJobType         `protobuf:"varint,4,opt,name=job_type,json=jobType,proto3,enum=scraper.ScraperJobType" json:"job_type,omitempty"`
 	Schedule      *ScraperSchedule       `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Metadata      map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Priority      int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`                               // -10 to 10, higher runs first among the tenant's due jobs
+	BackfillUntil *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=backfill_until,json=backfillUntil,proto3" json:"backfill_until,omitempty"` // Posts jobs only, walk back through the post history to this date first
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -341,6 +413,20 @@ func (x *CreateScraperJobRequest) GetMetadata() map[string]string {
 	return nil
 }
 
+func (x *CreateScraperJobRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateScraperJobRequest) GetBackfillUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackfillUntil
+	}
+	return nil
+}
+
 type GetScraperJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -399,6 +485,8 @@ type ListScraperJobsRequest struct {
 	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`                                           // Optional, filter by platform
 	JobType       ScraperJobType         `protobuf:"varint,3,opt,name=job_type,json=jobType,proto3,enum=scraper.ScraperJobType" json:"job_type,omitempty"` // Optional, filter by job type
 	Status        ScraperJobStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=scraper.ScraperJobStatus" json:"status,omitempty"`                // Optional, filter by status
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`                          // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                        // Optional, next_page_token of the previous page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -461,9 +549,25 @@ func (x *ListScraperJobsRequest) GetStatus() ScraperJobStatus {
 	return ScraperJobStatus_JOB_STATUS_UNSPECIFIED
 }
 
+func (x *ListScraperJobsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScraperJobsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListScraperJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Jobs          []*ScraperJob          `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -505,6 +609,20 @@ func (x *ListScraperJobsResponse) GetJobs() []*ScraperJob {
 	return nil
 }
 
+func (x *ListScraperJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListScraperJobsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
 type CancelScraperJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
@@ -609,28 +727,28 @@ func (x *DeleteScraperJobRequest) GetJobId() string {
 	return ""
 }
 
-// Platform operations
-type ListSupportedPlatformsRequest struct {
+type RequeueScraperJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSupportedPlatformsRequest) Reset() {
-	*x = ListSupportedPlatformsRequest{}
+func (x *RequeueScraperJobRequest) Reset() {
+	*x = RequeueScraperJobRequest{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSupportedPlatformsRequest) String() string {
+func (x *RequeueScraperJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSupportedPlatformsRequest) ProtoMessage() {}
+func (*RequeueScraperJobRequest) ProtoMessage() {}
 
-func (x *ListSupportedPlatformsRequest) ProtoReflect() protoreflect.Message {
+func (x *RequeueScraperJobRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -642,39 +760,48 @@ func (x *ListSupportedPlatformsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSupportedPlatformsRequest.ProtoReflect.Descriptor instead.
-func (*ListSupportedPlatformsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequeueScraperJobRequest.ProtoReflect.Descriptor instead.
+func (*RequeueScraperJobRequest) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListSupportedPlatformsRequest) GetTenantId() string {
+func (x *RequeueScraperJobRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-type ListSupportedPlatformsResponse struct {
+func (x *RequeueScraperJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type WatchScraperJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Platforms     []*PlatformInfo        `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`        // Optional
+	JobId         string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // Optional, the stream starts with the job's current state
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSupportedPlatformsResponse) Reset() {
-	*x = ListSupportedPlatformsResponse{}
+func (x *WatchScraperJobsRequest) Reset() {
+	*x = WatchScraperJobsRequest{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSupportedPlatformsResponse) String() string {
+func (x *WatchScraperJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSupportedPlatformsResponse) ProtoMessage() {}
+func (*WatchScraperJobsRequest) ProtoMessage() {}
 
-func (x *ListSupportedPlatformsResponse) ProtoReflect() protoreflect.Message {
+func (x *WatchScraperJobsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,40 +813,57 @@ func (x *ListSupportedPlatformsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSupportedPlatformsResponse.ProtoReflect.Descriptor instead.
-func (*ListSupportedPlatformsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchScraperJobsRequest.ProtoReflect.Descriptor instead.
+func (*WatchScraperJobsRequest) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListSupportedPlatformsResponse) GetPlatforms() []*PlatformInfo {
+func (x *WatchScraperJobsRequest) GetTenantId() string {
 	if x != nil {
-		return x.Platforms
+		return x.TenantId
 	}
-	return nil
+	return ""
 }
 
-type GetPlatformStatusRequest struct {
+func (x *WatchScraperJobsRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *WatchScraperJobsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ScraperJobEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Job           *ScraperJob            `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`                                        // The job after the change
+	RunId         string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`                       // Run the change belongs to, if any
+	ItemsScraped  int32                  `protobuf:"varint,3,opt,name=items_scraped,json=itemsScraped,proto3" json:"items_scraped,omitempty"` // Items stored by the run, once it has finished
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlatformStatusRequest) Reset() {
-	*x = GetPlatformStatusRequest{}
+func (x *ScraperJobEvent) Reset() {
+	*x = ScraperJobEvent{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlatformStatusRequest) String() string {
+func (x *ScraperJobEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlatformStatusRequest) ProtoMessage() {}
+func (*ScraperJobEvent) ProtoMessage() {}
 
-func (x *GetPlatformStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *ScraperJobEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -731,50 +875,68 @@ func (x *GetPlatformStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlatformStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetPlatformStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScraperJobEvent.ProtoReflect.Descriptor instead.
+func (*ScraperJobEvent) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetPlatformStatusRequest) GetTenantId() string {
+func (x *ScraperJobEvent) GetJob() *ScraperJob {
 	if x != nil {
-		return x.TenantId
+		return x.Job
+	}
+	return nil
+}
+
+func (x *ScraperJobEvent) GetRunId() string {
+	if x != nil {
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *GetPlatformStatusRequest) GetPlatform() string {
+func (x *ScraperJobEvent) GetItemsScraped() int32 {
 	if x != nil {
-		return x.Platform
+		return x.ItemsScraped
+	}
+	return 0
+}
+
+func (x *ScraperJobEvent) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-// Scraper results
-type GetScrapedDataRequest struct {
+func (x *ScraperJobEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Platform operations
+type ListSupportedPlatformsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScrapedDataRequest) Reset() {
-	*x = GetScrapedDataRequest{}
+func (x *ListSupportedPlatformsRequest) Reset() {
+	*x = ListSupportedPlatformsRequest{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScrapedDataRequest) String() string {
+func (x *ListSupportedPlatformsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScrapedDataRequest) ProtoMessage() {}
+func (*ListSupportedPlatformsRequest) ProtoMessage() {}
 
-func (x *GetScrapedDataRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSupportedPlatformsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -786,60 +948,39 @@ func (x *GetScrapedDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScrapedDataRequest.ProtoReflect.Descriptor instead.
-func (*GetScrapedDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSupportedPlatformsRequest.ProtoReflect.Descriptor instead.
+func (*ListSupportedPlatformsRequest) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetScrapedDataRequest) GetTenantId() string {
+func (x *ListSupportedPlatformsRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *GetScrapedDataRequest) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *GetScrapedDataRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *GetScrapedDataRequest) GetEndDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.EndDate
-	}
-	return nil
-}
-
-type GetScrapedDataResponse struct {
+type ListSupportedPlatformsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ScrapedDataItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Platforms     []*PlatformInfo        `protobuf:"bytes,1,rep,name=platforms,proto3" json:"platforms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetScrapedDataResponse) Reset() {
-	*x = GetScrapedDataResponse{}
+func (x *ListSupportedPlatformsResponse) Reset() {
+	*x = ListSupportedPlatformsResponse{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetScrapedDataResponse) String() string {
+func (x *ListSupportedPlatformsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetScrapedDataResponse) ProtoMessage() {}
+func (*ListSupportedPlatformsResponse) ProtoMessage() {}
 
-func (x *GetScrapedDataResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSupportedPlatformsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,53 +992,40 @@ func (x *GetScrapedDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetScrapedDataResponse.ProtoReflect.Descriptor instead.
-func (*GetScrapedDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSupportedPlatformsResponse.ProtoReflect.Descriptor instead.
+func (*ListSupportedPlatformsResponse) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetScrapedDataResponse) GetItems() []*ScrapedDataItem {
+func (x *ListSupportedPlatformsResponse) GetPlatforms() []*PlatformInfo {
 	if x != nil {
-		return x.Items
+		return x.Platforms
 	}
 	return nil
 }
 
-// Models
-type ScraperJob struct {
+type GetPlatformStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
-	TargetId      string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	JobType       ScraperJobType         `protobuf:"varint,5,opt,name=job_type,json=jobType,proto3,enum=scraper.ScraperJobType" json:"job_type,omitempty"`
-	Status        ScraperJobStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=scraper.ScraperJobStatus" json:"status,omitempty"`
-	Schedule      *ScraperSchedule       `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
-	RunCount      int32                  `protobuf:"varint,9,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
-	LastRunAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
-	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScraperJob) Reset() {
-	*x = ScraperJob{}
+func (x *GetPlatformStatusRequest) Reset() {
+	*x = GetPlatformStatusRequest{}
 	mi := &file_scraper_pb_scraper_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScraperJob) String() string {
+func (x *GetPlatformStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScraperJob) ProtoMessage() {}
+func (*GetPlatformStatusRequest) ProtoMessage() {}
 
-func (x *ScraperJob) ProtoReflect() protoreflect.Message {
+func (x *GetPlatformStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_scraper_pb_scraper_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,134 +1037,242 @@ func (x *ScraperJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScraperJob.ProtoReflect.Descriptor instead.
-func (*ScraperJob) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPlatformStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPlatformStatusRequest) Descriptor() ([]byte, []int) {
 	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ScraperJob) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ScraperJob) GetTenantId() string {
+func (x *GetPlatformStatusRequest) GetTenantId() string {
 	if x != nil {
 		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ScraperJob) GetPlatform() string {
+func (x *GetPlatformStatusRequest) GetPlatform() string {
 	if x != nil {
 		return x.Platform
 	}
 	return ""
 }
 
-func (x *ScraperJob) GetTargetId() string {
-	if x != nil {
-		return x.TargetId
-	}
-	return ""
-}
-
-func (x *ScraperJob) GetJobType() ScraperJobType {
-	if x != nil {
-		return x.JobType
-	}
-	return ScraperJobType_JOB_TYPE_UNSPECIFIED
+// Scraper results
+type GetQueueStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScraperJob) GetStatus() ScraperJobStatus {
+func (x *GetQueueStatusRequest) Reset() {
+	*x = GetQueueStatusRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQueueStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQueueStatusRequest) ProtoMessage() {}
+
+func (x *GetQueueStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[12]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ScraperJobStatus_JOB_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *ScraperJob) GetSchedule() *ScraperSchedule {
+// Deprecated: Use GetQueueStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetQueueStatusRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetQueueStatusRequest) GetTenantId() string {
 	if x != nil {
-		return x.Schedule
+		return x.TenantId
 	}
-	return nil
+	return ""
 }
 
-func (x *ScraperJob) GetLastError() string {
+type GetScrapedDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScrapedDataRequest) Reset() {
+	*x = GetScrapedDataRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScrapedDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrapedDataRequest) ProtoMessage() {}
+
+func (x *GetScrapedDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[13]
 	if x != nil {
-		return x.LastError
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrapedDataRequest.ProtoReflect.Descriptor instead.
+func (*GetScrapedDataRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetScrapedDataRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ScraperJob) GetRunCount() int32 {
+func (x *GetScrapedDataRequest) GetJobId() string {
 	if x != nil {
-		return x.RunCount
+		return x.JobId
 	}
-	return 0
+	return ""
 }
 
-func (x *ScraperJob) GetLastRunAt() *timestamppb.Timestamp {
+func (x *GetScrapedDataRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastRunAt
+		return x.StartDate
 	}
 	return nil
 }
 
-func (x *ScraperJob) GetNextRunAt() *timestamppb.Timestamp {
+func (x *GetScrapedDataRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.NextRunAt
+		return x.EndDate
 	}
 	return nil
 }
 
-func (x *ScraperJob) GetMetadata() map[string]string {
+func (x *GetScrapedDataRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Metadata
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *ScraperJob) GetCreatedAt() *timestamppb.Timestamp {
+func (x *GetScrapedDataRequest) GetPageToken() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *ScraperJob) GetUpdatedAt() *timestamppb.Timestamp {
+type GetScrapedDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ScrapedDataItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScrapedDataResponse) Reset() {
+	*x = GetScrapedDataResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScrapedDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrapedDataResponse) ProtoMessage() {}
+
+func (x *GetScrapedDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[14]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrapedDataResponse.ProtoReflect.Descriptor instead.
+func (*GetScrapedDataResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetScrapedDataResponse) GetItems() []*ScrapedDataItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-type ScraperSchedule struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CronExpression string                 `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"` // Cron expression for scheduled jobs
-	Frequency      ScheduleFrequency      `protobuf:"varint,2,opt,name=frequency,proto3,enum=scraper.ScheduleFrequency" json:"frequency,omitempty"` // Predefined frequency
-	StartDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                // Optional start date for scheduled jobs
-	EndDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                      // Optional end date for scheduled jobs
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetScrapedDataResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-func (x *ScraperSchedule) Reset() {
-	*x = ScraperSchedule{}
-	mi := &file_scraper_pb_scraper_proto_msgTypes[12]
+func (x *GetScrapedDataResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`                    // Optional, filter by platform
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Optional, earliest comment time
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Optional, latest comment time
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScraperSchedule) String() string {
+func (x *ListCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScraperSchedule) ProtoMessage() {}
+func (*ListCommentsRequest) ProtoMessage() {}
 
-func (x *ScraperSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_scraper_pb_scraper_proto_msgTypes[12]
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,65 +1283,84 @@ func (x *ScraperSchedule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScraperSchedule.ProtoReflect.Descriptor instead.
-func (*ScraperSchedule) Descriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ScraperSchedule) GetCronExpression() string {
+func (x *ListCommentsRequest) GetTenantId() string {
 	if x != nil {
-		return x.CronExpression
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ScraperSchedule) GetFrequency() ScheduleFrequency {
+func (x *ListCommentsRequest) GetPostId() string {
 	if x != nil {
-		return x.Frequency
+		return x.PostId
 	}
-	return ScheduleFrequency_FREQUENCY_UNSPECIFIED
+	return ""
 }
 
-func (x *ScraperSchedule) GetStartDate() *timestamppb.Timestamp {
+func (x *ListCommentsRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.StartDate
 	}
 	return nil
 }
 
-func (x *ScraperSchedule) GetEndDate() *timestamppb.Timestamp {
+func (x *ListCommentsRequest) GetEndDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.EndDate
 	}
 	return nil
 }
 
-type PlatformInfo struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                          // Platform name (e.g., "instagram", "twitter")
-	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                         // Display name (e.g., "Instagram", "Twitter")
-	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                                                            // Platform description
-	SupportedJobTypes []ScraperJobType       `protobuf:"varint,4,rep,packed,name=supported_job_types,json=supportedJobTypes,proto3,enum=scraper.ScraperJobType" json:"supported_job_types,omitempty"` // Supported job types
-	RateLimits        *PlatformRateLimits    `protobuf:"bytes,5,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`                                                            // Rate limits
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *PlatformInfo) Reset() {
-	*x = PlatformInfo{}
-	mi := &file_scraper_pb_scraper_proto_msgTypes[13]
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`                                  // Oldest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformInfo) String() string {
+func (x *ListCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformInfo) ProtoMessage() {}
+func (*ListCommentsResponse) ProtoMessage() {}
 
-func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_scraper_pb_scraper_proto_msgTypes[13]
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1116,72 +1371,2853 @@ func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
-func (*PlatformInfo) Descriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCommentsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListMentionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`                    // Optional, filter by platform
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Optional, earliest post time
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Optional, latest post time
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsRequest) Reset() {
+	*x = ListMentionsRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsRequest) ProtoMessage() {}
+
+func (x *ListMentionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMentionsRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListMentionsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListMentionsRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ListMentionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListMentionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListMentionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMentionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMentionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mentions      []*Mention             `protobuf:"bytes,1,rep,name=mentions,proto3" json:"mentions,omitempty"`                                  // Oldest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMentionsResponse) Reset() {
+	*x = ListMentionsResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMentionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMentionsResponse) ProtoMessage() {}
+
+func (x *ListMentionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMentionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMentionsResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMentionsResponse) GetMentions() []*Mention {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ListMentionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMentionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetShareOfVoiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"` // Optional, filter by platform
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval      string                 `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"` // "day", "week", "month"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareOfVoiceRequest) Reset() {
+	*x = GetShareOfVoiceRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareOfVoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareOfVoiceRequest) ProtoMessage() {}
+
+func (x *GetShareOfVoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareOfVoiceRequest.ProtoReflect.Descriptor instead.
+func (*GetShareOfVoiceRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetShareOfVoiceRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetShareOfVoiceRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *GetShareOfVoiceRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetShareOfVoiceRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetShareOfVoiceRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+type GetShareOfVoiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*ShareOfVoice        `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"` // Oldest period first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareOfVoiceResponse) Reset() {
+	*x = GetShareOfVoiceResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareOfVoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareOfVoiceResponse) ProtoMessage() {}
+
+func (x *GetShareOfVoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareOfVoiceResponse.ProtoReflect.Descriptor instead.
+func (*GetShareOfVoiceResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetShareOfVoiceResponse) GetShares() []*ShareOfVoice {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type GetPostVelocityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`          // Optional, limit to one post
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // Optional, earliest snapshot time
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // Optional, latest snapshot time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostVelocityRequest) Reset() {
+	*x = GetPostVelocityRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostVelocityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostVelocityRequest) ProtoMessage() {}
+
+func (x *GetPostVelocityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostVelocityRequest.ProtoReflect.Descriptor instead.
+func (*GetPostVelocityRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPostVelocityRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *GetPostVelocityRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *GetPostVelocityRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *GetPostVelocityRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostVelocityRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPostVelocityRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type GetPostVelocityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*PostVelocity        `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"` // Newest post first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostVelocityResponse) Reset() {
+	*x = GetPostVelocityResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostVelocityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostVelocityResponse) ProtoMessage() {}
+
+func (x *GetPostVelocityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostVelocityResponse.ProtoReflect.Descriptor instead.
+func (*GetPostVelocityResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPostVelocityResponse) GetPosts() []*PostVelocity {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type ListJobRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsRequest) Reset() {
+	*x = ListJobRunsRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsRequest) ProtoMessage() {}
+
+func (x *ListJobRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobRunsRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListJobRunsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ListJobRunsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobRunsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListJobRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*JobRun              `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`                                          // Most recent first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobRunsResponse) Reset() {
+	*x = ListJobRunsResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobRunsResponse) ProtoMessage() {}
+
+func (x *ListJobRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobRunsResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListJobRunsResponse) GetRuns() []*JobRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+func (x *ListJobRunsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListJobRunsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// Data retention
+type ListRetentionPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesRequest) Reset() {
+	*x = ListRetentionPoliciesRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListRetentionPoliciesRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListRetentionPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*RetentionPolicy     `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"` // One per data type
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesResponse) Reset() {
+	*x = ListRetentionPoliciesResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListRetentionPoliciesResponse) GetPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type SetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	DataType      ScraperDataType        `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=scraper.ScraperDataType" json:"data_type,omitempty"`
+	RetentionDays int32                  `protobuf:"varint,3,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // 0 keeps the data forever
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetRetentionPolicyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetDataType() ScraperDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return ScraperDataType_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *SetRetentionPolicyRequest) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetStorageUsageRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetStorageUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Usage         []*StorageUsage        `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	TotalRows     int64                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageResponse) Reset() {
+	*x = GetStorageUsageResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetStorageUsageResponse) GetUsage() []*StorageUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetStorageUsageResponse) GetTotalRows() int64 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+type ListMediaAssetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`                    // Optional, filter by platform
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`    // Optional, filter by target
+	PostId        string                 `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`          // Optional, filter by post
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Optional, defaults to 50 and is capped at 1000
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Optional, next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMediaAssetsRequest) Reset() {
+	*x = ListMediaAssetsRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMediaAssetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMediaAssetsRequest) ProtoMessage() {}
+
+func (x *ListMediaAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMediaAssetsRequest.ProtoReflect.Descriptor instead.
+func (*ListMediaAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListMediaAssetsRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMediaAssetsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListMediaAssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        []*MediaAsset          `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`                                      // Most recently captured first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMediaAssetsResponse) Reset() {
+	*x = ListMediaAssetsResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMediaAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMediaAssetsResponse) ProtoMessage() {}
+
+func (x *ListMediaAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMediaAssetsResponse.ProtoReflect.Descriptor instead.
+func (*ListMediaAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListMediaAssetsResponse) GetAssets() []*MediaAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *ListMediaAssetsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListMediaAssetsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type FindSimilarMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AssetId       string                 `protobuf:"bytes,2,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	MaxDistance   int32                  `protobuf:"varint,3,opt,name=max_distance,json=maxDistance,proto3" json:"max_distance,omitempty"` // Bits the perceptual hashes may differ in, defaults to 10
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarMediaRequest) Reset() {
+	*x = FindSimilarMediaRequest{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarMediaRequest) ProtoMessage() {}
+
+func (x *FindSimilarMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarMediaRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarMediaRequest) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FindSimilarMediaRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *FindSimilarMediaRequest) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *FindSimilarMediaRequest) GetMaxDistance() int32 {
+	if x != nil {
+		return x.MaxDistance
+	}
+	return 0
+}
+
+type FindSimilarMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*SimilarMedia        `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"` // Closest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindSimilarMediaResponse) Reset() {
+	*x = FindSimilarMediaResponse{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindSimilarMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindSimilarMediaResponse) ProtoMessage() {}
+
+func (x *FindSimilarMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindSimilarMediaResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarMediaResponse) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FindSimilarMediaResponse) GetMatches() []*SimilarMedia {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// Models
+type ScraperJob struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId       string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform       string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId       string                 `protobuf:"bytes,4,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	JobType        ScraperJobType         `protobuf:"varint,5,opt,name=job_type,json=jobType,proto3,enum=scraper.ScraperJobType" json:"job_type,omitempty"`
+	Status         ScraperJobStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=scraper.ScraperJobStatus" json:"status,omitempty"`
+	Schedule       *ScraperSchedule       `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	LastError      string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	RunCount       int32                  `protobuf:"varint,9,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	LastRunAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	NextRunAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	Metadata       map[string]string      `protobuf:"bytes,12,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Attempts       int32                  `protobuf:"varint,15,opt,name=attempts,proto3" json:"attempts,omitempty"`                                                           // Failed attempts of the current run
+	Priority       int32                  `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`                                                           // Higher runs first among the tenant's due jobs
+	ClaimedBy      string                 `protobuf:"bytes,17,opt,name=claimed_by,json=claimedBy,proto3" json:"claimed_by,omitempty"`                                         // Worker running the job
+	LeaseExpiresAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=lease_expires_at,json=leaseExpiresAt,proto3" json:"lease_expires_at,omitempty"`                        // When the job is requeued unless its worker renews the lease
+	ParentId       string                 `protobuf:"bytes,19,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`                                            // Job whose pipeline spawned this one
+	ParentRunId    string                 `protobuf:"bytes,20,opt,name=parent_run_id,json=parentRunId,proto3" json:"parent_run_id,omitempty"`                                 // Run of the parent job that spawned this one
+	Children       []*ScraperJob          `protobuf:"bytes,21,rep,name=children,proto3" json:"children,omitempty"`                                                            // Jobs spawned by the latest run, only set by GetScraperJob
+	RollupStatus   ScraperJobStatus       `protobuf:"varint,22,opt,name=rollup_status,json=rollupStatus,proto3,enum=scraper.ScraperJobStatus" json:"rollup_status,omitempty"` // Status of the job and its children taken together, only set by GetScraperJob
+	Backfill       *BackfillProgress      `protobuf:"bytes,23,opt,name=backfill,proto3" json:"backfill,omitempty"`                                                            // Only set for jobs created with a backfill date
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScraperJob) Reset() {
+	*x = ScraperJob{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScraperJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScraperJob) ProtoMessage() {}
+
+func (x *ScraperJob) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScraperJob.ProtoReflect.Descriptor instead.
+func (*ScraperJob) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ScraperJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetJobType() ScraperJobType {
+	if x != nil {
+		return x.JobType
+	}
+	return ScraperJobType_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *ScraperJob) GetStatus() ScraperJobStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScraperJobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ScraperJob) GetSchedule() *ScraperSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *ScraperJob) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScraperJob) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ScraperJob) GetClaimedBy() string {
+	if x != nil {
+		return x.ClaimedBy
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetLeaseExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LeaseExpiresAt
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetParentRunId() string {
+	if x != nil {
+		return x.ParentRunId
+	}
+	return ""
+}
+
+func (x *ScraperJob) GetChildren() []*ScraperJob {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *ScraperJob) GetRollupStatus() ScraperJobStatus {
+	if x != nil {
+		return x.RollupStatus
+	}
+	return ScraperJobStatus_JOB_STATUS_UNSPECIFIED
+}
+
+func (x *ScraperJob) GetBackfill() *BackfillProgress {
+	if x != nil {
+		return x.Backfill
+	}
+	return nil
+}
+
+type BackfillProgress struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Until           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=until,proto3" json:"until,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	OldestPostAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=oldest_post_at,json=oldestPostAt,proto3" json:"oldest_post_at,omitempty"` // Oldest post collected so far
+	Pages           int32                  `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+	Posts           int32                  `protobuf:"varint,5,opt,name=posts,proto3" json:"posts,omitempty"`
+	PercentComplete float64                `protobuf:"fixed64,6,opt,name=percent_complete,json=percentComplete,proto3" json:"percent_complete,omitempty"`
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BackfillProgress) Reset() {
+	*x = BackfillProgress{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackfillProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackfillProgress) ProtoMessage() {}
+
+func (x *BackfillProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackfillProgress.ProtoReflect.Descriptor instead.
+func (*BackfillProgress) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BackfillProgress) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *BackfillProgress) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *BackfillProgress) GetOldestPostAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OldestPostAt
+	}
+	return nil
+}
+
+func (x *BackfillProgress) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+func (x *BackfillProgress) GetPosts() int32 {
+	if x != nil {
+		return x.Posts
+	}
+	return 0
+}
+
+func (x *BackfillProgress) GetPercentComplete() float64 {
+	if x != nil {
+		return x.PercentComplete
+	}
+	return 0
+}
+
+func (x *BackfillProgress) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ScraperSchedule struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CronExpression string                 `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"` // Cron expression for scheduled jobs
+	Frequency      ScheduleFrequency      `protobuf:"varint,2,opt,name=frequency,proto3,enum=scraper.ScheduleFrequency" json:"frequency,omitempty"` // Predefined frequency
+	StartDate      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                // Optional start date for scheduled jobs
+	EndDate        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                      // Optional end date for scheduled jobs
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScraperSchedule) Reset() {
+	*x = ScraperSchedule{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScraperSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScraperSchedule) ProtoMessage() {}
+
+func (x *ScraperSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScraperSchedule.ProtoReflect.Descriptor instead.
+func (*ScraperSchedule) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScraperSchedule) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *ScraperSchedule) GetFrequency() ScheduleFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return ScheduleFrequency_FREQUENCY_UNSPECIFIED
+}
+
+func (x *ScraperSchedule) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ScraperSchedule) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type PlatformInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                          // Platform name (e.g., "instagram", "twitter")
+	DisplayName       string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                         // Display name (e.g., "Instagram", "Twitter")
+	Description       string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                                                            // Platform description
+	SupportedJobTypes []ScraperJobType       `protobuf:"varint,4,rep,packed,name=supported_job_types,json=supportedJobTypes,proto3,enum=scraper.ScraperJobType" json:"supported_job_types,omitempty"` // Supported job types
+	RateLimits        *PlatformRateLimits    `protobuf:"bytes,5,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`                                                            // Rate limits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlatformInfo) Reset() {
+	*x = PlatformInfo{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformInfo) ProtoMessage() {}
+
+func (x *PlatformInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformInfo.ProtoReflect.Descriptor instead.
+func (*PlatformInfo) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PlatformInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlatformInfo) GetSupportedJobTypes() []ScraperJobType {
+	if x != nil {
+		return x.SupportedJobTypes
+	}
+	return nil
+}
+
+func (x *PlatformInfo) GetRateLimits() *PlatformRateLimits {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+type PlatformStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	StatusMessage string                 `protobuf:"bytes,3,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	RateLimits    *PlatformRateLimits    `protobuf:"bytes,4,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	LastChecked   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlatformStatus) Reset() {
+	*x = PlatformStatus{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformStatus) ProtoMessage() {}
+
+func (x *PlatformStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformStatus.ProtoReflect.Descriptor instead.
+func (*PlatformStatus) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlatformStatus) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PlatformStatus) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *PlatformStatus) GetStatusMessage() string {
+	if x != nil {
+		return x.StatusMessage
+	}
+	return ""
+}
+
+func (x *PlatformStatus) GetRateLimits() *PlatformRateLimits {
+	if x != nil {
+		return x.RateLimits
+	}
+	return nil
+}
+
+func (x *PlatformStatus) GetLastChecked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastChecked
+	}
+	return nil
+}
+
+type QueueStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workers       int32                  `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	Queued        int32                  `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`   // Jobs waiting across all tenants
+	Running       int32                  `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"` // Jobs running across all tenants
+	Tenants       []*TenantQueueStatus   `protobuf:"bytes,4,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueStatus) Reset() {
+	*x = QueueStatus{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueStatus) ProtoMessage() {}
+
+func (x *QueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueStatus.ProtoReflect.Descriptor instead.
+func (*QueueStatus) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueueStatus) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *QueueStatus) GetTenants() []*TenantQueueStatus {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type TenantQueueStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TenantId          string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Tier              string                 `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	Weight            int32                  `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"` // Jobs dispatched per round-robin turn
+	MaxConcurrent     int32                  `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	Queued            int32                  `protobuf:"varint,5,opt,name=queued,proto3" json:"queued,omitempty"`
+	Running           int32                  `protobuf:"varint,6,opt,name=running,proto3" json:"running,omitempty"`
+	OldestWaitSeconds float64                `protobuf:"fixed64,7,opt,name=oldest_wait_seconds,json=oldestWaitSeconds,proto3" json:"oldest_wait_seconds,omitempty"` // How long the oldest queued job has been waiting
+	AvgWaitSeconds    float64                `protobuf:"fixed64,8,opt,name=avg_wait_seconds,json=avgWaitSeconds,proto3" json:"avg_wait_seconds,omitempty"`          // Average wait of the jobs dispatched since the service started
+	Dispatched        int32                  `protobuf:"varint,9,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TenantQueueStatus) Reset() {
+	*x = TenantQueueStatus{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantQueueStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQueueStatus) ProtoMessage() {}
+
+func (x *TenantQueueStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQueueStatus.ProtoReflect.Descriptor instead.
+func (*TenantQueueStatus) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TenantQueueStatus) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantQueueStatus) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *TenantQueueStatus) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetOldestWaitSeconds() float64 {
+	if x != nil {
+		return x.OldestWaitSeconds
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetAvgWaitSeconds() float64 {
+	if x != nil {
+		return x.AvgWaitSeconds
+	}
+	return 0
+}
+
+func (x *TenantQueueStatus) GetDispatched() int32 {
+	if x != nil {
+		return x.Dispatched
+	}
+	return 0
+}
+
+type PlatformRateLimits struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RequestsPerMinute int32                  `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	RequestsPerHour   int32                  `protobuf:"varint,2,opt,name=requests_per_hour,json=requestsPerHour,proto3" json:"requests_per_hour,omitempty"`
+	RequestsPerDay    int32                  `protobuf:"varint,3,opt,name=requests_per_day,json=requestsPerDay,proto3" json:"requests_per_day,omitempty"`
+	AvailableRequests int32                  `protobuf:"varint,4,opt,name=available_requests,json=availableRequests,proto3" json:"available_requests,omitempty"`
+	ResetAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PlatformRateLimits) Reset() {
+	*x = PlatformRateLimits{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlatformRateLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlatformRateLimits) ProtoMessage() {}
+
+func (x *PlatformRateLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlatformRateLimits.ProtoReflect.Descriptor instead.
+func (*PlatformRateLimits) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PlatformRateLimits) GetRequestsPerMinute() int32 {
+	if x != nil {
+		return x.RequestsPerMinute
+	}
+	return 0
+}
+
+func (x *PlatformRateLimits) GetRequestsPerHour() int32 {
+	if x != nil {
+		return x.RequestsPerHour
+	}
+	return 0
+}
+
+func (x *PlatformRateLimits) GetRequestsPerDay() int32 {
+	if x != nil {
+		return x.RequestsPerDay
+	}
+	return 0
+}
+
+func (x *PlatformRateLimits) GetAvailableRequests() int32 {
+	if x != nil {
+		return x.AvailableRequests
+	}
+	return 0
+}
+
+func (x *PlatformRateLimits) GetResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetAt
+	}
+	return nil
+}
+
+type ScrapedDataItem struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId    string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TenantId string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Platform string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId   string                 `protobuf:"bytes,6,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	DataType ScraperDataType        `protobuf:"varint,7,opt,name=data_type,json=dataType,proto3,enum=scraper.ScraperDataType" json:"data_type,omitempty"`
+	PostedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	// Engagement metrics
+	Likes            int32   `protobuf:"varint,9,opt,name=likes,proto3" json:"likes,omitempty"`
+	Shares           int32   `protobuf:"varint,10,opt,name=shares,proto3" json:"shares,omitempty"`
+	Comments         int32   `protobuf:"varint,11,opt,name=comments,proto3" json:"comments,omitempty"`
+	ClickThroughRate float64 `protobuf:"fixed64,12,opt,name=click_through_rate,json=clickThroughRate,proto3" json:"click_through_rate,omitempty"`
+	AvgWatchTime     float64 `protobuf:"fixed64,13,opt,name=avg_watch_time,json=avgWatchTime,proto3" json:"avg_watch_time,omitempty"`
+	EngagementRate   float64 `protobuf:"fixed64,14,opt,name=engagement_rate,json=engagementRate,proto3" json:"engagement_rate,omitempty"`
+	// Content information
+	ContentType       string                 `protobuf:"bytes,15,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	ContentUrl        string                 `protobuf:"bytes,16,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`
+	ContentAttributes map[string]string      `protobuf:"bytes,17,rep,name=content_attributes,json=contentAttributes,proto3" json:"content_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ScrapedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RunId             string                 `protobuf:"bytes,20,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // Run that scraped the item
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScrapedDataItem) Reset() {
+	*x = ScrapedDataItem{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScrapedDataItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScrapedDataItem) ProtoMessage() {}
+
+func (x *ScrapedDataItem) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScrapedDataItem.ProtoReflect.Descriptor instead.
+func (*ScrapedDataItem) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScrapedDataItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetDataType() ScraperDataType {
+	if x != nil {
+		return x.DataType
+	}
+	return ScraperDataType_DATA_TYPE_UNSPECIFIED
+}
+
+func (x *ScrapedDataItem) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+func (x *ScrapedDataItem) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetClickThroughRate() float64 {
+	if x != nil {
+		return x.ClickThroughRate
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetAvgWatchTime() float64 {
+	if x != nil {
+		return x.AvgWatchTime
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetEngagementRate() float64 {
+	if x != nil {
+		return x.EngagementRate
+	}
+	return 0
+}
+
+func (x *ScrapedDataItem) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetContentUrl() string {
+	if x != nil {
+		return x.ContentUrl
+	}
+	return ""
+}
+
+func (x *ScrapedDataItem) GetContentAttributes() map[string]string {
+	if x != nil {
+		return x.ContentAttributes
+	}
+	return nil
+}
+
+func (x *ScrapedDataItem) GetScrapedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return nil
+}
+
+func (x *ScrapedDataItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScrapedDataItem) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId      string                 `protobuf:"bytes,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId     string                 `protobuf:"bytes,8,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"` // Platform-specific comment ID
+	ParentId      string                 `protobuf:"bytes,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`    // Comment being replied to, empty for top-level comments
+	AuthorId      string                 `protobuf:"bytes,10,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,11,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Text          string                 `protobuf:"bytes,12,opt,name=text,proto3" json:"text,omitempty"`
+	Likes         int32                  `protobuf:"varint,13,opt,name=likes,proto3" json:"likes,omitempty"`
+	PostedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	ScrapedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Comment) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Comment) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Comment) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Comment) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Comment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Comment) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Comment) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetScrapedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return nil
+}
+
+type Mention struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	RunId         string                 `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Platform      string                 `protobuf:"bytes,5,opt,name=platform,proto3" json:"platform,omitempty"`
+	JobType       ScraperJobType         `protobuf:"varint,6,opt,name=job_type,json=jobType,proto3,enum=scraper.ScraperJobType" json:"job_type,omitempty"`
+	Query         string                 `protobuf:"bytes,7,opt,name=query,proto3" json:"query,omitempty"` // Keyword, hashtag or account that was tracked
+	PostId        string                 `protobuf:"bytes,8,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Url           string                 `protobuf:"bytes,9,opt,name=url,proto3" json:"url,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,10,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,11,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Text          string                 `protobuf:"bytes,12,opt,name=text,proto3" json:"text,omitempty"`
+	Likes         int32                  `protobuf:"varint,13,opt,name=likes,proto3" json:"likes,omitempty"`
+	Shares        int32                  `protobuf:"varint,14,opt,name=shares,proto3" json:"shares,omitempty"`
+	Comments      int32                  `protobuf:"varint,15,opt,name=comments,proto3" json:"comments,omitempty"`
+	Views         int32                  `protobuf:"varint,16,opt,name=views,proto3" json:"views,omitempty"`
+	CompetitorId  string                 `protobuf:"bytes,17,opt,name=competitor_id,json=competitorId,proto3" json:"competitor_id,omitempty"` // Competitor the mention counts towards, if any
+	OwnBrand      bool                   `protobuf:"varint,18,opt,name=own_brand,json=ownBrand,proto3" json:"own_brand,omitempty"`            // Whether the mention counts towards the tenant's own brand
+	PostedAt      *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	ScrapedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mention) Reset() {
+	*x = Mention{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mention) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mention) ProtoMessage() {}
+
+func (x *Mention) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mention.ProtoReflect.Descriptor instead.
+func (*Mention) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Mention) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Mention) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Mention) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *Mention) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Mention) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *Mention) GetJobType() ScraperJobType {
+	if x != nil {
+		return x.JobType
+	}
+	return ScraperJobType_JOB_TYPE_UNSPECIFIED
+}
+
+func (x *Mention) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Mention) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Mention) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Mention) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Mention) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Mention) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Mention) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Mention) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *Mention) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *Mention) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *Mention) GetCompetitorId() string {
+	if x != nil {
+		return x.CompetitorId
+	}
+	return ""
+}
+
+func (x *Mention) GetOwnBrand() bool {
+	if x != nil {
+		return x.OwnBrand
+	}
+	return false
+}
+
+func (x *Mention) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+func (x *Mention) GetScrapedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return nil
+}
+
+type ShareOfVoice struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	CompetitorId    string                 `protobuf:"bytes,2,opt,name=competitor_id,json=competitorId,proto3" json:"competitor_id,omitempty"` // Empty for the tenant's own brand
+	OwnBrand        bool                   `protobuf:"varint,3,opt,name=own_brand,json=ownBrand,proto3" json:"own_brand,omitempty"`
+	Mentions        int32                  `protobuf:"varint,4,opt,name=mentions,proto3" json:"mentions,omitempty"`
+	Engagement      int32                  `protobuf:"varint,5,opt,name=engagement,proto3" json:"engagement,omitempty"`                                   // Likes, shares and comments on the mentioning posts
+	MentionShare    float64                `protobuf:"fixed64,6,opt,name=mention_share,json=mentionShare,proto3" json:"mention_share,omitempty"`          // Fraction of the period's attributed mentions
+	EngagementShare float64                `protobuf:"fixed64,7,opt,name=engagement_share,json=engagementShare,proto3" json:"engagement_share,omitempty"` // Fraction of the period's engagement on attributed mentions
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShareOfVoice) Reset() {
+	*x = ShareOfVoice{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareOfVoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareOfVoice) ProtoMessage() {}
+
+func (x *ShareOfVoice) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareOfVoice.ProtoReflect.Descriptor instead.
+func (*ShareOfVoice) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ShareOfVoice) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *ShareOfVoice) GetCompetitorId() string {
+	if x != nil {
+		return x.CompetitorId
+	}
+	return ""
+}
+
+func (x *ShareOfVoice) GetOwnBrand() bool {
+	if x != nil {
+		return x.OwnBrand
+	}
+	return false
+}
+
+func (x *ShareOfVoice) GetMentions() int32 {
+	if x != nil {
+		return x.Mentions
+	}
+	return 0
+}
+
+func (x *ShareOfVoice) GetEngagement() int32 {
+	if x != nil {
+		return x.Engagement
+	}
+	return 0
+}
+
+func (x *ShareOfVoice) GetMentionShare() float64 {
+	if x != nil {
+		return x.MentionShare
+	}
+	return 0
+}
+
+func (x *ShareOfVoice) GetEngagementShare() float64 {
+	if x != nil {
+		return x.EngagementShare
+	}
+	return 0
+}
+
+type PostSnapshot struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScrapedAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
+	Likes           int32                  `protobuf:"varint,2,opt,name=likes,proto3" json:"likes,omitempty"`
+	Shares          int32                  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	Comments        int32                  `protobuf:"varint,4,opt,name=comments,proto3" json:"comments,omitempty"`
+	Views           int32                  `protobuf:"varint,5,opt,name=views,proto3" json:"views,omitempty"`
+	LikesPerHour    float64                `protobuf:"fixed64,6,opt,name=likes_per_hour,json=likesPerHour,proto3" json:"likes_per_hour,omitempty"` // Since the previous snapshot, or since the post was published
+	SharesPerHour   float64                `protobuf:"fixed64,7,opt,name=shares_per_hour,json=sharesPerHour,proto3" json:"shares_per_hour,omitempty"`
+	CommentsPerHour float64                `protobuf:"fixed64,8,opt,name=comments_per_hour,json=commentsPerHour,proto3" json:"comments_per_hour,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PostSnapshot) Reset() {
+	*x = PostSnapshot{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostSnapshot) ProtoMessage() {}
+
+func (x *PostSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostSnapshot.ProtoReflect.Descriptor instead.
+func (*PostSnapshot) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PostSnapshot) GetScrapedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScrapedAt
+	}
+	return nil
+}
+
+func (x *PostSnapshot) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetLikesPerHour() float64 {
+	if x != nil {
+		return x.LikesPerHour
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetSharesPerHour() float64 {
+	if x != nil {
+		return x.SharesPerHour
+	}
+	return 0
+}
+
+func (x *PostSnapshot) GetCommentsPerHour() float64 {
+	if x != nil {
+		return x.CommentsPerHour
+	}
+	return 0
+}
+
+type PostVelocity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Platform        string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId        string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId          string                 `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PostedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"` // Unset when unknown, in which case rates start from the first snapshot
+	Likes           int32                  `protobuf:"varint,5,opt,name=likes,proto3" json:"likes,omitempty"`                      // Counters of the latest snapshot
+	Shares          int32                  `protobuf:"varint,6,opt,name=shares,proto3" json:"shares,omitempty"`
+	Comments        int32                  `protobuf:"varint,7,opt,name=comments,proto3" json:"comments,omitempty"`
+	Views           int32                  `protobuf:"varint,8,opt,name=views,proto3" json:"views,omitempty"`
+	LikesPerHour    float64                `protobuf:"fixed64,9,opt,name=likes_per_hour,json=likesPerHour,proto3" json:"likes_per_hour,omitempty"` // Since the post was published
+	SharesPerHour   float64                `protobuf:"fixed64,10,opt,name=shares_per_hour,json=sharesPerHour,proto3" json:"shares_per_hour,omitempty"`
+	CommentsPerHour float64                `protobuf:"fixed64,11,opt,name=comments_per_hour,json=commentsPerHour,proto3" json:"comments_per_hour,omitempty"`
+	Snapshots       []*PostSnapshot        `protobuf:"bytes,12,rep,name=snapshots,proto3" json:"snapshots,omitempty"` // Oldest first, one per change in the counters
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PostVelocity) Reset() {
+	*x = PostVelocity{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostVelocity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostVelocity) ProtoMessage() {}
+
+func (x *PostVelocity) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostVelocity.ProtoReflect.Descriptor instead.
+func (*PostVelocity) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PostVelocity) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *PostVelocity) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *PostVelocity) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostVelocity) GetPostedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PostedAt
+	}
+	return nil
+}
+
+func (x *PostVelocity) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetShares() int32 {
+	if x != nil {
+		return x.Shares
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetLikesPerHour() float64 {
+	if x != nil {
+		return x.LikesPerHour
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetSharesPerHour() float64 {
+	if x != nil {
+		return x.SharesPerHour
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetCommentsPerHour() float64 {
+	if x != nil {
+		return x.CommentsPerHour
+	}
+	return 0
+}
+
+func (x *PostVelocity) GetSnapshots() []*PostSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type JobRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	RunNumber     int32                  `protobuf:"varint,4,opt,name=run_number,json=runNumber,proto3" json:"run_number,omitempty"` // Retries and runs deferred by a rate limit share their number with the next attempt
+	Status        JobRunStatus           `protobuf:"varint,5,opt,name=status,proto3,enum=scraper.JobRunStatus" json:"status,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ItemsScraped  int32                  `protobuf:"varint,8,opt,name=items_scraped,json=itemsScraped,proto3" json:"items_scraped,omitempty"`
+	RequestsUsed  int32                  `protobuf:"varint,9,opt,name=requests_used,json=requestsUsed,proto3" json:"requests_used,omitempty"` // Requests counted against the platform rate limit
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Attempt       int32                  `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"` // Attempt within the run, starting at 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRun) Reset() {
+	*x = JobRun{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRun) ProtoMessage() {}
+
+func (x *JobRun) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRun.ProtoReflect.Descriptor instead.
+func (*JobRun) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *JobRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobRun) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobRun) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *JobRun) GetRunNumber() int32 {
+	if x != nil {
+		return x.RunNumber
+	}
+	return 0
+}
+
+func (x *JobRun) GetStatus() JobRunStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobRunStatus_RUN_STATUS_UNSPECIFIED
+}
+
+func (x *JobRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
 }
 
-func (x *PlatformInfo) GetName() string {
+func (x *JobRun) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.FinishedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *PlatformInfo) GetDisplayName() string {
+func (x *JobRun) GetItemsScraped() int32 {
 	if x != nil {
-		return x.DisplayName
+		return x.ItemsScraped
 	}
-	return ""
+	return 0
 }
 
-func (x *PlatformInfo) GetDescription() string {
+func (x *JobRun) GetRequestsUsed() int32 {
 	if x != nil {
-		return x.Description
+		return x.RequestsUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *PlatformInfo) GetSupportedJobTypes() []ScraperJobType {
+func (x *JobRun) GetError() string {
 	if x != nil {
-		return x.SupportedJobTypes
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *PlatformInfo) GetRateLimits() *PlatformRateLimits {
+func (x *JobRun) GetAttempt() int32 {
 	if x != nil {
-		return x.RateLimits
+		return x.Attempt
 	}
-	return nil
+	return 0
 }
 
-type PlatformStatus struct {
+type RetentionPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Platform      string                 `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
-	Available     bool                   `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
-	StatusMessage string                 `protobuf:"bytes,3,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
-	RateLimits    *PlatformRateLimits    `protobuf:"bytes,4,opt,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
-	LastChecked   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_checked,json=lastChecked,proto3" json:"last_checked,omitempty"`
+	DataType      ScraperDataType        `protobuf:"varint,1,opt,name=data_type,json=dataType,proto3,enum=scraper.ScraperDataType" json:"data_type,omitempty"`
+	RetentionDays int32                  `protobuf:"varint,2,opt,name=retention_days,json=retentionDays,proto3" json:"retention_days,omitempty"` // 0 keeps the data forever
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`             // The tenant hasn't set a policy, so the default applies
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformStatus) Reset() {
-	*x = PlatformStatus{}
-	mi := &file_scraper_pb_scraper_proto_msgTypes[14]
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformStatus) String() string {
+func (x *RetentionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformStatus) ProtoMessage() {}
+func (*RetentionPolicy) ProtoMessage() {}
 
-func (x *PlatformStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_scraper_pb_scraper_proto_msgTypes[14]
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,72 +4228,65 @@ func (x *PlatformStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformStatus.ProtoReflect.Descriptor instead.
-func (*PlatformStatus) Descriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *PlatformStatus) GetPlatform() string {
-	if x != nil {
-		return x.Platform
-	}
-	return ""
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PlatformStatus) GetAvailable() bool {
+func (x *RetentionPolicy) GetDataType() ScraperDataType {
 	if x != nil {
-		return x.Available
+		return x.DataType
 	}
-	return false
+	return ScraperDataType_DATA_TYPE_UNSPECIFIED
 }
 
-func (x *PlatformStatus) GetStatusMessage() string {
+func (x *RetentionPolicy) GetRetentionDays() int32 {
 	if x != nil {
-		return x.StatusMessage
+		return x.RetentionDays
 	}
-	return ""
+	return 0
 }
 
-func (x *PlatformStatus) GetRateLimits() *PlatformRateLimits {
+func (x *RetentionPolicy) GetIsDefault() bool {
 	if x != nil {
-		return x.RateLimits
+		return x.IsDefault
 	}
-	return nil
+	return false
 }
 
-func (x *PlatformStatus) GetLastChecked() *timestamppb.Timestamp {
+func (x *RetentionPolicy) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastChecked
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type PlatformRateLimits struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	RequestsPerMinute int32                  `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
-	RequestsPerHour   int32                  `protobuf:"varint,2,opt,name=requests_per_hour,json=requestsPerHour,proto3" json:"requests_per_hour,omitempty"`
-	RequestsPerDay    int32                  `protobuf:"varint,3,opt,name=requests_per_day,json=requestsPerDay,proto3" json:"requests_per_day,omitempty"`
-	AvailableRequests int32                  `protobuf:"varint,4,opt,name=available_requests,json=availableRequests,proto3" json:"available_requests,omitempty"`
-	ResetAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type StorageUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Table         string                 `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	DataType      ScraperDataType        `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=scraper.ScraperDataType" json:"data_type,omitempty"` // Only set for scraped_data
+	Rows          int64                  `protobuf:"varint,3,opt,name=rows,proto3" json:"rows,omitempty"`
+	OldestAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=oldest_at,json=oldestAt,proto3" json:"oldest_at,omitempty"`
+	NewestAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=newest_at,json=newestAt,proto3" json:"newest_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PlatformRateLimits) Reset() {
-	*x = PlatformRateLimits{}
-	mi := &file_scraper_pb_scraper_proto_msgTypes[15]
+func (x *StorageUsage) Reset() {
+	*x = StorageUsage{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlatformRateLimits) String() string {
+func (x *StorageUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlatformRateLimits) ProtoMessage() {}
+func (*StorageUsage) ProtoMessage() {}
 
-func (x *PlatformRateLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_scraper_pb_scraper_proto_msgTypes[15]
+func (x *StorageUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1268,88 +4297,83 @@ func (x *PlatformRateLimits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlatformRateLimits.ProtoReflect.Descriptor instead.
-func (*PlatformRateLimits) Descriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use StorageUsage.ProtoReflect.Descriptor instead.
+func (*StorageUsage) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *PlatformRateLimits) GetRequestsPerMinute() int32 {
+func (x *StorageUsage) GetTable() string {
 	if x != nil {
-		return x.RequestsPerMinute
+		return x.Table
 	}
-	return 0
+	return ""
 }
 
-func (x *PlatformRateLimits) GetRequestsPerHour() int32 {
+func (x *StorageUsage) GetDataType() ScraperDataType {
 	if x != nil {
-		return x.RequestsPerHour
+		return x.DataType
 	}
-	return 0
+	return ScraperDataType_DATA_TYPE_UNSPECIFIED
 }
 
-func (x *PlatformRateLimits) GetRequestsPerDay() int32 {
+func (x *StorageUsage) GetRows() int64 {
 	if x != nil {
-		return x.RequestsPerDay
+		return x.Rows
 	}
 	return 0
 }
 
-func (x *PlatformRateLimits) GetAvailableRequests() int32 {
+func (x *StorageUsage) GetOldestAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AvailableRequests
+		return x.OldestAt
 	}
-	return 0
+	return nil
 }
 
-func (x *PlatformRateLimits) GetResetAt() *timestamppb.Timestamp {
+func (x *StorageUsage) GetNewestAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ResetAt
+		return x.NewestAt
 	}
 	return nil
 }
 
-type ScrapedDataItem struct {
-	state    protoimpl.MessageState `protogen:"open.v1"`
-	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	JobId    string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	TenantId string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	Platform string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
-	TargetId string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
-	PostId   string                 `protobuf:"bytes,6,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	DataType ScraperDataType        `protobuf:"varint,7,opt,name=data_type,json=dataType,proto3,enum=scraper.ScraperDataType" json:"data_type,omitempty"`
-	PostedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
-	// Engagement metrics
-	Likes            int32   `protobuf:"varint,9,opt,name=likes,proto3" json:"likes,omitempty"`
-	Shares           int32   `protobuf:"varint,10,opt,name=shares,proto3" json:"shares,omitempty"`
-	Comments         int32   `protobuf:"varint,11,opt,name=comments,proto3" json:"comments,omitempty"`
-	ClickThroughRate float64 `protobuf:"fixed64,12,opt,name=click_through_rate,json=clickThroughRate,proto3" json:"click_through_rate,omitempty"`
-	AvgWatchTime     float64 `protobuf:"fixed64,13,opt,name=avg_watch_time,json=avgWatchTime,proto3" json:"avg_watch_time,omitempty"`
-	EngagementRate   float64 `protobuf:"fixed64,14,opt,name=engagement_rate,json=engagementRate,proto3" json:"engagement_rate,omitempty"`
-	// Content information
-	ContentType       string                 `protobuf:"bytes,15,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	ContentUrl        string                 `protobuf:"bytes,16,opt,name=content_url,json=contentUrl,proto3" json:"content_url,omitempty"`
-	ContentAttributes map[string]string      `protobuf:"bytes,17,rep,name=content_attributes,json=contentAttributes,proto3" json:"content_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ScrapedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=scraped_at,json=scrapedAt,proto3" json:"scraped_at,omitempty"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type MediaAsset struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId       string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	JobId          string                 `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Platform       string                 `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`
+	TargetId       string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	PostId         string                 `protobuf:"bytes,6,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	SourceUrl      string                 `protobuf:"bytes,7,opt,name=source_url,json=sourceUrl,proto3" json:"source_url,omitempty"`    // Where the media was downloaded from
+	StorageKey     string                 `protobuf:"bytes,8,opt,name=storage_key,json=storageKey,proto3" json:"storage_key,omitempty"` // Key of the copy in the media store
+	ContentType    string                 `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes      int64                  `protobuf:"varint,10,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width          int32                  `protobuf:"varint,11,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty"`
+	Duration       float64                `protobuf:"fixed64,13,opt,name=duration,proto3" json:"duration,omitempty"` // Seconds, for video and audio
+	Sha256         string                 `protobuf:"bytes,14,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	PerceptualHash string                 `protobuf:"bytes,15,opt,name=perceptual_hash,json=perceptualHash,proto3" json:"perceptual_hash,omitempty"` // Only set for images
+	CapturedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ScrapedDataItem) Reset() {
-	*x = ScrapedDataItem{}
-	mi := &file_scraper_pb_scraper_proto_msgTypes[16]
+func (x *MediaAsset) Reset() {
+	*x = MediaAsset{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScrapedDataItem) String() string {
+func (x *MediaAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScrapedDataItem) ProtoMessage() {}
+func (*MediaAsset) ProtoMessage() {}
 
-func (x *ScrapedDataItem) ProtoReflect() protoreflect.Message {
-	mi := &file_scraper_pb_scraper_proto_msgTypes[16]
+func (x *MediaAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,190 +4384,343 @@ func (x *ScrapedDataItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScrapedDataItem.ProtoReflect.Descriptor instead.
-func (*ScrapedDataItem) Descriptor() ([]byte, []int) {
-	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use MediaAsset.ProtoReflect.Descriptor instead.
+func (*MediaAsset) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ScrapedDataItem) GetId() string {
+func (x *MediaAsset) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetJobId() string {
+func (x *MediaAsset) GetTenantId() string {
 	if x != nil {
-		return x.JobId
+		return x.TenantId
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetTenantId() string {
+func (x *MediaAsset) GetJobId() string {
 	if x != nil {
-		return x.TenantId
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetPlatform() string {
+func (x *MediaAsset) GetPlatform() string {
 	if x != nil {
 		return x.Platform
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetTargetId() string {
+func (x *MediaAsset) GetTargetId() string {
 	if x != nil {
 		return x.TargetId
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetPostId() string {
+func (x *MediaAsset) GetPostId() string {
 	if x != nil {
 		return x.PostId
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetDataType() ScraperDataType {
+func (x *MediaAsset) GetSourceUrl() string {
 	if x != nil {
-		return x.DataType
+		return x.SourceUrl
 	}
-	return ScraperDataType_DATA_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *ScrapedDataItem) GetPostedAt() *timestamppb.Timestamp {
+func (x *MediaAsset) GetStorageKey() string {
 	if x != nil {
-		return x.PostedAt
+		return x.StorageKey
 	}
-	return nil
+	return ""
 }
 
-func (x *ScrapedDataItem) GetLikes() int32 {
+func (x *MediaAsset) GetContentType() string {
 	if x != nil {
-		return x.Likes
+		return x.ContentType
 	}
-	return 0
+	return ""
 }
 
-func (x *ScrapedDataItem) GetShares() int32 {
+func (x *MediaAsset) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Shares
+		return x.SizeBytes
 	}
 	return 0
 }
 
-func (x *ScrapedDataItem) GetComments() int32 {
+func (x *MediaAsset) GetWidth() int32 {
 	if x != nil {
-		return x.Comments
+		return x.Width
 	}
 	return 0
 }
 
-func (x *ScrapedDataItem) GetClickThroughRate() float64 {
+func (x *MediaAsset) GetHeight() int32 {
 	if x != nil {
-		return x.ClickThroughRate
+		return x.Height
 	}
 	return 0
 }
 
-func (x *ScrapedDataItem) GetAvgWatchTime() float64 {
+func (x *MediaAsset) GetDuration() float64 {
 	if x != nil {
-		return x.AvgWatchTime
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *ScrapedDataItem) GetEngagementRate() float64 {
+func (x *MediaAsset) GetSha256() string {
 	if x != nil {
-		return x.EngagementRate
+		return x.Sha256
 	}
-	return 0
+	return ""
 }
 
-func (x *ScrapedDataItem) GetContentType() string {
+func (x *MediaAsset) GetPerceptualHash() string {
 	if x != nil {
-		return x.ContentType
+		return x.PerceptualHash
 	}
 	return ""
 }
 
-func (x *ScrapedDataItem) GetContentUrl() string {
+func (x *MediaAsset) GetCapturedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ContentUrl
+		return x.CapturedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *ScrapedDataItem) GetContentAttributes() map[string]string {
+type SimilarMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         *MediaAsset            `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Distance      int32                  `protobuf:"varint,2,opt,name=distance,proto3" json:"distance,omitempty"` // 0 for the same image
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarMedia) Reset() {
+	*x = SimilarMedia{}
+	mi := &file_scraper_pb_scraper_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarMedia) ProtoMessage() {}
+
+func (x *SimilarMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_scraper_pb_scraper_proto_msgTypes[52]
 	if x != nil {
-		return x.ContentAttributes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ScrapedDataItem) GetScrapedAt() *timestamppb.Timestamp {
+// Deprecated: Use SimilarMedia.ProtoReflect.Descriptor instead.
+func (*SimilarMedia) Descriptor() ([]byte, []int) {
+	return file_scraper_pb_scraper_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SimilarMedia) GetAsset() *MediaAsset {
 	if x != nil {
-		return x.ScrapedAt
+		return x.Asset
 	}
 	return nil
 }
 
-func (x *ScrapedDataItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *SimilarMedia) GetDistance() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Distance
 	}
-	return nil
+	return 0
 }
 
 var File_scraper_pb_scraper_proto protoreflect.FileDescriptor
 
 const file_scraper_pb_scraper_proto_rawDesc = "" +
 	"\n" +
-	"\x18scraper/pb/scraper.proto\x12\ascraper\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xe2\x02\n" +
+	"\x18scraper/pb/scraper.proto\x12\ascraper\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc1\x03\n" +
 	"\x17CreateScraperJobRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1b\n" +
 	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x122\n" +
 	"\bjob_type\x18\x04 \x01(\x0e2\x17.scraper.ScraperJobTypeR\ajobType\x124\n" +
 	"\bschedule\x18\x05 \x01(\v2\x18.scraper.ScraperScheduleR\bschedule\x12J\n" +
-	"\bmetadata\x18\x06 \x03(\v2..scraper.CreateScraperJobRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x06 \x03(\v2..scraper.CreateScraperJobRequest.MetadataEntryR\bmetadata\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12A\n" +
+	"\x0ebackfill_until\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rbackfillUntil\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"J\n" +
 	"\x14GetScraperJobRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
-	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xb8\x01\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"\xf4\x01\n" +
 	"\x16ListScraperJobsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
 	"\bplatform\x18\x02 \x01(\tR\bplatform\x122\n" +
 	"\bjob_type\x18\x03 \x01(\x0e2\x17.scraper.ScraperJobTypeR\ajobType\x121\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x19.scraper.ScraperJobStatusR\x06status\"B\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.scraper.ScraperJobStatusR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x8b\x01\n" +
 	"\x17ListScraperJobsResponse\x12'\n" +
-	"\x04jobs\x18\x01 \x03(\v2\x13.scraper.ScraperJobR\x04jobs\"M\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x13.scraper.ScraperJobR\x04jobs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"M\n" +
 	"\x17CancelScraperJobRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
 	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"M\n" +
 	"\x17DeleteScraperJobRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
-	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"<\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"N\n" +
+	"\x18RequeueScraperJobRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\"i\n" +
+	"\x17WatchScraperJobsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\"\xc7\x01\n" +
+	"\x0fScraperJobEvent\x12%\n" +
+	"\x03job\x18\x01 \x01(\v2\x13.scraper.ScraperJobR\x03job\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12#\n" +
+	"\ritems_scraped\x18\x03 \x01(\x05R\fitemsScraped\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"<\n" +
 	"\x1dListSupportedPlatformsRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"U\n" +
 	"\x1eListSupportedPlatformsResponse\x123\n" +
 	"\tplatforms\x18\x01 \x03(\v2\x15.scraper.PlatformInfoR\tplatforms\"S\n" +
 	"\x18GetPlatformStatusRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
-	"\bplatform\x18\x02 \x01(\tR\bplatform\"\xbd\x01\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\"4\n" +
+	"\x15GetQueueStatusRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\xf9\x01\n" +
 	"\x15GetScrapedDataRequest\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
 	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x129\n" +
 	"\n" +
 	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"H\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x91\x01\n" +
 	"\x16GetScrapedDataResponse\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.scraper.ScrapedDataItemR\x05items\"\xb5\x05\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.scraper.ScrapedDataItemR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\x95\x02\n" +
+	"\x13ListCommentsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"\x14ListCommentsResponse\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.scraper.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xfc\x01\n" +
+	"\x13ListMentionsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x8d\x01\n" +
+	"\x14ListMentionsResponse\x12,\n" +
+	"\bmentions\x18\x01 \x03(\v2\x10.scraper.MentionR\bmentions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"\xdf\x01\n" +
+	"\x16GetShareOfVoiceRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\tR\binterval\"H\n" +
+	"\x17GetShareOfVoiceResponse\x12-\n" +
+	"\x06shares\x18\x01 \x03(\v2\x15.scraper.ShareOfVoiceR\x06shares\"\xf9\x01\n" +
+	"\x16GetPostVelocityRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\tR\x06postId\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"F\n" +
+	"\x17GetPostVelocityResponse\x12+\n" +
+	"\x05posts\x18\x01 \x03(\v2\x15.scraper.PostVelocityR\x05posts\"\x84\x01\n" +
+	"\x12ListJobRunsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x13ListJobRunsResponse\x12#\n" +
+	"\x04runs\x18\x01 \x03(\v2\x0f.scraper.JobRunR\x04runs\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\";\n" +
+	"\x1cListRetentionPoliciesRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"U\n" +
+	"\x1dListRetentionPoliciesResponse\x124\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x18.scraper.RetentionPolicyR\bpolicies\"\x96\x01\n" +
+	"\x19SetRetentionPolicyRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x125\n" +
+	"\tdata_type\x18\x02 \x01(\x0e2\x18.scraper.ScraperDataTypeR\bdataType\x12%\n" +
+	"\x0eretention_days\x18\x03 \x01(\x05R\rretentionDays\"5\n" +
+	"\x16GetStorageUsageRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"e\n" +
+	"\x17GetStorageUsageResponse\x12+\n" +
+	"\x05usage\x18\x01 \x03(\v2\x15.scraper.StorageUsageR\x05usage\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x03R\ttotalRows\"\xc3\x01\n" +
+	"\x16ListMediaAssetsRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bplatform\x18\x02 \x01(\tR\bplatform\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x17\n" +
+	"\apost_id\x18\x04 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\x8f\x01\n" +
+	"\x17ListMediaAssetsResponse\x12+\n" +
+	"\x06assets\x18\x01 \x03(\v2\x13.scraper.MediaAssetR\x06assets\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\"t\n" +
+	"\x17FindSimilarMediaRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x19\n" +
+	"\basset_id\x18\x02 \x01(\tR\aassetId\x12!\n" +
+	"\fmax_distance\x18\x03 \x01(\x05R\vmaxDistance\"K\n" +
+	"\x18FindSimilarMediaResponse\x12/\n" +
+	"\amatches\x18\x01 \x03(\v2\x15.scraper.SimilarMediaR\amatches\"\xbb\b\n" +
 	"\n" +
 	"ScraperJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
@@ -1563,10 +4740,29 @@ const file_scraper_pb_scraper_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\battempts\x18\x0f \x01(\x05R\battempts\x12\x1a\n" +
+	"\bpriority\x18\x10 \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"claimed_by\x18\x11 \x01(\tR\tclaimedBy\x12D\n" +
+	"\x10lease_expires_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x0eleaseExpiresAt\x12\x1b\n" +
+	"\tparent_id\x18\x13 \x01(\tR\bparentId\x12\"\n" +
+	"\rparent_run_id\x18\x14 \x01(\tR\vparentRunId\x12/\n" +
+	"\bchildren\x18\x15 \x03(\v2\x13.scraper.ScraperJobR\bchildren\x12>\n" +
+	"\rrollup_status\x18\x16 \x01(\x0e2\x19.scraper.ScraperJobStatusR\frollupStatus\x125\n" +
+	"\bbackfill\x18\x17 \x01(\v2\x19.scraper.BackfillProgressR\bbackfill\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe6\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd7\x02\n" +
+	"\x10BackfillProgress\x120\n" +
+	"\x05until\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x129\n" +
+	"\n" +
+	"started_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12@\n" +
+	"\x0eoldest_post_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\foldestPostAt\x12\x14\n" +
+	"\x05pages\x18\x04 \x01(\x05R\x05pages\x12\x14\n" +
+	"\x05posts\x18\x05 \x01(\x05R\x05posts\x12)\n" +
+	"\x10percent_complete\x18\x06 \x01(\x01R\x0fpercentComplete\x12=\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xe6\x01\n" +
 	"\x0fScraperSchedule\x12'\n" +
 	"\x0fcron_expression\x18\x01 \x01(\tR\x0ecronExpression\x128\n" +
 	"\tfrequency\x18\x02 \x01(\x0e2\x1a.scraper.ScheduleFrequencyR\tfrequency\x129\n" +
@@ -1586,13 +4782,30 @@ const file_scraper_pb_scraper_proto_rawDesc = "" +
 	"\x0estatus_message\x18\x03 \x01(\tR\rstatusMessage\x12<\n" +
 	"\vrate_limits\x18\x04 \x01(\v2\x1b.scraper.PlatformRateLimitsR\n" +
 	"rateLimits\x12=\n" +
-	"\flast_checked\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastChecked\"\x80\x02\n" +
+	"\flast_checked\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vlastChecked\"\x8f\x01\n" +
+	"\vQueueStatus\x12\x18\n" +
+	"\aworkers\x18\x01 \x01(\x05R\aworkers\x12\x16\n" +
+	"\x06queued\x18\x02 \x01(\x05R\x06queued\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\x05R\arunning\x124\n" +
+	"\atenants\x18\x04 \x03(\v2\x1a.scraper.TenantQueueStatusR\atenants\"\xaf\x02\n" +
+	"\x11TenantQueueStatus\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x12\n" +
+	"\x04tier\x18\x02 \x01(\tR\x04tier\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x05R\x06weight\x12%\n" +
+	"\x0emax_concurrent\x18\x04 \x01(\x05R\rmaxConcurrent\x12\x16\n" +
+	"\x06queued\x18\x05 \x01(\x05R\x06queued\x12\x18\n" +
+	"\arunning\x18\x06 \x01(\x05R\arunning\x12.\n" +
+	"\x13oldest_wait_seconds\x18\a \x01(\x01R\x11oldestWaitSeconds\x12(\n" +
+	"\x10avg_wait_seconds\x18\b \x01(\x01R\x0eavgWaitSeconds\x12\x1e\n" +
+	"\n" +
+	"dispatched\x18\t \x01(\x05R\n" +
+	"dispatched\"\x80\x02\n" +
 	"\x12PlatformRateLimits\x12.\n" +
 	"\x13requests_per_minute\x18\x01 \x01(\x05R\x11requestsPerMinute\x12*\n" +
 	"\x11requests_per_hour\x18\x02 \x01(\x05R\x0frequestsPerHour\x12(\n" +
 	"\x10requests_per_day\x18\x03 \x01(\x05R\x0erequestsPerDay\x12-\n" +
 	"\x12available_requests\x18\x04 \x01(\x05R\x11availableRequests\x125\n" +
-	"\breset_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt\"\xbe\x06\n" +
+	"\breset_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aresetAt\"\xd5\x06\n" +
 	"\x0fScrapedDataItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1b\n" +
@@ -1616,17 +4829,154 @@ const file_scraper_pb_scraper_proto_rawDesc = "" +
 	"\n" +
 	"scraped_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tscrapedAt\x129\n" +
 	"\n" +
-	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1aD\n" +
+	"created_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x15\n" +
+	"\x06run_id\x18\x14 \x01(\tR\x05runId\x1aD\n" +
 	"\x16ContentAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\x9c\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xce\x03\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12\x1a\n" +
+	"\bplatform\x18\x05 \x01(\tR\bplatform\x12\x1b\n" +
+	"\ttarget_id\x18\x06 \x01(\tR\btargetId\x12\x17\n" +
+	"\apost_id\x18\a \x01(\tR\x06postId\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\b \x01(\tR\tcommentId\x12\x1b\n" +
+	"\tparent_id\x18\t \x01(\tR\bparentId\x12\x1b\n" +
+	"\tauthor_id\x18\n" +
+	" \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\v \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04text\x18\f \x01(\tR\x04text\x12\x14\n" +
+	"\x05likes\x18\r \x01(\x05R\x05likes\x127\n" +
+	"\tposted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\bpostedAt\x129\n" +
+	"\n" +
+	"scraped_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tscrapedAt\"\xdd\x04\n" +
+	"\aMention\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x15\n" +
+	"\x06run_id\x18\x04 \x01(\tR\x05runId\x12\x1a\n" +
+	"\bplatform\x18\x05 \x01(\tR\bplatform\x122\n" +
+	"\bjob_type\x18\x06 \x01(\x0e2\x17.scraper.ScraperJobTypeR\ajobType\x12\x14\n" +
+	"\x05query\x18\a \x01(\tR\x05query\x12\x17\n" +
+	"\apost_id\x18\b \x01(\tR\x06postId\x12\x10\n" +
+	"\x03url\x18\t \x01(\tR\x03url\x12\x1b\n" +
+	"\tauthor_id\x18\n" +
+	" \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\v \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04text\x18\f \x01(\tR\x04text\x12\x14\n" +
+	"\x05likes\x18\r \x01(\x05R\x05likes\x12\x16\n" +
+	"\x06shares\x18\x0e \x01(\x05R\x06shares\x12\x1a\n" +
+	"\bcomments\x18\x0f \x01(\x05R\bcomments\x12\x14\n" +
+	"\x05views\x18\x10 \x01(\x05R\x05views\x12#\n" +
+	"\rcompetitor_id\x18\x11 \x01(\tR\fcompetitorId\x12\x1b\n" +
+	"\town_brand\x18\x12 \x01(\bR\bownBrand\x127\n" +
+	"\tposted_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\bpostedAt\x129\n" +
+	"\n" +
+	"scraped_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tscrapedAt\"\x9b\x02\n" +
+	"\fShareOfVoice\x12=\n" +
+	"\fperiod_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12#\n" +
+	"\rcompetitor_id\x18\x02 \x01(\tR\fcompetitorId\x12\x1b\n" +
+	"\town_brand\x18\x03 \x01(\bR\bownBrand\x12\x1a\n" +
+	"\bmentions\x18\x04 \x01(\x05R\bmentions\x12\x1e\n" +
+	"\n" +
+	"engagement\x18\x05 \x01(\x05R\n" +
+	"engagement\x12#\n" +
+	"\rmention_share\x18\x06 \x01(\x01R\fmentionShare\x12)\n" +
+	"\x10engagement_share\x18\a \x01(\x01R\x0fengagementShare\"\xa3\x02\n" +
+	"\fPostSnapshot\x129\n" +
+	"\n" +
+	"scraped_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tscrapedAt\x12\x14\n" +
+	"\x05likes\x18\x02 \x01(\x05R\x05likes\x12\x16\n" +
+	"\x06shares\x18\x03 \x01(\x05R\x06shares\x12\x1a\n" +
+	"\bcomments\x18\x04 \x01(\x05R\bcomments\x12\x14\n" +
+	"\x05views\x18\x05 \x01(\x05R\x05views\x12$\n" +
+	"\x0elikes_per_hour\x18\x06 \x01(\x01R\flikesPerHour\x12&\n" +
+	"\x0fshares_per_hour\x18\a \x01(\x01R\rsharesPerHour\x12*\n" +
+	"\x11comments_per_hour\x18\b \x01(\x01R\x0fcommentsPerHour\"\xa8\x03\n" +
+	"\fPostVelocity\x12\x1a\n" +
+	"\bplatform\x18\x01 \x01(\tR\bplatform\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x17\n" +
+	"\apost_id\x18\x03 \x01(\tR\x06postId\x127\n" +
+	"\tposted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bpostedAt\x12\x14\n" +
+	"\x05likes\x18\x05 \x01(\x05R\x05likes\x12\x16\n" +
+	"\x06shares\x18\x06 \x01(\x05R\x06shares\x12\x1a\n" +
+	"\bcomments\x18\a \x01(\x05R\bcomments\x12\x14\n" +
+	"\x05views\x18\b \x01(\x05R\x05views\x12$\n" +
+	"\x0elikes_per_hour\x18\t \x01(\x01R\flikesPerHour\x12&\n" +
+	"\x0fshares_per_hour\x18\n" +
+	" \x01(\x01R\rsharesPerHour\x12*\n" +
+	"\x11comments_per_hour\x18\v \x01(\x01R\x0fcommentsPerHour\x123\n" +
+	"\tsnapshots\x18\f \x03(\v2\x15.scraper.PostSnapshotR\tsnapshots\"\x8c\x03\n" +
+	"\x06JobRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\x12\x1d\n" +
+	"\n" +
+	"run_number\x18\x04 \x01(\x05R\trunNumber\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.scraper.JobRunStatusR\x06status\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12#\n" +
+	"\ritems_scraped\x18\b \x01(\x05R\fitemsScraped\x12#\n" +
+	"\rrequests_used\x18\t \x01(\x05R\frequestsUsed\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x18\n" +
+	"\aattempt\x18\v \x01(\x05R\aattempt\"\xc9\x01\n" +
+	"\x0fRetentionPolicy\x125\n" +
+	"\tdata_type\x18\x01 \x01(\x0e2\x18.scraper.ScraperDataTypeR\bdataType\x12%\n" +
+	"\x0eretention_days\x18\x02 \x01(\x05R\rretentionDays\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bR\tisDefault\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x01\n" +
+	"\fStorageUsage\x12\x14\n" +
+	"\x05table\x18\x01 \x01(\tR\x05table\x125\n" +
+	"\tdata_type\x18\x02 \x01(\x0e2\x18.scraper.ScraperDataTypeR\bdataType\x12\x12\n" +
+	"\x04rows\x18\x03 \x01(\x03R\x04rows\x127\n" +
+	"\toldest_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\boldestAt\x127\n" +
+	"\tnewest_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnewestAt\"\xec\x03\n" +
+	"\n" +
+	"MediaAsset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\tR\x05jobId\x12\x1a\n" +
+	"\bplatform\x18\x04 \x01(\tR\bplatform\x12\x1b\n" +
+	"\ttarget_id\x18\x05 \x01(\tR\btargetId\x12\x17\n" +
+	"\apost_id\x18\x06 \x01(\tR\x06postId\x12\x1d\n" +
+	"\n" +
+	"source_url\x18\a \x01(\tR\tsourceUrl\x12\x1f\n" +
+	"\vstorage_key\x18\b \x01(\tR\n" +
+	"storageKey\x12!\n" +
+	"\fcontent_type\x18\t \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\n" +
+	" \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\v \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\f \x01(\x05R\x06height\x12\x1a\n" +
+	"\bduration\x18\r \x01(\x01R\bduration\x12\x16\n" +
+	"\x06sha256\x18\x0e \x01(\tR\x06sha256\x12'\n" +
+	"\x0fperceptual_hash\x18\x0f \x01(\tR\x0eperceptualHash\x12;\n" +
+	"\vcaptured_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"capturedAt\"U\n" +
+	"\fSimilarMedia\x12)\n" +
+	"\x05asset\x18\x01 \x01(\v2\x13.scraper.MediaAssetR\x05asset\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance*\xdf\x01\n" +
 	"\x0eScraperJobType\x12\x18\n" +
 	"\x14JOB_TYPE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10JOB_TYPE_PROFILE\x10\x01\x12\x12\n" +
 	"\x0eJOB_TYPE_POSTS\x10\x02\x12\x17\n" +
 	"\x13JOB_TYPE_ENGAGEMENT\x10\x03\x12\x15\n" +
 	"\x11JOB_TYPE_COMMENTS\x10\x04\x12\x16\n" +
-	"\x12JOB_TYPE_FOLLOWERS\x10\x05*\xc3\x01\n" +
+	"\x12JOB_TYPE_FOLLOWERS\x10\x05\x12\x14\n" +
+	"\x10JOB_TYPE_KEYWORD\x10\x06\x12\x14\n" +
+	"\x10JOB_TYPE_HASHTAG\x10\a\x12\x15\n" +
+	"\x11JOB_TYPE_MENTIONS\x10\b*\xdf\x01\n" +
 	"\x10ScraperJobStatus\x12\x1a\n" +
 	"\x16JOB_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12JOB_STATUS_PENDING\x10\x01\x12\x18\n" +
@@ -1634,29 +4984,50 @@ const file_scraper_pb_scraper_proto_rawDesc = "" +
 	"\x12JOB_STATUS_RUNNING\x10\x03\x12\x18\n" +
 	"\x14JOB_STATUS_COMPLETED\x10\x04\x12\x15\n" +
 	"\x11JOB_STATUS_FAILED\x10\x05\x12\x18\n" +
-	"\x14JOB_STATUS_CANCELLED\x10\x06*\x83\x01\n" +
+	"\x14JOB_STATUS_CANCELLED\x10\x06\x12\x1a\n" +
+	"\x16JOB_STATUS_DEAD_LETTER\x10\a*\x8c\x01\n" +
+	"\fJobRunStatus\x12\x1a\n" +
+	"\x16RUN_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12RUN_STATUS_RUNNING\x10\x01\x12\x18\n" +
+	"\x14RUN_STATUS_COMPLETED\x10\x02\x12\x15\n" +
+	"\x11RUN_STATUS_FAILED\x10\x03\x12\x17\n" +
+	"\x13RUN_STATUS_DEFERRED\x10\x04*\x83\x01\n" +
 	"\x11ScheduleFrequency\x12\x19\n" +
 	"\x15FREQUENCY_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eFREQUENCY_ONCE\x10\x01\x12\x14\n" +
 	"\x10FREQUENCY_HOURLY\x10\x02\x12\x13\n" +
 	"\x0fFREQUENCY_DAILY\x10\x03\x12\x14\n" +
-	"\x10FREQUENCY_WEEKLY\x10\x04*\x9b\x01\n" +
+	"\x10FREQUENCY_WEEKLY\x10\x04*\xb2\x01\n" +
 	"\x0fScraperDataType\x12\x19\n" +
 	"\x15DATA_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11DATA_TYPE_PROFILE\x10\x01\x12\x12\n" +
 	"\x0eDATA_TYPE_POST\x10\x02\x12\x13\n" +
 	"\x0fDATA_TYPE_STORY\x10\x03\x12\x15\n" +
 	"\x11DATA_TYPE_COMMENT\x10\x04\x12\x16\n" +
-	"\x12DATA_TYPE_FOLLOWER\x10\x052\xae\x05\n" +
+	"\x12DATA_TYPE_FOLLOWER\x10\x05\x12\x15\n" +
+	"\x11DATA_TYPE_MENTION\x10\x062\x80\x0e\n" +
 	"\x0eScraperService\x12K\n" +
 	"\x10CreateScraperJob\x12 .scraper.CreateScraperJobRequest\x1a\x13.scraper.ScraperJob\"\x00\x12E\n" +
 	"\rGetScraperJob\x12\x1d.scraper.GetScraperJobRequest\x1a\x13.scraper.ScraperJob\"\x00\x12V\n" +
 	"\x0fListScraperJobs\x12\x1f.scraper.ListScraperJobsRequest\x1a .scraper.ListScraperJobsResponse\"\x00\x12K\n" +
 	"\x10CancelScraperJob\x12 .scraper.CancelScraperJobRequest\x1a\x13.scraper.ScraperJob\"\x00\x12N\n" +
-	"\x10DeleteScraperJob\x12 .scraper.DeleteScraperJobRequest\x1a\x16.google.protobuf.Empty\"\x00\x12k\n" +
+	"\x10DeleteScraperJob\x12 .scraper.DeleteScraperJobRequest\x1a\x16.google.protobuf.Empty\"\x00\x12M\n" +
+	"\x11RequeueScraperJob\x12!.scraper.RequeueScraperJobRequest\x1a\x13.scraper.ScraperJob\"\x00\x12R\n" +
+	"\x10WatchScraperJobs\x12 .scraper.WatchScraperJobsRequest\x1a\x18.scraper.ScraperJobEvent\"\x000\x01\x12k\n" +
 	"\x16ListSupportedPlatforms\x12&.scraper.ListSupportedPlatformsRequest\x1a'.scraper.ListSupportedPlatformsResponse\"\x00\x12Q\n" +
-	"\x11GetPlatformStatus\x12!.scraper.GetPlatformStatusRequest\x1a\x17.scraper.PlatformStatus\"\x00\x12S\n" +
-	"\x0eGetScrapedData\x12\x1e.scraper.GetScrapedDataRequest\x1a\x1f.scraper.GetScrapedDataResponse\"\x00B0Z.github.com/donaldnash/go-competitor/scraper/pbb\x06proto3"
+	"\x11GetPlatformStatus\x12!.scraper.GetPlatformStatusRequest\x1a\x17.scraper.PlatformStatus\"\x00\x12H\n" +
+	"\x0eGetQueueStatus\x12\x1e.scraper.GetQueueStatusRequest\x1a\x14.scraper.QueueStatus\"\x00\x12S\n" +
+	"\x0eGetScrapedData\x12\x1e.scraper.GetScrapedDataRequest\x1a\x1f.scraper.GetScrapedDataResponse\"\x00\x12J\n" +
+	"\vListJobRuns\x12\x1b.scraper.ListJobRunsRequest\x1a\x1c.scraper.ListJobRunsResponse\"\x00\x12M\n" +
+	"\fListComments\x12\x1c.scraper.ListCommentsRequest\x1a\x1d.scraper.ListCommentsResponse\"\x00\x12M\n" +
+	"\fListMentions\x12\x1c.scraper.ListMentionsRequest\x1a\x1d.scraper.ListMentionsResponse\"\x00\x12V\n" +
+	"\x0fGetShareOfVoice\x12\x1f.scraper.GetShareOfVoiceRequest\x1a .scraper.GetShareOfVoiceResponse\"\x00\x12V\n" +
+	"\x0fGetPostVelocity\x12\x1f.scraper.GetPostVelocityRequest\x1a .scraper.GetPostVelocityResponse\"\x00\x12V\n" +
+	"\x0fListMediaAssets\x12\x1f.scraper.ListMediaAssetsRequest\x1a .scraper.ListMediaAssetsResponse\"\x00\x12Y\n" +
+	"\x10FindSimilarMedia\x12 .scraper.FindSimilarMediaRequest\x1a!.scraper.FindSimilarMediaResponse\"\x00\x12h\n" +
+	"\x15ListRetentionPolicies\x12%.scraper.ListRetentionPoliciesRequest\x1a&.scraper.ListRetentionPoliciesResponse\"\x00\x12T\n" +
+	"\x12SetRetentionPolicy\x12\".scraper.SetRetentionPolicyRequest\x1a\x18.scraper.RetentionPolicy\"\x00\x12V\n" +
+	"\x0fGetStorageUsage\x12\x1f.scraper.GetStorageUsageRequest\x1a .scraper.GetStorageUsageResponse\"\x00B0Z.github.com/donaldnash/go-competitor/scraper/pbb\x06proto3"
 
 var (
 	file_scraper_pb_scraper_proto_rawDescOnce sync.Once
@@ -1670,89 +5041,201 @@ func file_scraper_pb_scraper_proto_rawDescGZIP() []byte {
 	return file_scraper_pb_scraper_proto_rawDescData
 }
 
-var file_scraper_pb_scraper_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_scraper_pb_scraper_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_scraper_pb_scraper_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_scraper_pb_scraper_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_scraper_pb_scraper_proto_goTypes = []any{
 	(ScraperJobType)(0),                    // 0: scraper.ScraperJobType
 	(ScraperJobStatus)(0),                  // 1: scraper.ScraperJobStatus
-	(ScheduleFrequency)(0),                 // 2: scraper.ScheduleFrequency
-	(ScraperDataType)(0),                   // 3: scraper.ScraperDataType
-	(*CreateScraperJobRequest)(nil),        // 4: scraper.CreateScraperJobRequest
-	(*GetScraperJobRequest)(nil),           // 5: scraper.GetScraperJobRequest
-	(*ListScraperJobsRequest)(nil),         // 6: scraper.ListScraperJobsRequest
-	(*ListScraperJobsResponse)(nil),        // 7: scraper.ListScraperJobsResponse
-	(*CancelScraperJobRequest)(nil),        // 8: scraper.CancelScraperJobRequest
-	(*DeleteScraperJobRequest)(nil),        // 9: scraper.DeleteScraperJobRequest
-	(*ListSupportedPlatformsRequest)(nil),  // 10: scraper.ListSupportedPlatformsRequest
-	(*ListSupportedPlatformsResponse)(nil), // 11: scraper.ListSupportedPlatformsResponse
-	(*GetPlatformStatusRequest)(nil),       // 12: scraper.GetPlatformStatusRequest
-	(*GetScrapedDataRequest)(nil),          // 13: scraper.GetScrapedDataRequest
-	(*GetScrapedDataResponse)(nil),         // 14: scraper.GetScrapedDataResponse
-	(*ScraperJob)(nil),                     // 15: scraper.ScraperJob
-	(*ScraperSchedule)(nil),                // 16: scraper.ScraperSchedule
-	(*PlatformInfo)(nil),                   // 17: scraper.PlatformInfo
-	(*PlatformStatus)(nil),                 // 18: scraper.PlatformStatus
-	(*PlatformRateLimits)(nil),             // 19: scraper.PlatformRateLimits
-	(*ScrapedDataItem)(nil),                // 20: scraper.ScrapedDataItem
-	nil,                                    // 21: scraper.CreateScraperJobRequest.MetadataEntry
-	nil,                                    // 22: scraper.ScraperJob.MetadataEntry
-	nil,                                    // 23: scraper.ScrapedDataItem.ContentAttributesEntry
-	(*timestamppb.Timestamp)(nil),          // 24: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 25: google.protobuf.Empty
+	(JobRunStatus)(0),                      // 2: scraper.JobRunStatus
+	(ScheduleFrequency)(0),                 // 3: scraper.ScheduleFrequency
+	(ScraperDataType)(0),                   // 4: scraper.ScraperDataType
+	(*CreateScraperJobRequest)(nil),        // 5: scraper.CreateScraperJobRequest
+	(*GetScraperJobRequest)(nil),           // 6: scraper.GetScraperJobRequest
+	(*ListScraperJobsRequest)(nil),         // 7: scraper.ListScraperJobsRequest
+	(*ListScraperJobsResponse)(nil),        // 8: scraper.ListScraperJobsResponse
+	(*CancelScraperJobRequest)(nil),        // 9: scraper.CancelScraperJobRequest
+	(*DeleteScraperJobRequest)(nil),        // 10: scraper.DeleteScraperJobRequest
+	(*RequeueScraperJobRequest)(nil),       // 11: scraper.RequeueScraperJobRequest
+	(*WatchScraperJobsRequest)(nil),        // 12: scraper.WatchScraperJobsRequest
+	(*ScraperJobEvent)(nil),                // 13: scraper.ScraperJobEvent
+	(*ListSupportedPlatformsRequest)(nil),  // 14: scraper.ListSupportedPlatformsRequest
+	(*ListSupportedPlatformsResponse)(nil), // 15: scraper.ListSupportedPlatformsResponse
+	(*GetPlatformStatusRequest)(nil),       // 16: scraper.GetPlatformStatusRequest
+	(*GetQueueStatusRequest)(nil),          // 17: scraper.GetQueueStatusRequest
+	(*GetScrapedDataRequest)(nil),          // 18: scraper.GetScrapedDataRequest
+	(*GetScrapedDataResponse)(nil),         // 19: scraper.GetScrapedDataResponse
+	(*ListCommentsRequest)(nil),            // 20: scraper.ListCommentsRequest
+	(*ListCommentsResponse)(nil),           // 21: scraper.ListCommentsResponse
+	(*ListMentionsRequest)(nil),            // 22: scraper.ListMentionsRequest
+	(*ListMentionsResponse)(nil),           // 23: scraper.ListMentionsResponse
+	(*GetShareOfVoiceRequest)(nil),         // 24: scraper.GetShareOfVoiceRequest
+	(*GetShareOfVoiceResponse)(nil),        // 25: scraper.GetShareOfVoiceResponse
+	(*GetPostVelocityRequest)(nil),         // 26: scraper.GetPostVelocityRequest
+	(*GetPostVelocityResponse)(nil),        // 27: scraper.GetPostVelocityResponse
+	(*ListJobRunsRequest)(nil),             // 28: scraper.ListJobRunsRequest
+	(*ListJobRunsResponse)(nil),            // 29: scraper.ListJobRunsResponse
+	(*ListRetentionPoliciesRequest)(nil),   // 30: scraper.ListRetentionPoliciesRequest
+	(*ListRetentionPoliciesResponse)(nil),  // 31: scraper.ListRetentionPoliciesResponse
+	(*SetRetentionPolicyRequest)(nil),      // 32: scraper.SetRetentionPolicyRequest
+	(*GetStorageUsageRequest)(nil),         // 33: scraper.GetStorageUsageRequest
+	(*GetStorageUsageResponse)(nil),        // 34: scraper.GetStorageUsageResponse
+	(*ListMediaAssetsRequest)(nil),         // 35: scraper.ListMediaAssetsRequest
+	(*ListMediaAssetsResponse)(nil),        // 36: scraper.ListMediaAssetsResponse
+	(*FindSimilarMediaRequest)(nil),        // 37: scraper.FindSimilarMediaRequest
+	(*FindSimilarMediaResponse)(nil),       // 38: scraper.FindSimilarMediaResponse
+	(*ScraperJob)(nil),                     // 39: scraper.ScraperJob
+	(*BackfillProgress)(nil),               // 40: scraper.BackfillProgress
+	(*ScraperSchedule)(nil),                // 41: scraper.ScraperSchedule
+	(*PlatformInfo)(nil),                   // 42: scraper.PlatformInfo
+	(*PlatformStatus)(nil),                 // 43: scraper.PlatformStatus
+	(*QueueStatus)(nil),                    // 44: scraper.QueueStatus
+	(*TenantQueueStatus)(nil),              // 45: scraper.TenantQueueStatus
+	(*PlatformRateLimits)(nil),             // 46: scraper.PlatformRateLimits
+	(*ScrapedDataItem)(nil),                // 47: scraper.ScrapedDataItem
+	(*Comment)(nil),                        // 48: scraper.Comment
+	(*Mention)(nil),                        // 49: scraper.Mention
+	(*ShareOfVoice)(nil),                   // 50: scraper.ShareOfVoice
+	(*PostSnapshot)(nil),                   // 51: scraper.PostSnapshot
+	(*PostVelocity)(nil),                   // 52: scraper.PostVelocity
+	(*JobRun)(nil),                         // 53: scraper.JobRun
+	(*RetentionPolicy)(nil),                // 54: scraper.RetentionPolicy
+	(*StorageUsage)(nil),                   // 55: scraper.StorageUsage
+	(*MediaAsset)(nil),                     // 56: scraper.MediaAsset
+	(*SimilarMedia)(nil),                   // 57: scraper.SimilarMedia
+	nil,                                    // 58: scraper.CreateScraperJobRequest.MetadataEntry
+	nil,                                    // 59: scraper.ScraperJob.MetadataEntry
+	nil,                                    // 60: scraper.ScrapedDataItem.ContentAttributesEntry
+	(*timestamppb.Timestamp)(nil),          // 61: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 62: google.protobuf.Empty
 }
 var file_scraper_pb_scraper_proto_depIdxs = []int32{
-	0,  // 0: scraper.CreateScraperJobRequest.job_type:type_name -> scraper.ScraperJobType
-	16, // 1: scraper.CreateScraperJobRequest.schedule:type_name -> scraper.ScraperSchedule
-	21, // 2: scraper.CreateScraperJobRequest.metadata:type_name -> scraper.CreateScraperJobRequest.MetadataEntry
-	0,  // 3: scraper.ListScraperJobsRequest.job_type:type_name -> scraper.ScraperJobType
-	1,  // 4: scraper.ListScraperJobsRequest.status:type_name -> scraper.ScraperJobStatus
-	15, // 5: scraper.ListScraperJobsResponse.jobs:type_name -> scraper.ScraperJob
-	17, // 6: scraper.ListSupportedPlatformsResponse.platforms:type_name -> scraper.PlatformInfo
-	24, // 7: scraper.GetScrapedDataRequest.start_date:type_name -> google.protobuf.Timestamp
-	24, // 8: scraper.GetScrapedDataRequest.end_date:type_name -> google.protobuf.Timestamp
-	20, // 9: scraper.GetScrapedDataResponse.items:type_name -> scraper.ScrapedDataItem
-	0,  // 10: scraper.ScraperJob.job_type:type_name -> scraper.ScraperJobType
-	1,  // 11: scraper.ScraperJob.status:type_name -> scraper.ScraperJobStatus
-	16, // 12: scraper.ScraperJob.schedule:type_name -> scraper.ScraperSchedule
-	24, // 13: scraper.ScraperJob.last_run_at:type_name -> google.protobuf.Timestamp
-	24, // 14: scraper.ScraperJob.next_run_at:type_name -> google.protobuf.Timestamp
-	22, // 15: scraper.ScraperJob.metadata:type_name -> scraper.ScraperJob.MetadataEntry
-	24, // 16: scraper.ScraperJob.created_at:type_name -> google.protobuf.Timestamp
-	24, // 17: scraper.ScraperJob.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 18: scraper.ScraperSchedule.frequency:type_name -> scraper.ScheduleFrequency
-	24, // 19: scraper.ScraperSchedule.start_date:type_name -> google.protobuf.Timestamp
-	24, // 20: scraper.ScraperSchedule.end_date:type_name -> google.protobuf.Timestamp
-	0,  // 21: scraper.PlatformInfo.supported_job_types:type_name -> scraper.ScraperJobType
-	19, // 22: scraper.PlatformInfo.rate_limits:type_name -> scraper.PlatformRateLimits
-	19, // 23: scraper.PlatformStatus.rate_limits:type_name -> scraper.PlatformRateLimits
-	24, // 24: scraper.PlatformStatus.last_checked:type_name -> google.protobuf.Timestamp
-	24, // 25: scraper.PlatformRateLimits.reset_at:type_name -> google.protobuf.Timestamp
-	3,  // 26: scraper.ScrapedDataItem.data_type:type_name -> scraper.ScraperDataType
-	24, // 27: scraper.ScrapedDataItem.posted_at:type_name -> google.protobuf.Timestamp
-	23, // 28: scraper.ScrapedDataItem.content_attributes:type_name -> scraper.ScrapedDataItem.ContentAttributesEntry
-	24, // 29: scraper.ScrapedDataItem.scraped_at:type_name -> google.protobuf.Timestamp
-	24, // 30: scraper.ScrapedDataItem.created_at:type_name -> google.protobuf.Timestamp
-	4,  // 31: scraper.ScraperService.CreateScraperJob:input_type -> scraper.CreateScraperJobRequest
-	5,  // 32: scraper.ScraperService.GetScraperJob:input_type -> scraper.GetScraperJobRequest
-	6,  // 33: scraper.ScraperService.ListScraperJobs:input_type -> scraper.ListScraperJobsRequest
-	8,  // 34: scraper.ScraperService.CancelScraperJob:input_type -> scraper.CancelScraperJobRequest
-	9,  // 35: scraper.ScraperService.DeleteScraperJob:input_type -> scraper.DeleteScraperJobRequest
-	10, // 36: scraper.ScraperService.ListSupportedPlatforms:input_type -> scraper.ListSupportedPlatformsRequest
-	12, // 37: scraper.ScraperService.GetPlatformStatus:input_type -> scraper.GetPlatformStatusRequest
-	13, // 38: scraper.ScraperService.GetScrapedData:input_type -> scraper.GetScrapedDataRequest
-	15, // 39: scraper.ScraperService.CreateScraperJob:output_type -> scraper.ScraperJob
-	15, // 40: scraper.ScraperService.GetScraperJob:output_type -> scraper.ScraperJob
-	7,  // 41: scraper.ScraperService.ListScraperJobs:output_type -> scraper.ListScraperJobsResponse
-	15, // 42: scraper.ScraperService.CancelScraperJob:output_type -> scraper.ScraperJob
-	25, // 43: scraper.ScraperService.DeleteScraperJob:output_type -> google.protobuf.Empty
-	11, // 44: scraper.ScraperService.ListSupportedPlatforms:output_type -> scraper.ListSupportedPlatformsResponse
-	18, // 45: scraper.ScraperService.GetPlatformStatus:output_type -> scraper.PlatformStatus
-	14, // 46: scraper.ScraperService.GetScrapedData:output_type -> scraper.GetScrapedDataResponse
-	39, // [39:47] is the sub-list for method output_type
-	31, // [31:39] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	0,   // 0: scraper.CreateScraperJobRequest.job_type:type_name -> scraper.ScraperJobType
+	41,  // 1: scraper.CreateScraperJobRequest.schedule:type_name -> scraper.ScraperSchedule
+	58,  // 2: scraper.CreateScraperJobRequest.metadata:type_name -> scraper.CreateScraperJobRequest.MetadataEntry
+	61,  // 3: scraper.CreateScraperJobRequest.backfill_until:type_name -> google.protobuf.Timestamp
+	0,   // 4: scraper.ListScraperJobsRequest.job_type:type_name -> scraper.ScraperJobType
+	1,   // 5: scraper.ListScraperJobsRequest.status:type_name -> scraper.ScraperJobStatus
+	39,  // 6: scraper.ListScraperJobsResponse.jobs:type_name -> scraper.ScraperJob
+	39,  // 7: scraper.ScraperJobEvent.job:type_name -> scraper.ScraperJob
+	61,  // 8: scraper.ScraperJobEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	42,  // 9: scraper.ListSupportedPlatformsResponse.platforms:type_name -> scraper.PlatformInfo
+	61,  // 10: scraper.GetScrapedDataRequest.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 11: scraper.GetScrapedDataRequest.end_date:type_name -> google.protobuf.Timestamp
+	47,  // 12: scraper.GetScrapedDataResponse.items:type_name -> scraper.ScrapedDataItem
+	61,  // 13: scraper.ListCommentsRequest.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 14: scraper.ListCommentsRequest.end_date:type_name -> google.protobuf.Timestamp
+	48,  // 15: scraper.ListCommentsResponse.comments:type_name -> scraper.Comment
+	61,  // 16: scraper.ListMentionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 17: scraper.ListMentionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	49,  // 18: scraper.ListMentionsResponse.mentions:type_name -> scraper.Mention
+	61,  // 19: scraper.GetShareOfVoiceRequest.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 20: scraper.GetShareOfVoiceRequest.end_date:type_name -> google.protobuf.Timestamp
+	50,  // 21: scraper.GetShareOfVoiceResponse.shares:type_name -> scraper.ShareOfVoice
+	61,  // 22: scraper.GetPostVelocityRequest.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 23: scraper.GetPostVelocityRequest.end_date:type_name -> google.protobuf.Timestamp
+	52,  // 24: scraper.GetPostVelocityResponse.posts:type_name -> scraper.PostVelocity
+	53,  // 25: scraper.ListJobRunsResponse.runs:type_name -> scraper.JobRun
+	54,  // 26: scraper.ListRetentionPoliciesResponse.policies:type_name -> scraper.RetentionPolicy
+	4,   // 27: scraper.SetRetentionPolicyRequest.data_type:type_name -> scraper.ScraperDataType
+	55,  // 28: scraper.GetStorageUsageResponse.usage:type_name -> scraper.StorageUsage
+	56,  // 29: scraper.ListMediaAssetsResponse.assets:type_name -> scraper.MediaAsset
+	57,  // 30: scraper.FindSimilarMediaResponse.matches:type_name -> scraper.SimilarMedia
+	0,   // 31: scraper.ScraperJob.job_type:type_name -> scraper.ScraperJobType
+	1,   // 32: scraper.ScraperJob.status:type_name -> scraper.ScraperJobStatus
+	41,  // 33: scraper.ScraperJob.schedule:type_name -> scraper.ScraperSchedule
+	61,  // 34: scraper.ScraperJob.last_run_at:type_name -> google.protobuf.Timestamp
+	61,  // 35: scraper.ScraperJob.next_run_at:type_name -> google.protobuf.Timestamp
+	59,  // 36: scraper.ScraperJob.metadata:type_name -> scraper.ScraperJob.MetadataEntry
+	61,  // 37: scraper.ScraperJob.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 38: scraper.ScraperJob.updated_at:type_name -> google.protobuf.Timestamp
+	61,  // 39: scraper.ScraperJob.lease_expires_at:type_name -> google.protobuf.Timestamp
+	39,  // 40: scraper.ScraperJob.children:type_name -> scraper.ScraperJob
+	1,   // 41: scraper.ScraperJob.rollup_status:type_name -> scraper.ScraperJobStatus
+	40,  // 42: scraper.ScraperJob.backfill:type_name -> scraper.BackfillProgress
+	61,  // 43: scraper.BackfillProgress.until:type_name -> google.protobuf.Timestamp
+	61,  // 44: scraper.BackfillProgress.started_at:type_name -> google.protobuf.Timestamp
+	61,  // 45: scraper.BackfillProgress.oldest_post_at:type_name -> google.protobuf.Timestamp
+	61,  // 46: scraper.BackfillProgress.completed_at:type_name -> google.protobuf.Timestamp
+	3,   // 47: scraper.ScraperSchedule.frequency:type_name -> scraper.ScheduleFrequency
+	61,  // 48: scraper.ScraperSchedule.start_date:type_name -> google.protobuf.Timestamp
+	61,  // 49: scraper.ScraperSchedule.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 50: scraper.PlatformInfo.supported_job_types:type_name -> scraper.ScraperJobType
+	46,  // 51: scraper.PlatformInfo.rate_limits:type_name -> scraper.PlatformRateLimits
+	46,  // 52: scraper.PlatformStatus.rate_limits:type_name -> scraper.PlatformRateLimits
+	61,  // 53: scraper.PlatformStatus.last_checked:type_name -> google.protobuf.Timestamp
+	45,  // 54: scraper.QueueStatus.tenants:type_name -> scraper.TenantQueueStatus
+	61,  // 55: scraper.PlatformRateLimits.reset_at:type_name -> google.protobuf.Timestamp
+	4,   // 56: scraper.ScrapedDataItem.data_type:type_name -> scraper.ScraperDataType
+	61,  // 57: scraper.ScrapedDataItem.posted_at:type_name -> google.protobuf.Timestamp
+	60,  // 58: scraper.ScrapedDataItem.content_attributes:type_name -> scraper.ScrapedDataItem.ContentAttributesEntry
+	61,  // 59: scraper.ScrapedDataItem.scraped_at:type_name -> google.protobuf.Timestamp
+	61,  // 60: scraper.ScrapedDataItem.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 61: scraper.Comment.posted_at:type_name -> google.protobuf.Timestamp
+	61,  // 62: scraper.Comment.scraped_at:type_name -> google.protobuf.Timestamp
+	0,   // 63: scraper.Mention.job_type:type_name -> scraper.ScraperJobType
+	61,  // 64: scraper.Mention.posted_at:type_name -> google.protobuf.Timestamp
+	61,  // 65: scraper.Mention.scraped_at:type_name -> google.protobuf.Timestamp
+	61,  // 66: scraper.ShareOfVoice.period_start:type_name -> google.protobuf.Timestamp
+	61,  // 67: scraper.PostSnapshot.scraped_at:type_name -> google.protobuf.Timestamp
+	61,  // 68: scraper.PostVelocity.posted_at:type_name -> google.protobuf.Timestamp
+	51,  // 69: scraper.PostVelocity.snapshots:type_name -> scraper.PostSnapshot
+	2,   // 70: scraper.JobRun.status:type_name -> scraper.JobRunStatus
+	61,  // 71: scraper.JobRun.started_at:type_name -> google.protobuf.Timestamp
+	61,  // 72: scraper.JobRun.finished_at:type_name -> google.protobuf.Timestamp
+	4,   // 73: scraper.RetentionPolicy.data_type:type_name -> scraper.ScraperDataType
+	61,  // 74: scraper.RetentionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 75: scraper.StorageUsage.data_type:type_name -> scraper.ScraperDataType
+	61,  // 76: scraper.StorageUsage.oldest_at:type_name -> google.protobuf.Timestamp
+	61,  // 77: scraper.StorageUsage.newest_at:type_name -> google.protobuf.Timestamp
+	61,  // 78: scraper.MediaAsset.captured_at:type_name -> google.protobuf.Timestamp
+	56,  // 79: scraper.SimilarMedia.asset:type_name -> scraper.MediaAsset
+	5,   // 80: scraper.ScraperService.CreateScraperJob:input_type -> scraper.CreateScraperJobRequest
+	6,   // 81: scraper.ScraperService.GetScraperJob:input_type -> scraper.GetScraperJobRequest
+	7,   // 82: scraper.ScraperService.ListScraperJobs:input_type -> scraper.ListScraperJobsRequest
+	9,   // 83: scraper.ScraperService.CancelScraperJob:input_type -> scraper.CancelScraperJobRequest
+	10,  // 84: scraper.ScraperService.DeleteScraperJob:input_type -> scraper.DeleteScraperJobRequest
+	11,  // 85: scraper.ScraperService.RequeueScraperJob:input_type -> scraper.RequeueScraperJobRequest
+	12,  // 86: scraper.ScraperService.WatchScraperJobs:input_type -> scraper.WatchScraperJobsRequest
+	14,  // 87: scraper.ScraperService.ListSupportedPlatforms:input_type -> scraper.ListSupportedPlatformsRequest
+	16,  // 88: scraper.ScraperService.GetPlatformStatus:input_type -> scraper.GetPlatformStatusRequest
+	17,  // 89: scraper.ScraperService.GetQueueStatus:input_type -> scraper.GetQueueStatusRequest
+	18,  // 90: scraper.ScraperService.GetScrapedData:input_type -> scraper.GetScrapedDataRequest
+	28,  // 91: scraper.ScraperService.ListJobRuns:input_type -> scraper.ListJobRunsRequest
+	20,  // 92: scraper.ScraperService.ListComments:input_type -> scraper.ListCommentsRequest
+	22,  // 93: scraper.ScraperService.ListMentions:input_type -> scraper.ListMentionsRequest
+	24,  // 94: scraper.ScraperService.GetShareOfVoice:input_type -> scraper.GetShareOfVoiceRequest
+	26,  // 95: scraper.ScraperService.GetPostVelocity:input_type -> scraper.GetPostVelocityRequest
+	35,  // 96: scraper.ScraperService.ListMediaAssets:input_type -> scraper.ListMediaAssetsRequest
+	37,  // 97: scraper.ScraperService.FindSimilarMedia:input_type -> scraper.FindSimilarMediaRequest
+	30,  // 98: scraper.ScraperService.ListRetentionPolicies:input_type -> scraper.ListRetentionPoliciesRequest
+	32,  // 99: scraper.ScraperService.SetRetentionPolicy:input_type -> scraper.SetRetentionPolicyRequest
+	33,  // 100: scraper.ScraperService.GetStorageUsage:input_type -> scraper.GetStorageUsageRequest
+	39,  // 101: scraper.ScraperService.CreateScraperJob:output_type -> scraper.ScraperJob
+	39,  // 102: scraper.ScraperService.GetScraperJob:output_type -> scraper.ScraperJob
+	8,   // 103: scraper.ScraperService.ListScraperJobs:output_type -> scraper.ListScraperJobsResponse
+	39,  // 104: scraper.ScraperService.CancelScraperJob:output_type -> scraper.ScraperJob
+	62,  // 105: scraper.ScraperService.DeleteScraperJob:output_type -> google.protobuf.Empty
+	39,  // 106: scraper.ScraperService.RequeueScraperJob:output_type -> scraper.ScraperJob
+	13,  // 107: scraper.ScraperService.WatchScraperJobs:output_type -> scraper.ScraperJobEvent
+	15,  // 108: scraper.ScraperService.ListSupportedPlatforms:output_type -> scraper.ListSupportedPlatformsResponse
+	43,  // 109: scraper.ScraperService.GetPlatformStatus:output_type -> scraper.PlatformStatus
+	44,  // 110: scraper.ScraperService.GetQueueStatus:output_type -> scraper.QueueStatus
+	19,  // 111: scraper.ScraperService.GetScrapedData:output_type -> scraper.GetScrapedDataResponse
+	29,  // 112: scraper.ScraperService.ListJobRuns:output_type -> scraper.ListJobRunsResponse
+	21,  // 113: scraper.ScraperService.ListComments:output_type -> scraper.ListCommentsResponse
+	23,  // 114: scraper.ScraperService.ListMentions:output_type -> scraper.ListMentionsResponse
+	25,  // 115: scraper.ScraperService.GetShareOfVoice:output_type -> scraper.GetShareOfVoiceResponse
+	27,  // 116: scraper.ScraperService.GetPostVelocity:output_type -> scraper.GetPostVelocityResponse
+	36,  // 117: scraper.ScraperService.ListMediaAssets:output_type -> scraper.ListMediaAssetsResponse
+	38,  // 118: scraper.ScraperService.FindSimilarMedia:output_type -> scraper.FindSimilarMediaResponse
+	31,  // 119: scraper.ScraperService.ListRetentionPolicies:output_type -> scraper.ListRetentionPoliciesResponse
+	54,  // 120: scraper.ScraperService.SetRetentionPolicy:output_type -> scraper.RetentionPolicy
+	34,  // 121: scraper.ScraperService.GetStorageUsage:output_type -> scraper.GetStorageUsageResponse
+	101, // [101:122] is the sub-list for method output_type
+	80,  // [80:101] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_scraper_pb_scraper_proto_init() }
@@ -1765,8 +5248,8 @@ func file_scraper_pb_scraper_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_scraper_pb_scraper_proto_rawDesc), len(file_scraper_pb_scraper_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   20,
+			NumEnums:      5,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
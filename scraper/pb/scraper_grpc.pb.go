@@ -25,9 +25,22 @@ const (
 	ScraperService_ListScraperJobs_FullMethodName        = "/scraper.ScraperService/ListScraperJobs"
 	ScraperService_CancelScraperJob_FullMethodName       = "/scraper.ScraperService/CancelScraperJob"
 	ScraperService_DeleteScraperJob_FullMethodName       = "/scraper.ScraperService/DeleteScraperJob"
+	ScraperService_RequeueScraperJob_FullMethodName      = "/scraper.ScraperService/RequeueScraperJob"
+	ScraperService_WatchScraperJobs_FullMethodName       = "/scraper.ScraperService/WatchScraperJobs"
 	ScraperService_ListSupportedPlatforms_FullMethodName = "/scraper.ScraperService/ListSupportedPlatforms"
 	ScraperService_GetPlatformStatus_FullMethodName      = "/scraper.ScraperService/GetPlatformStatus"
+	ScraperService_GetQueueStatus_FullMethodName         = "/scraper.ScraperService/GetQueueStatus"
 	ScraperService_GetScrapedData_FullMethodName         = "/scraper.ScraperService/GetScrapedData"
+	ScraperService_ListJobRuns_FullMethodName            = "/scraper.ScraperService/ListJobRuns"
+	ScraperService_ListComments_FullMethodName           = "/scraper.ScraperService/ListComments"
+	ScraperService_ListMentions_FullMethodName           = "/scraper.ScraperService/ListMentions"
+	ScraperService_GetShareOfVoice_FullMethodName        = "/scraper.ScraperService/GetShareOfVoice"
+	ScraperService_GetPostVelocity_FullMethodName        = "/scraper.ScraperService/GetPostVelocity"
+	ScraperService_ListMediaAssets_FullMethodName        = "/scraper.ScraperService/ListMediaAssets"
+	ScraperService_FindSimilarMedia_FullMethodName       = "/scraper.ScraperService/FindSimilarMedia"
+	ScraperService_ListRetentionPolicies_FullMethodName  = "/scraper.ScraperService/ListRetentionPolicies"
+	ScraperService_SetRetentionPolicy_FullMethodName     = "/scraper.ScraperService/SetRetentionPolicy"
+	ScraperService_GetStorageUsage_FullMethodName        = "/scraper.ScraperService/GetStorageUsage"
 )
 
 // ScraperServiceClient is the client API for ScraperService service.
@@ -40,11 +53,26 @@ type ScraperServiceClient interface {
 	ListScraperJobs(ctx context.Context, in *ListScraperJobsRequest, opts ...grpc.CallOption) (*ListScraperJobsResponse, error)
 	CancelScraperJob(ctx context.Context, in *CancelScraperJobRequest, opts ...grpc.CallOption) (*ScraperJob, error)
 	DeleteScraperJob(ctx context.Context, in *DeleteScraperJobRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RequeueScraperJob(ctx context.Context, in *RequeueScraperJobRequest, opts ...grpc.CallOption) (*ScraperJob, error)
+	WatchScraperJobs(ctx context.Context, in *WatchScraperJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScraperJobEvent], error)
 	// Platform operations
 	ListSupportedPlatforms(ctx context.Context, in *ListSupportedPlatformsRequest, opts ...grpc.CallOption) (*ListSupportedPlatformsResponse, error)
 	GetPlatformStatus(ctx context.Context, in *GetPlatformStatusRequest, opts ...grpc.CallOption) (*PlatformStatus, error)
+	GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*QueueStatus, error)
 	// Scraper results
 	GetScrapedData(ctx context.Context, in *GetScrapedDataRequest, opts ...grpc.CallOption) (*GetScrapedDataResponse, error)
+	ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error)
+	ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error)
+	ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error)
+	GetShareOfVoice(ctx context.Context, in *GetShareOfVoiceRequest, opts ...grpc.CallOption) (*GetShareOfVoiceResponse, error)
+	GetPostVelocity(ctx context.Context, in *GetPostVelocityRequest, opts ...grpc.CallOption) (*GetPostVelocityResponse, error)
+	// Captured media
+	ListMediaAssets(ctx context.Context, in *ListMediaAssetsRequest, opts ...grpc.CallOption) (*ListMediaAssetsResponse, error)
+	FindSimilarMedia(ctx context.Context, in *FindSimilarMediaRequest, opts ...grpc.CallOption) (*FindSimilarMediaResponse, error)
+	// Data retention
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error)
 }
 
 type scraperServiceClient struct {
@@ -105,6 +133,35 @@ func (c *scraperServiceClient) DeleteScraperJob(ctx context.Context, in *DeleteS
 	return out, nil
 }
 
+func (c *scraperServiceClient) RequeueScraperJob(ctx context.Context, in *RequeueScraperJobRequest, opts ...grpc.CallOption) (*ScraperJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScraperJob)
+	err := c.cc.Invoke(ctx, ScraperService_RequeueScraperJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) WatchScraperJobs(ctx context.Context, in *WatchScraperJobsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScraperJobEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScraperService_ServiceDesc.Streams[0], ScraperService_WatchScraperJobs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchScraperJobsRequest, ScraperJobEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScraperService_WatchScraperJobsClient = grpc.ServerStreamingClient[ScraperJobEvent]
+
 func (c *scraperServiceClient) ListSupportedPlatforms(ctx context.Context, in *ListSupportedPlatformsRequest, opts ...grpc.CallOption) (*ListSupportedPlatformsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSupportedPlatformsResponse)
@@ -125,6 +182,16 @@ func (c *scraperServiceClient) GetPlatformStatus(ctx context.Context, in *GetPla
 	return out, nil
 }
 
+func (c *scraperServiceClient) GetQueueStatus(ctx context.Context, in *GetQueueStatusRequest, opts ...grpc.CallOption) (*QueueStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueueStatus)
+	err := c.cc.Invoke(ctx, ScraperService_GetQueueStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scraperServiceClient) GetScrapedData(ctx context.Context, in *GetScrapedDataRequest, opts ...grpc.CallOption) (*GetScrapedDataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetScrapedDataResponse)
@@ -135,6 +202,106 @@ func (c *scraperServiceClient) GetScrapedData(ctx context.Context, in *GetScrape
 	return out, nil
 }
 
+func (c *scraperServiceClient) ListJobRuns(ctx context.Context, in *ListJobRunsRequest, opts ...grpc.CallOption) (*ListJobRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobRunsResponse)
+	err := c.cc.Invoke(ctx, ScraperService_ListJobRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) ListComments(ctx context.Context, in *ListCommentsRequest, opts ...grpc.CallOption) (*ListCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCommentsResponse)
+	err := c.cc.Invoke(ctx, ScraperService_ListComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) ListMentions(ctx context.Context, in *ListMentionsRequest, opts ...grpc.CallOption) (*ListMentionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMentionsResponse)
+	err := c.cc.Invoke(ctx, ScraperService_ListMentions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) GetShareOfVoice(ctx context.Context, in *GetShareOfVoiceRequest, opts ...grpc.CallOption) (*GetShareOfVoiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShareOfVoiceResponse)
+	err := c.cc.Invoke(ctx, ScraperService_GetShareOfVoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) GetPostVelocity(ctx context.Context, in *GetPostVelocityRequest, opts ...grpc.CallOption) (*GetPostVelocityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostVelocityResponse)
+	err := c.cc.Invoke(ctx, ScraperService_GetPostVelocity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) ListMediaAssets(ctx context.Context, in *ListMediaAssetsRequest, opts ...grpc.CallOption) (*ListMediaAssetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMediaAssetsResponse)
+	err := c.cc.Invoke(ctx, ScraperService_ListMediaAssets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) FindSimilarMedia(ctx context.Context, in *FindSimilarMediaRequest, opts ...grpc.CallOption) (*FindSimilarMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSimilarMediaResponse)
+	err := c.cc.Invoke(ctx, ScraperService_FindSimilarMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, ScraperService_ListRetentionPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, ScraperService_SetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scraperServiceClient) GetStorageUsage(ctx context.Context, in *GetStorageUsageRequest, opts ...grpc.CallOption) (*GetStorageUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStorageUsageResponse)
+	err := c.cc.Invoke(ctx, ScraperService_GetStorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScraperServiceServer is the server API for ScraperService service.
 // All implementations must embed UnimplementedScraperServiceServer
 // for forward compatibility.
@@ -145,11 +312,26 @@ type ScraperServiceServer interface {
 	ListScraperJobs(context.Context, *ListScraperJobsRequest) (*ListScraperJobsResponse, error)
 	CancelScraperJob(context.Context, *CancelScraperJobRequest) (*ScraperJob, error)
 	DeleteScraperJob(context.Context, *DeleteScraperJobRequest) (*emptypb.Empty, error)
+	RequeueScraperJob(context.Context, *RequeueScraperJobRequest) (*ScraperJob, error)
+	WatchScraperJobs(*WatchScraperJobsRequest, grpc.ServerStreamingServer[ScraperJobEvent]) error
 	// Platform operations
 	ListSupportedPlatforms(context.Context, *ListSupportedPlatformsRequest) (*ListSupportedPlatformsResponse, error)
 	GetPlatformStatus(context.Context, *GetPlatformStatusRequest) (*PlatformStatus, error)
+	GetQueueStatus(context.Context, *GetQueueStatusRequest) (*QueueStatus, error)
 	// Scraper results
 	GetScrapedData(context.Context, *GetScrapedDataRequest) (*GetScrapedDataResponse, error)
+	ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error)
+	ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error)
+	ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error)
+	GetShareOfVoice(context.Context, *GetShareOfVoiceRequest) (*GetShareOfVoiceResponse, error)
+	GetPostVelocity(context.Context, *GetPostVelocityRequest) (*GetPostVelocityResponse, error)
+	// Captured media
+	ListMediaAssets(context.Context, *ListMediaAssetsRequest) (*ListMediaAssetsResponse, error)
+	FindSimilarMedia(context.Context, *FindSimilarMediaRequest) (*FindSimilarMediaResponse, error)
+	// Data retention
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*RetentionPolicy, error)
+	GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error)
 	mustEmbedUnimplementedScraperServiceServer()
 }
 
@@ -175,15 +357,54 @@ func (UnimplementedScraperServiceServer) CancelScraperJob(context.Context, *Canc
 func (UnimplementedScraperServiceServer) DeleteScraperJob(context.Context, *DeleteScraperJobRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteScraperJob not implemented")
 }
+func (UnimplementedScraperServiceServer) RequeueScraperJob(context.Context, *RequeueScraperJobRequest) (*ScraperJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequeueScraperJob not implemented")
+}
+func (UnimplementedScraperServiceServer) WatchScraperJobs(*WatchScraperJobsRequest, grpc.ServerStreamingServer[ScraperJobEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchScraperJobs not implemented")
+}
 func (UnimplementedScraperServiceServer) ListSupportedPlatforms(context.Context, *ListSupportedPlatformsRequest) (*ListSupportedPlatformsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSupportedPlatforms not implemented")
 }
 func (UnimplementedScraperServiceServer) GetPlatformStatus(context.Context, *GetPlatformStatusRequest) (*PlatformStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlatformStatus not implemented")
 }
+func (UnimplementedScraperServiceServer) GetQueueStatus(context.Context, *GetQueueStatusRequest) (*QueueStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueStatus not implemented")
+}
 func (UnimplementedScraperServiceServer) GetScrapedData(context.Context, *GetScrapedDataRequest) (*GetScrapedDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetScrapedData not implemented")
 }
+func (UnimplementedScraperServiceServer) ListJobRuns(context.Context, *ListJobRunsRequest) (*ListJobRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobRuns not implemented")
+}
+func (UnimplementedScraperServiceServer) ListComments(context.Context, *ListCommentsRequest) (*ListCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListComments not implemented")
+}
+func (UnimplementedScraperServiceServer) ListMentions(context.Context, *ListMentionsRequest) (*ListMentionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMentions not implemented")
+}
+func (UnimplementedScraperServiceServer) GetShareOfVoice(context.Context, *GetShareOfVoiceRequest) (*GetShareOfVoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShareOfVoice not implemented")
+}
+func (UnimplementedScraperServiceServer) GetPostVelocity(context.Context, *GetPostVelocityRequest) (*GetPostVelocityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostVelocity not implemented")
+}
+func (UnimplementedScraperServiceServer) ListMediaAssets(context.Context, *ListMediaAssetsRequest) (*ListMediaAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMediaAssets not implemented")
+}
+func (UnimplementedScraperServiceServer) FindSimilarMedia(context.Context, *FindSimilarMediaRequest) (*FindSimilarMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSimilarMedia not implemented")
+}
+func (UnimplementedScraperServiceServer) ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (UnimplementedScraperServiceServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedScraperServiceServer) GetStorageUsage(context.Context, *GetStorageUsageRequest) (*GetStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
 func (UnimplementedScraperServiceServer) mustEmbedUnimplementedScraperServiceServer() {}
 func (UnimplementedScraperServiceServer) testEmbeddedByValue()                        {}
 
@@ -295,6 +516,35 @@ func _ScraperService_DeleteScraperJob_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScraperService_RequeueScraperJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequeueScraperJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).RequeueScraperJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_RequeueScraperJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).RequeueScraperJob(ctx, req.(*RequeueScraperJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_WatchScraperJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchScraperJobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScraperServiceServer).WatchScraperJobs(m, &grpc.GenericServerStream[WatchScraperJobsRequest, ScraperJobEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScraperService_WatchScraperJobsServer = grpc.ServerStreamingServer[ScraperJobEvent]
+
 func _ScraperService_ListSupportedPlatforms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSupportedPlatformsRequest)
 	if err := dec(in); err != nil {
@@ -331,6 +581,24 @@ func _ScraperService_GetPlatformStatus_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScraperService_GetQueueStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).GetQueueStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_GetQueueStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).GetQueueStatus(ctx, req.(*GetQueueStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ScraperService_GetScrapedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetScrapedDataRequest)
 	if err := dec(in); err != nil {
@@ -349,6 +617,186 @@ func _ScraperService_GetScrapedData_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScraperService_ListJobRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).ListJobRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_ListJobRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).ListJobRuns(ctx, req.(*ListJobRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_ListComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).ListComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_ListComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).ListComments(ctx, req.(*ListCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_ListMentions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMentionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).ListMentions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_ListMentions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).ListMentions(ctx, req.(*ListMentionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_GetShareOfVoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShareOfVoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).GetShareOfVoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_GetShareOfVoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).GetShareOfVoice(ctx, req.(*GetShareOfVoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_GetPostVelocity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostVelocityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).GetPostVelocity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_GetPostVelocity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).GetPostVelocity(ctx, req.(*GetPostVelocityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_ListMediaAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMediaAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).ListMediaAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_ListMediaAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).ListMediaAssets(ctx, req.(*ListMediaAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_FindSimilarMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSimilarMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).FindSimilarMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_FindSimilarMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).FindSimilarMedia(ctx, req.(*FindSimilarMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_ListRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScraperService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScraperServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScraperService_GetStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScraperServiceServer).GetStorageUsage(ctx, req.(*GetStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScraperService_ServiceDesc is the grpc.ServiceDesc for ScraperService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -376,6 +824,10 @@ var ScraperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteScraperJob",
 			Handler:    _ScraperService_DeleteScraperJob_Handler,
 		},
+		{
+			MethodName: "RequeueScraperJob",
+			Handler:    _ScraperService_RequeueScraperJob_Handler,
+		},
 		{
 			MethodName: "ListSupportedPlatforms",
 			Handler:    _ScraperService_ListSupportedPlatforms_Handler,
@@ -384,11 +836,61 @@ var ScraperService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlatformStatus",
 			Handler:    _ScraperService_GetPlatformStatus_Handler,
 		},
+		{
+			MethodName: "GetQueueStatus",
+			Handler:    _ScraperService_GetQueueStatus_Handler,
+		},
 		{
 			MethodName: "GetScrapedData",
 			Handler:    _ScraperService_GetScrapedData_Handler,
 		},
+		{
+			MethodName: "ListJobRuns",
+			Handler:    _ScraperService_ListJobRuns_Handler,
+		},
+		{
+			MethodName: "ListComments",
+			Handler:    _ScraperService_ListComments_Handler,
+		},
+		{
+			MethodName: "ListMentions",
+			Handler:    _ScraperService_ListMentions_Handler,
+		},
+		{
+			MethodName: "GetShareOfVoice",
+			Handler:    _ScraperService_GetShareOfVoice_Handler,
+		},
+		{
+			MethodName: "GetPostVelocity",
+			Handler:    _ScraperService_GetPostVelocity_Handler,
+		},
+		{
+			MethodName: "ListMediaAssets",
+			Handler:    _ScraperService_ListMediaAssets_Handler,
+		},
+		{
+			MethodName: "FindSimilarMedia",
+			Handler:    _ScraperService_FindSimilarMedia_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _ScraperService_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _ScraperService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _ScraperService_GetStorageUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchScraperJobs",
+			Handler:       _ScraperService_WatchScraperJobs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "scraper/pb/scraper.proto",
 }
@@ -0,0 +1,299 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func init() {
+	Register(Info{
+		Name:        "facebook",
+		DisplayName: "Facebook",
+		Description: "Meta's social networking platform",
+		BaseURL:     "https://graph.facebook.com/v19.0",
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+			repository.JobTypeEngagement,
+			repository.JobTypeComments,
+			repository.JobTypeMentions,
+		},
+		RateLimits: RateLimits{
+			RequestsPerMinute: 20,
+			RequestsPerHour:   200,
+			RequestsPerDay:    2000,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewFacebookAPI(fetcher, cfg.FacebookAccessToken)
+	})
+}
+
+// FacebookAPI scrapes public Facebook pages through the Graph API
+type FacebookAPI struct {
+	fetcher *Fetcher
+}
+
+// NewFacebookAPI creates a new FacebookAPI authenticated with an app access token
+func NewFacebookAPI(fetcher *Fetcher, accessToken string) *FacebookAPI {
+	if accessToken != "" {
+		fetcher.Query.Set("access_token", accessToken)
+	}
+	return &FacebookAPI{fetcher: fetcher}
+}
+
+const facebookPostFields = "id,message,created_time,permalink_url,full_picture,status_type," +
+	"shares,reactions.summary(total_count).limit(0),comments.summary(total_count).limit(0)"
+
+// facebookTime parses the timestamp format used by the Graph API
+type facebookTime struct {
+	time.Time
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *facebookTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		return nil
+	}
+
+	parsed, err := time.Parse("2006-01-02T15:04:05-0700", s)
+	if err != nil {
+		return err
+	}
+	t.Time = parsed.UTC()
+	return nil
+}
+
+type facebookSummary struct {
+	Summary struct {
+		TotalCount int `json:"total_count"`
+	} `json:"summary"`
+}
+
+type facebookPost struct {
+	ID           string       `json:"id"`
+	Message      string       `json:"message"`
+	CreatedTime  facebookTime `json:"created_time"`
+	PermalinkURL string       `json:"permalink_url"`
+	FullPicture  string       `json:"full_picture"`
+	StatusType   string       `json:"status_type"`
+	Shares       struct {
+		Count int `json:"count"`
+	} `json:"shares"`
+	Reactions facebookSummary `json:"reactions"`
+	Comments  facebookSummary `json:"comments"`
+	From      struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"from"`
+}
+
+// contentType maps a Graph API status type to a content type
+func (p facebookPost) contentType() string {
+	switch {
+	case strings.Contains(p.StatusType, "video"):
+		return "video"
+	case p.StatusType == "added_photos":
+		return "image"
+	case p.StatusType == "shared_story":
+		return "link"
+	default:
+		return "text"
+	}
+}
+
+// toPost converts a Graph API post to a post
+func (p facebookPost) toPost() Post {
+	return Post{
+		ID:          p.ID,
+		AuthorID:    p.From.ID,
+		AuthorName:  p.From.Name,
+		URL:         p.PermalinkURL,
+		Text:        p.Message,
+		ContentType: p.contentType(),
+		MediaURL:    p.FullPicture,
+		PostedAt:    p.CreatedTime.Time,
+		Likes:       p.Reactions.Summary.TotalCount,
+		Shares:      p.Shares.Count,
+		Comments:    p.Comments.Summary.TotalCount,
+	}
+}
+
+// GetProfile returns the public profile of a page
+func (a *FacebookAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	var page struct {
+		ID                 string `json:"id"`
+		Name               string `json:"name"`
+		Username           string `json:"username"`
+		About              string `json:"about"`
+		Link               string `json:"link"`
+		VerificationStatus string `json:"verification_status"`
+		FanCount           int    `json:"fan_count"`
+		FollowersCount     int    `json:"followers_count"`
+	}
+
+	query := url.Values{"fields": {"id,name,username,about,link,verification_status,fan_count,followers_count"}}
+	if err := a.fetcher.GetJSON(ctx, "/"+url.PathEscape(targetID), query, &page); err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		ID:          page.ID,
+		Username:    page.Username,
+		DisplayName: page.Name,
+		Bio:         page.About,
+		URL:         page.Link,
+		Verified:    page.VerificationStatus == "blue_verified",
+		Followers:   page.FollowersCount,
+	}, nil
+}
+
+// GetPosts returns the most recent posts of a page
+func (a *FacebookAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	posts, _, err := a.GetPostsPage(ctx, targetID, "", count)
+	return posts, err
+}
+
+// GetPostsPage returns a page of a page's posts, paged by the Graph API's after cursor
+func (a *FacebookAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	var resp struct {
+		Data   []facebookPost `json:"data"`
+		Paging struct {
+			Cursors struct {
+				After string `json:"after"`
+			} `json:"cursors"`
+			Next string `json:"next"`
+		} `json:"paging"`
+	}
+
+	query := url.Values{
+		"fields": {facebookPostFields},
+		"limit":  {strconv.Itoa(count)},
+	}
+	if cursor != "" {
+		query.Set("after", cursor)
+	}
+	if err := a.fetcher.GetJSON(ctx, "/"+url.PathEscape(targetID)+"/posts", query, &resp); err != nil {
+		return nil, "", err
+	}
+
+	posts := make([]Post, 0, len(resp.Data))
+	for _, p := range resp.Data {
+		posts = append(posts, p.toPost())
+	}
+
+	// The after cursor is set on the last page too, only a next link means there is more
+	next := ""
+	if resp.Paging.Next != "" {
+		next = resp.Paging.Cursors.After
+	}
+
+	return posts, next, nil
+}
+
+// GetEngagement returns the current engagement counters of a post
+func (a *FacebookAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	var post facebookPost
+
+	query := url.Values{"fields": {facebookPostFields}}
+	if err := a.fetcher.GetJSON(ctx, "/"+url.PathEscape(postID), query, &post); err != nil {
+		return nil, err
+	}
+
+	return &Engagement{
+		PostID:   postID,
+		Likes:    post.Reactions.Summary.TotalCount,
+		Shares:   post.Shares.Count,
+		Comments: post.Comments.Summary.TotalCount,
+	}, nil
+}
+
+// GetFollowers is not supported; the Graph API doesn't list a page's followers
+func (a *FacebookAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	return nil, ErrNotSupported
+}
+
+// GetComments returns comments on a post
+func (a *FacebookAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	var resp struct {
+		Data []struct {
+			ID          string       `json:"id"`
+			Message     string       `json:"message"`
+			CreatedTime facebookTime `json:"created_time"`
+			LikeCount   int          `json:"like_count"`
+			From        struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"from"`
+			Parent struct {
+				ID string `json:"id"`
+			} `json:"parent"`
+		} `json:"data"`
+	}
+
+	query := url.Values{
+		"fields": {"id,message,created_time,like_count,from,parent"},
+		"filter": {"stream"},
+		"limit":  {strconv.Itoa(count)},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/"+url.PathEscape(postID)+"/comments", query, &resp); err != nil {
+		return nil, err
+	}
+
+	comments := make([]Comment, 0, len(resp.Data))
+	for _, c := range resp.Data {
+		comments = append(comments, Comment{
+			ID:         c.ID,
+			PostID:     postID,
+			ParentID:   c.Parent.ID,
+			AuthorID:   c.From.ID,
+			AuthorName: c.From.Name,
+			Text:       c.Message,
+			Likes:      c.LikeCount,
+			PostedAt:   c.CreatedTime.Time,
+		})
+	}
+
+	return comments, nil
+}
+
+// SearchPosts is not supported; the Graph API has no public post search
+func (a *FacebookAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHashtagPosts is not supported; the Graph API has no public hashtag feed
+func (a *FacebookAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetMentions returns posts by other pages and people that tag the page
+func (a *FacebookAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	var resp struct {
+		Data []facebookPost `json:"data"`
+	}
+
+	query := url.Values{
+		"fields": {facebookPostFields + ",from"},
+		"limit":  {strconv.Itoa(count)},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/"+url.PathEscape(targetID)+"/tagged", query, &resp); err != nil {
+		return nil, err
+	}
+
+	posts := make([]Post, 0, len(resp.Data))
+	for _, p := range resp.Data {
+		posts = append(posts, p.toPost())
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,85 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestFacebookFixtures(t *testing.T) {
+	runFixtureCases(t, "facebook", []fixtureCase{
+		{
+			name: "profile",
+			call: getProfile("acmeoutdoors"),
+			want: &platform.Profile{
+				ID: "104958162837", Username: "acmeoutdoors", DisplayName: "Acme Outdoor Co.", Bio: "Gear for every trail.",
+				URL: "https://www.facebook.com/acmeoutdoors", Verified: true, Followers: 31874,
+			},
+		},
+		{
+			name: "first posts page",
+			call: getPostsPage("acmeoutdoors", ""),
+			want: postsPage{
+				Posts: []platform.Post{
+					{
+						ID: "104958162837_812345678901234", URL: "https://www.facebook.com/acmeoutdoors/posts/812345678901234",
+						Text: "Summer collection is here!", ContentType: "image",
+						MediaURL: "https://scontent.xx.fbcdn.net/v/t39/acme-summer.jpg",
+						PostedAt: at(2024, 6, 1, 12, 0, 0), Likes: 642, Shares: 36, Comments: 58,
+					},
+					{
+						ID: "104958162837_812345678909999", URL: "https://www.facebook.com/acmeoutdoors/videos/812345678909999",
+						Text: "Behind the scenes at our design studio", ContentType: "video",
+						PostedAt: at(2024, 5, 29, 16, 45, 0), Likes: 211, Comments: 12,
+					},
+				},
+				Next: "QVFIUmR",
+			},
+		},
+		{
+			name: "last posts page",
+			call: getPostsPage("acmeoutdoors", "QVFIUmR"),
+			want: postsPage{Posts: []platform.Post{{
+				ID: "104958162837_812345678800001", URL: "https://www.facebook.com/acmeoutdoors/posts/812345678800001",
+				Text: "Spring trail guide is live", ContentType: "link",
+				PostedAt: at(2024, 5, 13, 15, 0, 0), Likes: 174, Shares: 9, Comments: 6,
+			}}},
+		},
+		{
+			name: "engagement",
+			call: getEngagement("acmeoutdoors", "104958162837_812345678901234"),
+			want: &platform.Engagement{PostID: "104958162837_812345678901234", Likes: 701, Shares: 41, Comments: 63},
+		},
+		{
+			name: "comments",
+			call: getComments("acmeoutdoors", "104958162837_812345678901234"),
+			want: []platform.Comment{
+				{
+					ID: "812345678901234_998877665544", PostID: "104958162837_812345678901234", AuthorID: "100012345678",
+					AuthorName: "Sam Trail", Text: "Do these come in wide fit?", Likes: 4, PostedAt: at(2024, 6, 1, 13, 2, 11),
+				},
+				{
+					ID: "812345678901234_998877665545", PostID: "104958162837_812345678901234",
+					ParentID: "812345678901234_998877665544", AuthorID: "104958162837", AuthorName: "Acme Outdoor Co.",
+					Text: "Yes! Sizes 7-14 in wide.", Likes: 2, PostedAt: at(2024, 6, 1, 13, 30, 0),
+				},
+			},
+		},
+		{
+			name: "mentions",
+			call: getMentions("acmeoutdoors"),
+			want: []platform.Post{{
+				ID: "109876543210_998877665544332", AuthorID: "109876543210", AuthorName: "Cascade Trails Association",
+				URL:         "https://www.facebook.com/cascadetrails/posts/998877665544332",
+				Text:        "Our spring trail cleanup was a success, thanks to Acme Outdoors for the gloves and bags!",
+				ContentType: "image", MediaURL: "https://scontent.xx.fbcdn.net/v/t39/cleanup.jpg",
+				PostedAt: at(2024, 6, 2, 15, 20, 0), Likes: 97, Shares: 5, Comments: 11,
+			}},
+		},
+		{
+			name:    "search",
+			call:    searchPosts("acme"),
+			wantErr: platform.ErrNotSupported,
+		},
+	})
+}
@@ -0,0 +1,592 @@
+package platform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func init() {
+	Register(Info{
+		Name:        "feed",
+		DisplayName: "RSS / Atom Feeds",
+		Description: "Blogs, newsrooms and YouTube channels publishing RSS, Atom or JSON feeds",
+		BaseURL:     "", // Feeds are fetched from the URL they are tracked by
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+		},
+		// Feeds are spread over many sites, so the budget is only there to keep a burst of jobs polite
+		RateLimits: RateLimits{
+			RequestsPerMinute: 60,
+			RequestsPerHour:   1200,
+			RequestsPerDay:    10000,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewFeedAPI(fetcher)
+	})
+}
+
+// feedDateLayouts are the date formats found in the wild, tried in order
+var feedDateLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// FeedAPI reads RSS 2.0, RSS 1.0, Atom and JSON Feed documents. The target of a job is the URL of the
+// feed, and each entry of the feed is a post. Feeds have no followers, comments or search.
+type FeedAPI struct {
+	fetcher *Fetcher
+}
+
+// NewFeedAPI creates a new FeedAPI
+func NewFeedAPI(fetcher *Fetcher) *FeedAPI {
+	return &FeedAPI{fetcher: fetcher}
+}
+
+// feed is a parsed feed of any format
+type feed struct {
+	Title       string
+	Description string
+	HomeURL     string
+	NextURL     string // Next page of an RFC 5005 paged Atom feed or a JSON Feed
+	Posts       []Post
+}
+
+// feedLink is a link element, which is an href attribute in Atom and text in RSS
+type feedLink struct {
+	XMLName xml.Name
+	Href    string `xml:"href,attr"`
+	Rel     string `xml:"rel,attr"`
+	Type    string `xml:"type,attr"`
+	Value   string `xml:",chardata"`
+}
+
+// findLink returns the URL of the first link with a relation, where an empty relation is
+// read as "alternate". Links in other namespaces, such as atom:link in an RSS feed, are skipped.
+func findLink(links []feedLink, rel string) string {
+	for _, link := range links {
+		if link.XMLName.Space != "" && link.XMLName.Space != "http://www.w3.org/2005/Atom" {
+			continue
+		}
+		linkRel := link.Rel
+		if linkRel == "" {
+			linkRel = "alternate"
+		}
+		if linkRel != rel {
+			continue
+		}
+		if href := strings.TrimSpace(link.Href); href != "" {
+			return href
+		}
+		if value := strings.TrimSpace(link.Value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// mediaContent is a Media RSS content or thumbnail element
+type mediaContent struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Medium   string `xml:"medium,attr"`
+	Duration string `xml:"duration,attr"`
+}
+
+type rssItem struct {
+	Title     string       `xml:"title"`
+	Links     []feedLink   `xml:"link"`
+	GUID      string       `xml:"guid"`
+	About     string       `xml:"about,attr"` // RSS 1.0 items are identified by rdf:about
+	PubDate   string       `xml:"pubDate"`
+	Date      string       `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Comments  string       `xml:"http://purl.org/rss/1.0/modules/slash/ comments"`
+	Duration  string       `xml:"http://www.itunes.com/dtds/podcast-1.0.dtd duration"`
+	Media     mediaContent `xml:"http://search.yahoo.com/mrss/ content"`
+	Enclosure struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+}
+
+// toPost converts an RSS item to a Post
+func (i rssItem) toPost() Post {
+	link := findLink(i.Links, "alternate")
+
+	post := Post{
+		ID:       firstNonEmpty(i.GUID, i.About, link, i.Title),
+		URL:      link,
+		Text:     strings.TrimSpace(i.Title),
+		PostedAt: parseFeedDate(firstNonEmpty(i.PubDate, i.Date)),
+		MediaURL: firstNonEmpty(i.Enclosure.URL, i.Media.URL),
+		Duration: parseFeedDuration(firstNonEmpty(i.Duration, i.Media.Duration)),
+	}
+	post.Comments, _ = strconv.Atoi(strings.TrimSpace(i.Comments))
+	post.ContentType = feedContentType(firstNonEmpty(i.Enclosure.Type, i.Media.Type, i.Media.Medium))
+
+	return post
+}
+
+type rssChannel struct {
+	Title       string     `xml:"title"`
+	Links       []feedLink `xml:"link"`
+	Description string     `xml:"description"`
+	Items       []rssItem  `xml:"item"`
+}
+
+// rssDocument is an RSS 2.0 feed, whose items are inside the channel
+type rssDocument struct {
+	Channel rssChannel `xml:"channel"`
+}
+
+// rdfDocument is an RSS 1.0 feed, whose items are siblings of the channel
+type rdfDocument struct {
+	Channel rssChannel `xml:"channel"`
+	Items   []rssItem  `xml:"item"`
+}
+
+type atomEntry struct {
+	ID        string     `xml:"id"`
+	Title     string     `xml:"title"`
+	Links     []feedLink `xml:"link"`
+	Published string     `xml:"published"`
+	Updated   string     `xml:"updated"`
+	VideoID   string     `xml:"http://www.youtube.com/xml/schemas/2015 videoId"`
+	Media     struct {
+		Thumbnail mediaContent `xml:"http://search.yahoo.com/mrss/ thumbnail"`
+		Community struct {
+			StarRating struct {
+				Count string `xml:"count,attr"`
+			} `xml:"http://search.yahoo.com/mrss/ starRating"`
+			Statistics struct {
+				Views string `xml:"views,attr"`
+			} `xml:"http://search.yahoo.com/mrss/ statistics"`
+		} `xml:"http://search.yahoo.com/mrss/ community"`
+	} `xml:"http://search.yahoo.com/mrss/ group"`
+}
+
+// toPost converts an Atom entry to a Post. YouTube channel feeds add the video ID and its
+// view and like counts to each entry.
+func (e atomEntry) toPost() Post {
+	link := findLink(e.Links, "alternate")
+
+	post := Post{
+		ID:       firstNonEmpty(e.ID, link),
+		URL:      link,
+		Text:     strings.TrimSpace(e.Title),
+		PostedAt: parseFeedDate(firstNonEmpty(e.Published, e.Updated)),
+	}
+
+	if e.VideoID != "" {
+		post.ID = e.VideoID
+		post.ContentType = "video"
+		post.MediaURL = e.Media.Thumbnail.URL
+		post.Views, _ = strconv.Atoi(e.Media.Community.Statistics.Views)
+		post.Likes, _ = strconv.Atoi(e.Media.Community.StarRating.Count)
+		return post
+	}
+
+	enclosureType := ""
+	for _, link := range e.Links {
+		if link.Rel == "enclosure" {
+			post.MediaURL = link.Href
+			enclosureType = link.Type
+			break
+		}
+	}
+	post.ContentType = feedContentType(enclosureType)
+
+	return post
+}
+
+type atomDocument struct {
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle"`
+	Links    []feedLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type jsonFeedItem struct {
+	ID            json.RawMessage `json:"id"` // A string, though some feeds use numbers
+	URL           string          `json:"url"`
+	ExternalURL   string          `json:"external_url"`
+	Title         string          `json:"title"`
+	ContentText   string          `json:"content_text"`
+	Summary       string          `json:"summary"`
+	Image         string          `json:"image"`
+	DatePublished string          `json:"date_published"`
+	DateModified  string          `json:"date_modified"`
+	Attachments   []struct {
+		URL               string  `json:"url"`
+		MimeType          string  `json:"mime_type"`
+		DurationInSeconds float64 `json:"duration_in_seconds"`
+	} `json:"attachments"`
+}
+
+// toPost converts a JSON Feed item to a Post
+func (i jsonFeedItem) toPost() Post {
+	id := strings.Trim(string(i.ID), `"`)
+
+	post := Post{
+		ID:       firstNonEmpty(id, i.URL),
+		URL:      firstNonEmpty(i.URL, i.ExternalURL),
+		Text:     strings.TrimSpace(firstNonEmpty(i.Title, i.Summary, i.ContentText)),
+		MediaURL: i.Image,
+		PostedAt: parseFeedDate(firstNonEmpty(i.DatePublished, i.DateModified)),
+	}
+
+	mimeType := ""
+	if len(i.Attachments) > 0 {
+		attachment := i.Attachments[0]
+		post.MediaURL = attachment.URL
+		post.Duration = attachment.DurationInSeconds
+		mimeType = attachment.MimeType
+	}
+	post.ContentType = feedContentType(mimeType)
+
+	return post
+}
+
+type jsonFeedDocument struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	Description string         `json:"description"`
+	NextURL     string         `json:"next_url"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+// parseFeed detects the format of a feed and parses it
+func parseFeed(body []byte) (*feed, error) {
+	body = bytes.TrimPrefix(bytes.TrimSpace(body), []byte("\xef\xbb\xbf"))
+
+	if len(body) > 0 && body[0] == '{' {
+		return parseJSONFeed(body)
+	}
+	return parseXMLFeed(body)
+}
+
+// parseJSONFeed parses a JSON Feed document
+func parseJSONFeed(body []byte) (*feed, error) {
+	var doc jsonFeedDocument
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(doc.Version, "https://jsonfeed.org/version/") {
+		return nil, errors.New("JSON document is not a JSON Feed")
+	}
+
+	f := &feed{
+		Title:       doc.Title,
+		Description: doc.Description,
+		HomeURL:     doc.HomePageURL,
+		NextURL:     doc.NextURL,
+	}
+	for _, item := range doc.Items {
+		f.Posts = append(f.Posts, item.toPost())
+	}
+
+	return f, nil
+}
+
+// parseXMLFeed parses an RSS or Atom document. Feeds are often hand-made, so the
+// decoder accepts HTML entities and mismatched tags.
+func parseXMLFeed(body []byte) (*feed, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	decoder.CharsetReader = feedCharsetReader
+
+	// Find the root element to tell the formats apart
+	var root xml.StartElement
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("no feed element found: %w", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			root = start
+			break
+		}
+	}
+
+	f := &feed{}
+	switch root.Name.Local {
+	case "rss":
+		var doc rssDocument
+		if err := decoder.DecodeElement(&doc, &root); err != nil {
+			return nil, err
+		}
+		f.setChannel(doc.Channel, doc.Channel.Items)
+
+	case "RDF":
+		var doc rdfDocument
+		if err := decoder.DecodeElement(&doc, &root); err != nil {
+			return nil, err
+		}
+		f.setChannel(doc.Channel, doc.Items)
+
+	case "feed":
+		var doc atomDocument
+		if err := decoder.DecodeElement(&doc, &root); err != nil {
+			return nil, err
+		}
+		f.Title = strings.TrimSpace(doc.Title)
+		f.Description = strings.TrimSpace(doc.Subtitle)
+		f.HomeURL = findLink(doc.Links, "alternate")
+		f.NextURL = findLink(doc.Links, "next")
+		for _, entry := range doc.Entries {
+			f.Posts = append(f.Posts, entry.toPost())
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown feed format: <%s>", root.Name.Local)
+	}
+
+	return f, nil
+}
+
+// setChannel fills a feed from an RSS channel and its items
+func (f *feed) setChannel(channel rssChannel, items []rssItem) {
+	f.Title = strings.TrimSpace(channel.Title)
+	f.Description = strings.TrimSpace(channel.Description)
+	f.HomeURL = findLink(channel.Links, "alternate")
+	for _, item := range items {
+		f.Posts = append(f.Posts, item.toPost())
+	}
+}
+
+// feedCharsetReader decodes Latin-1 feeds, which encoding/xml can't read by itself. Windows-1252
+// is read as Latin-1 too, which only differs in a few punctuation characters.
+func feedCharsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "latin1", "windows-1252", "cp1252":
+		data, err := io.ReadAll(input)
+		if err != nil {
+			return nil, err
+		}
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return strings.NewReader(string(runes)), nil
+	}
+	return nil, fmt.Errorf("unsupported feed encoding: %s", charset)
+}
+
+// feedContentType maps the MIME type or medium of an entry's media to a content type.
+// Entries without audio or video are articles.
+func feedContentType(mediaType string) string {
+	switch {
+	case strings.HasPrefix(mediaType, "video"):
+		return "video"
+	case strings.HasPrefix(mediaType, "audio"):
+		return "audio"
+	default:
+		return "article"
+	}
+}
+
+// parseFeedDate parses a feed date in any of the common layouts, returning the zero time if none match
+func parseFeedDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range feedDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// parseFeedDuration parses a duration given in seconds or as [HH:]MM:SS
+func parseFeedDuration(value string) float64 {
+	var seconds float64
+	for _, part := range strings.Split(strings.TrimSpace(value), ":") {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds
+}
+
+// firstNonEmpty returns the first value that isn't blank
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// feedPath returns the path a feed URL is fetched from. Without a base URL the feed is fetched
+// directly; with one, such as a caching proxy or the replay server, the host and path of the
+// feed are appended to it, e.g. <base URL>/blog.example.com/feed.xml.
+func (a *FeedAPI) feedPath(feedURL string) (string, url.Values, error) {
+	u, err := url.Parse(feedURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", nil, fmt.Errorf("feed target must be an http or https URL: %s", feedURL)
+	}
+
+	query := u.Query()
+	if a.fetcher.BaseURL != "" {
+		return "/" + u.Host + u.EscapedPath(), query, nil
+	}
+
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), query, nil
+}
+
+// fetch loads and parses a feed unless it hasn't changed since the response the validators came from.
+// Relative links are resolved against the feed's URL and the posts are sorted newest first.
+func (a *FeedAPI) fetch(ctx context.Context, feedURL string, validators Validators) (*feed, Validators, error) {
+	path, query, err := a.feedPath(feedURL)
+	if err != nil {
+		return nil, validators, err
+	}
+
+	body, latest, err := a.fetcher.GetIfModified(ctx, path, query, validators)
+	if err != nil {
+		return nil, latest, err
+	}
+
+	f, err := parseFeed(body)
+	if err != nil {
+		return nil, latest, fmt.Errorf("failed to parse feed %s: %w", feedURL, err)
+	}
+
+	base, _ := url.Parse(feedURL)
+	resolve := func(ref string) string {
+		if ref == "" {
+			return ""
+		}
+		if u, err := base.Parse(ref); err == nil {
+			return u.String()
+		}
+		return ref
+	}
+	f.HomeURL = resolve(f.HomeURL)
+	f.NextURL = resolve(f.NextURL)
+	for i := range f.Posts {
+		f.Posts[i].URL = resolve(f.Posts[i].URL)
+		f.Posts[i].AuthorName = f.Title
+	}
+
+	sort.SliceStable(f.Posts, func(i, j int) bool {
+		return f.Posts[i].PostedAt.After(f.Posts[j].PostedAt)
+	})
+
+	return f, latest, nil
+}
+
+// GetProfile returns the title and description of a feed as a profile
+func (a *FeedAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	f, _, err := a.fetch(ctx, targetID, Validators{})
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &Profile{
+		ID:          targetID,
+		DisplayName: f.Title,
+		Bio:         f.Description,
+		URL:         firstNonEmpty(f.HomeURL, targetID),
+		PostCount:   len(f.Posts),
+	}
+	if u, err := url.Parse(profile.URL); err == nil {
+		profile.Username = u.Host
+	}
+
+	return profile, nil
+}
+
+// GetPosts returns the newest entries of a feed
+func (a *FeedAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	posts, _, err := a.GetPostsIfModified(ctx, targetID, count, Validators{})
+	return posts, err
+}
+
+// GetPostsIfModified returns the newest entries of a feed, or ErrNotModified if the feed
+// hasn't changed since the response the validators came from
+func (a *FeedAPI) GetPostsIfModified(ctx context.Context, targetID string, count int, validators Validators) ([]Post, Validators, error) {
+	f, latest, err := a.fetch(ctx, targetID, validators)
+	if err != nil {
+		return nil, latest, err
+	}
+
+	posts := f.Posts
+	if len(posts) > count {
+		posts = posts[:count]
+	}
+
+	return posts, latest, nil
+}
+
+// GetPostsPage returns a page of a feed's entries. Most feeds only list recent entries, but paged
+// Atom feeds and JSON Feeds link to older pages, and the next cursor is the URL of that page.
+// The publisher decides how many entries a page has, so the whole page is returned.
+func (a *FeedAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	pageURL := targetID
+	if cursor != "" {
+		pageURL = cursor
+	}
+
+	f, _, err := a.fetch(ctx, pageURL, Validators{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return f.Posts, f.NextURL, nil
+}
+
+// GetEngagement is not supported; feeds don't carry engagement counters
+func (a *FeedAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	return nil, ErrNotSupported
+}
+
+// GetFollowers is not supported; feeds have no followers
+func (a *FeedAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	return nil, ErrNotSupported
+}
+
+// GetComments is not supported; feeds only link to their comments
+func (a *FeedAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	return nil, ErrNotSupported
+}
+
+// SearchPosts is not supported; feeds can't be searched
+func (a *FeedAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHashtagPosts is not supported; feeds don't use hashtags
+func (a *FeedAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetMentions is not supported; feeds only contain their own entries
+func (a *FeedAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
@@ -0,0 +1,193 @@
+package platform_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestFeedFixtures(t *testing.T) {
+	const (
+		blog     = "https://blog.acmeoutdoor.com/feed/"
+		youtube  = "https://www.youtube.com/feeds/videos.xml?channel_id=UCacmeOutdoor0000000000a"
+		newsroom = "https://newsroom.acmeoutdoor.com/feed.json"
+	)
+
+	runFixtureCases(t, "feed", []fixtureCase{
+		{
+			name: "rss profile",
+			call: getProfile(blog),
+			want: &platform.Profile{
+				ID: blog, Username: "blog.acmeoutdoor.com", DisplayName: "Acme Outdoor Co. Blog",
+				Bio: "Trail guides, gear reviews and news from Acme Outdoor Co.", URL: "https://blog.acmeoutdoor.com", PostCount: 3,
+			},
+		},
+		{
+			name: "rss posts",
+			call: getPosts(blog, 10),
+			want: []platform.Post{
+				{
+					ID: "https://blog.acmeoutdoor.com/?p=2187", AuthorName: "Acme Outdoor Co. Blog",
+					URL: "https://blog.acmeoutdoor.com/2024/06/summer-collection/", Text: "Our Summer 2024 Collection Is Here",
+					ContentType: "article", PostedAt: at(2024, 6, 3, 14, 30, 0), Comments: 14,
+				},
+				{
+					ID: "https://blog.acmeoutdoor.com/?p=2164", AuthorName: "Acme Outdoor Co. Blog",
+					URL:  "https://blog.acmeoutdoor.com/2024/05/trail-talk-12/",
+					Text: "Trail Talk Episode 12: Thru-Hiking the Colorado Trail", ContentType: "audio",
+					MediaURL: "https://cdn.acmeoutdoor.com/podcast/trail-talk-12.mp3", Duration: 3012,
+					PostedAt: at(2024, 5, 23, 9, 0, 0), Comments: 3,
+				},
+				{
+					ID: "https://blog.acmeoutdoor.com/?p=2140", AuthorName: "Acme Outdoor Co. Blog",
+					URL: "https://blog.acmeoutdoor.com/2024/05/how-we-test-rain-shells/", Text: "How We Test Rain Shells",
+					ContentType: "article", PostedAt: at(2024, 5, 7, 16, 15, 0),
+				},
+			},
+		},
+		{
+			name: "youtube posts",
+			call: getPosts(youtube, 10),
+			want: []platform.Post{
+				{
+					ID: "Zx4cQ9acmeA", AuthorName: "Acme Outdoor Co.", URL: "https://www.youtube.com/watch?v=Zx4cQ9acmeA",
+					Text: "Summer 2024 Collection | First Look", ContentType: "video",
+					MediaURL: "https://i2.ytimg.com/vi/Zx4cQ9acmeA/hqdefault.jpg", PostedAt: at(2024, 6, 3, 15, 0, 7),
+					Likes: 1893, Views: 48217,
+				},
+				{
+					ID: "Pq7rT2acmeB", AuthorName: "Acme Outdoor Co.", URL: "https://www.youtube.com/watch?v=Pq7rT2acmeB",
+					Text: "How to Pitch a Tent in the Wind", ContentType: "video",
+					MediaURL: "https://i3.ytimg.com/vi/Pq7rT2acmeB/hqdefault.jpg", PostedAt: at(2024, 5, 20, 16, 30, 0),
+					Likes: 742, Views: 21904,
+				},
+			},
+		},
+		{
+			name: "json feed profile",
+			call: getProfile(newsroom),
+			want: &platform.Profile{
+				ID: newsroom, Username: "newsroom.acmeoutdoor.com", DisplayName: "Acme Outdoor Co. Newsroom",
+				Bio: "Press releases and announcements from Acme Outdoor Co.", URL: "https://newsroom.acmeoutdoor.com/", PostCount: 2,
+			},
+		},
+		{
+			name: "json feed first page",
+			call: getPostsPage(newsroom, ""),
+			want: postsPage{
+				Posts: []platform.Post{
+					{
+						ID:         "https://newsroom.acmeoutdoor.com/2024/06/acme-opens-denver-flagship",
+						AuthorName: "Acme Outdoor Co. Newsroom", URL: "https://newsroom.acmeoutdoor.com/2024/06/acme-opens-denver-flagship",
+						Text: "Acme Outdoor Co. Opens Denver Flagship Store", ContentType: "article",
+						MediaURL: "https://newsroom.acmeoutdoor.com/images/denver-flagship.jpg", PostedAt: at(2024, 6, 4, 19, 0, 0),
+					},
+					{
+						ID:         "https://newsroom.acmeoutdoor.com/2024/05/recycled-fleece",
+						AuthorName: "Acme Outdoor Co. Newsroom", URL: "https://newsroom.acmeoutdoor.com/2024/05/recycled-fleece",
+						Text: "All Acme Fleece Now Made From Recycled Materials", ContentType: "video",
+						MediaURL: "https://newsroom.acmeoutdoor.com/media/recycled-fleece.mp4", Duration: 94,
+						PostedAt: at(2024, 5, 15, 15, 0, 0),
+					},
+				},
+				Next: newsroom + "?page=2",
+			},
+		},
+		{
+			name: "json feed last page",
+			call: getPostsPage(newsroom, newsroom+"?page=2"),
+			want: postsPage{Posts: []platform.Post{{
+				ID:         "https://newsroom.acmeoutdoor.com/2024/03/q1-results",
+				AuthorName: "Acme Outdoor Co. Newsroom", URL: "https://newsroom.acmeoutdoor.com/2024/03/q1-results",
+				Text: "Acme Outdoor Co. Reports First Quarter Results", ContentType: "article",
+				PostedAt: at(2024, 3, 28, 13, 0, 0),
+			}}},
+		},
+	})
+}
+
+func TestFeedConditionalFetch(t *testing.T) {
+	const (
+		blog         = "https://blog.acmeoutdoor.com/feed/"
+		etag         = `"2187-1717425000"`
+		lastModified = "Mon, 03 Jun 2024 14:30:00 GMT"
+	)
+
+	body, err := os.ReadFile(filepath.Join("testdata", "feed", "blog.acmeoutdoor.com_feed.xml"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/blog.acmeoutdoor.com/feed/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		if r.Header.Get("If-None-Match") == etag || r.Header.Get("If-Modified-Since") == lastModified {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	api := platform.NewFeedAPI(platform.NewFetcher(server.URL))
+	ctx := context.Background()
+
+	posts, validators, err := platform.GetPostsIfModified(ctx, api, blog, 2, platform.Validators{})
+	if err != nil {
+		t.Fatalf("unconditional fetch: unexpected error: %v", err)
+	}
+	if len(posts) != 2 || posts[0].ID != "https://blog.acmeoutdoor.com/?p=2187" {
+		t.Errorf("unconditional fetch returned %+v, want the 2 newest posts", posts)
+	}
+	if want := (platform.Validators{ETag: etag, LastModified: lastModified}); validators != want {
+		t.Errorf("unconditional fetch returned validators %+v, want %+v", validators, want)
+	}
+
+	cases := []struct {
+		name       string
+		validators platform.Validators
+		wantErr    error
+	}{
+		{name: "matching etag", validators: validators, wantErr: platform.ErrNotModified},
+		{name: "matching last modified", validators: platform.Validators{LastModified: lastModified}, wantErr: platform.ErrNotModified},
+		{name: "stale etag", validators: platform.Validators{ETag: `"2164-1716454800"`}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			posts, latest, err := api.GetPostsIfModified(ctx, blog, 10, tc.validators)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tc.wantErr)
+				}
+				if latest != tc.validators {
+					t.Errorf("got validators %+v, want the ones sent %+v", latest, tc.validators)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(posts) != 3 || latest.ETag != etag {
+				t.Errorf("got %d posts and validators %+v, want 3 posts and the new validators", len(posts), latest)
+			}
+		})
+	}
+
+	if requests != 1+len(cases) {
+		t.Errorf("server received %d requests, want %d", requests, 1+len(cases))
+	}
+}
@@ -0,0 +1,180 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrTargetNotFound is returned when the platform reports that an account or post doesn't exist
+var ErrTargetNotFound = errors.New("target not found")
+
+// ErrNotModified is returned by conditional requests when the response hasn't changed since it was last fetched
+var ErrNotModified = errors.New("not modified since last fetch")
+
+// maxResponseSize caps how much of a response body is read
+const maxResponseSize = 10 << 20
+
+// HTTPError is returned when a platform responds with an error status
+type HTTPError struct {
+	URL        string
+	StatusCode int
+	Body       string
+	RetryAfter time.Duration // Set when the platform sent a Retry-After header
+}
+
+// Error implements the error interface
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("request to %s failed with status: %d", e.URL, e.StatusCode)
+}
+
+// Is allows errors.Is(err, ErrTargetNotFound) to match 404 responses
+func (e *HTTPError) Is(target error) bool {
+	return target == ErrTargetNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// Validators identify a version of a response. Sending them back with a request lets the
+// server answer 304 Not Modified instead of sending a response that hasn't changed again.
+type Validators struct {
+	ETag         string
+	LastModified string
+}
+
+// Fetcher performs HTTP requests against a platform's base URL
+type Fetcher struct {
+	BaseURL    string
+	Headers    http.Header
+	Query      url.Values
+	HTTPClient *http.Client
+}
+
+// NewFetcher creates a new Fetcher for the given base URL
+func NewFetcher(baseURL string) *Fetcher {
+	return &Fetcher{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Headers: http.Header{
+			"User-Agent": []string{"go-competitor-scraper/1.0"},
+		},
+		Query:      url.Values{},
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Get fetches a path relative to the base URL and returns the response body
+func (f *Fetcher) Get(ctx context.Context, path string, query url.Values) ([]byte, error) {
+	body, _, err := f.do(ctx, path, query, nil)
+	return body, err
+}
+
+// GetIfModified fetches a path unless it hasn't changed since the response the validators came from,
+// in which case it returns ErrNotModified. It returns the validators of the new response.
+func (f *Fetcher) GetIfModified(ctx context.Context, path string, query url.Values, validators Validators) ([]byte, Validators, error) {
+	header := http.Header{}
+	if validators.ETag != "" {
+		header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	body, resp, err := f.do(ctx, path, query, header)
+	if err != nil {
+		return nil, validators, err
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, validators, ErrNotModified
+	}
+
+	return body, Validators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
+
+// do sends a GET request for a path with the fetcher's headers and any extra ones,
+// and returns the response body along with the response
+func (f *Fetcher) do(ctx context.Context, path string, query url.Values, header http.Header) ([]byte, *http.Response, error) {
+	params := url.Values{}
+	for key, values := range f.Query {
+		params[key] = values
+	}
+	for key, values := range query {
+		params[key] = values
+	}
+
+	requestURL := f.BaseURL + path
+	if len(params) > 0 {
+		requestURL += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for key, values := range f.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := f.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read response from %s: %w", f.BaseURL+path, err)
+	}
+
+	if resp.StatusCode >= 400 {
+		httpErr := &HTTPError{URL: f.BaseURL + path, StatusCode: resp.StatusCode, Body: string(body)}
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			httpErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, nil, httpErr
+	}
+
+	return body, resp, nil
+}
+
+// GetJSON fetches a path and decodes the JSON response into out
+func (f *Fetcher) GetJSON(ctx context.Context, path string, query url.Values, out interface{}) error {
+	body, err := f.Get(ctx, path, query)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// scriptPattern matches inline script elements and captures their attributes and content
+var scriptPattern = regexp.MustCompile(`(?is)<script([^>]*)>(.*?)</script>`)
+
+// extractScripts returns the content of every script element whose attributes contain attr
+func extractScripts(page []byte, attr string) [][]byte {
+	var scripts [][]byte
+	for _, match := range scriptPattern.FindAllSubmatch(page, -1) {
+		if strings.Contains(string(match[1]), attr) {
+			scripts = append(scripts, match[2])
+		}
+	}
+	return scripts
+}
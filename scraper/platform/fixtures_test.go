@@ -0,0 +1,140 @@
+package platform_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/platform/replay"
+)
+
+// fixtureCase calls a platform API against the recorded fixtures and checks what it decoded
+type fixtureCase struct {
+	name    string
+	call    func(ctx context.Context, api platform.API) (interface{}, error)
+	want    interface{}
+	wantErr error
+}
+
+// postsPage is a page of posts along with the cursor of the next page
+type postsPage struct {
+	Posts []platform.Post
+	Next  string
+}
+
+// runFixtureCases runs the cases against a platform's API served from testdata
+func runFixtureCases(t *testing.T, name string, cases []fixtureCase) {
+	t.Helper()
+
+	srv, err := replay.NewServer("testdata")
+	if err != nil {
+		t.Fatalf("failed to start replay server: %v", err)
+	}
+	t.Cleanup(srv.Close)
+
+	api := srv.APIs()[name]
+	if api == nil {
+		t.Fatalf("platform %s isn't registered", name)
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.call(context.Background(), api)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got = inUTC(got)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("decoded\n%+v\nwant\n%+v", got, tc.want)
+			}
+		})
+	}
+}
+
+// inUTC converts the timestamps of decoded posts and comments to UTC, so they compare equal to
+// the expected values whatever offset the platform reported them in
+func inUTC(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []platform.Post:
+		for i := range v {
+			v[i].PostedAt = v[i].PostedAt.UTC()
+		}
+	case postsPage:
+		inUTC(v.Posts)
+	case []platform.Comment:
+		for i := range v {
+			v[i].PostedAt = v[i].PostedAt.UTC()
+		}
+	}
+	return v
+}
+
+// at returns a UTC time for expected values
+func at(year int, month time.Month, day, hour, min, sec int) time.Time {
+	return time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+}
+
+func getProfile(targetID string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetProfile(ctx, targetID)
+	}
+}
+
+func getPosts(targetID string, count int) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetPosts(ctx, targetID, count)
+	}
+}
+
+func getPostsPage(targetID, cursor string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		posts, next, err := api.GetPostsPage(ctx, targetID, cursor, 10)
+		return postsPage{Posts: posts, Next: next}, err
+	}
+}
+
+func getEngagement(targetID, postID string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetEngagement(ctx, targetID, postID)
+	}
+}
+
+func getComments(targetID, postID string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetComments(ctx, targetID, postID, 10)
+	}
+}
+
+func getFollowers(targetID string, count int) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetFollowers(ctx, targetID, count)
+	}
+}
+
+func searchPosts(keyword string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.SearchPosts(ctx, keyword, 10)
+	}
+}
+
+func getHashtagPosts(hashtag string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetHashtagPosts(ctx, hashtag, 10)
+	}
+}
+
+func getMentions(targetID string) func(context.Context, platform.API) (interface{}, error) {
+	return func(ctx context.Context, api platform.API) (interface{}, error) {
+		return api.GetMentions(ctx, targetID, 10)
+	}
+}
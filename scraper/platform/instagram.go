@@ -0,0 +1,457 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// instagramAppID is the app ID the Instagram web client sends with its API requests
+const instagramAppID = "936619743392459"
+
+func init() {
+	Register(Info{
+		Name:        "instagram",
+		DisplayName: "Instagram",
+		Description: "Meta's photo and video sharing social network",
+		BaseURL:     "https://i.instagram.com",
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+			repository.JobTypeEngagement,
+			repository.JobTypeComments,
+			repository.JobTypeFollowers,
+			repository.JobTypeKeyword,
+			repository.JobTypeHashtag,
+			repository.JobTypeMentions,
+		},
+		RateLimits: RateLimits{
+			RequestsPerMinute: 30,
+			RequestsPerHour:   500,
+			RequestsPerDay:    5000,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewInstagramAPI(fetcher)
+	})
+}
+
+// InstagramAPI scrapes Instagram's public web API
+type InstagramAPI struct {
+	fetcher *Fetcher
+}
+
+// NewInstagramAPI creates a new InstagramAPI
+func NewInstagramAPI(fetcher *Fetcher) *InstagramAPI {
+	fetcher.Headers.Set("X-IG-App-ID", instagramAppID)
+	return &InstagramAPI{fetcher: fetcher}
+}
+
+type instagramCount struct {
+	Count int `json:"count"`
+}
+
+type instagramUser struct {
+	ID             string         `json:"id"`
+	Username       string         `json:"username"`
+	FullName       string         `json:"full_name"`
+	Biography      string         `json:"biography"`
+	ExternalURL    string         `json:"external_url"`
+	IsVerified     bool           `json:"is_verified"`
+	EdgeFollowedBy instagramCount `json:"edge_followed_by"`
+	EdgeFollow     instagramCount `json:"edge_follow"`
+	Timeline       struct {
+		Count int `json:"count"`
+		Edges []struct {
+			Node instagramTimelineNode `json:"node"`
+		} `json:"edges"`
+	} `json:"edge_owner_to_timeline_media"`
+}
+
+type instagramTimelineNode struct {
+	ID         string  `json:"id"`
+	Shortcode  string  `json:"shortcode"`
+	IsVideo    bool    `json:"is_video"`
+	DisplayURL string  `json:"display_url"`
+	VideoURL   string  `json:"video_url"`
+	Duration   float64 `json:"video_duration"`
+	TakenAt    int64   `json:"taken_at_timestamp"`
+	ViewCount  int     `json:"video_view_count"`
+	Caption    struct {
+		Edges []struct {
+			Node struct {
+				Text string `json:"text"`
+			} `json:"node"`
+		} `json:"edges"`
+	} `json:"edge_media_to_caption"`
+	Likes    instagramCount `json:"edge_liked_by"`
+	Comments instagramCount `json:"edge_media_to_comment"`
+}
+
+type instagramMedia struct {
+	ID           string `json:"id"`
+	Code         string `json:"code"`
+	LikeCount    int    `json:"like_count"`
+	CommentCount int    `json:"comment_count"`
+	PlayCount    int    `json:"play_count"`
+}
+
+type instagramComment struct {
+	PK              string `json:"pk"`
+	Text            string `json:"text"`
+	CreatedAt       int64  `json:"created_at"`
+	LikeCount       int    `json:"comment_like_count"`
+	ParentCommentID string `json:"parent_comment_id"`
+	User            struct {
+		PK       string `json:"pk"`
+		Username string `json:"username"`
+	} `json:"user"`
+}
+
+// fetchUser loads the public profile of a username, including its most recent posts
+func (a *InstagramAPI) fetchUser(ctx context.Context, username string) (*instagramUser, error) {
+	var resp struct {
+		Data struct {
+			User *instagramUser `json:"user"`
+		} `json:"data"`
+	}
+
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/users/web_profile_info/", url.Values{"username": {username}}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data.User == nil {
+		return nil, fmt.Errorf("instagram user %s: %w", username, ErrTargetNotFound)
+	}
+
+	return resp.Data.User, nil
+}
+
+// userID resolves a target to its numeric user ID
+func (a *InstagramAPI) userID(ctx context.Context, targetID string) (string, error) {
+	if _, err := strconv.ParseUint(targetID, 10, 64); err == nil {
+		return targetID, nil
+	}
+
+	user, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// GetProfile returns the public profile of an account
+func (a *InstagramAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	user, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.FullName,
+		Bio:         user.Biography,
+		URL:         "https://www.instagram.com/" + user.Username + "/",
+		Verified:    user.IsVerified,
+		Followers:   user.EdgeFollowedBy.Count,
+		Following:   user.EdgeFollow.Count,
+		PostCount:   user.Timeline.Count,
+	}, nil
+}
+
+// GetPosts returns the most recent posts of an account
+func (a *InstagramAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	user, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var posts []Post
+	for _, edge := range user.Timeline.Edges {
+		if len(posts) == count {
+			break
+		}
+
+		node := edge.Node
+		post := Post{
+			ID:          node.ID,
+			URL:         "https://www.instagram.com/p/" + node.Shortcode + "/",
+			ContentType: "image",
+			MediaURL:    node.DisplayURL,
+			PostedAt:    time.Unix(node.TakenAt, 0).UTC(),
+			Likes:       node.Likes.Count,
+			Comments:    node.Comments.Count,
+			Views:       node.ViewCount,
+		}
+		if node.IsVideo {
+			post.ContentType = "video"
+			post.MediaURL = node.VideoURL
+			post.Duration = node.Duration
+		}
+		if len(node.Caption.Edges) > 0 {
+			post.Text = node.Caption.Edges[0].Node.Text
+		}
+
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// GetPostsPage returns a page of an account's posts from its feed, paged by the feed's max ID.
+// The profile page only embeds the latest posts, so older ones can only be reached through the feed.
+func (a *InstagramAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	// The feed is keyed by the numeric user ID
+	userID, err := a.userID(ctx, targetID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var resp struct {
+		Items         []instagramFeedMedia `json:"items"`
+		NextMaxID     string               `json:"next_max_id"`
+		MoreAvailable bool                 `json:"more_available"`
+	}
+
+	query := url.Values{"count": {strconv.Itoa(count)}}
+	if cursor != "" {
+		query.Set("max_id", cursor)
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/feed/user/"+userID+"/", query, &resp); err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if resp.MoreAvailable {
+		next = resp.NextMaxID
+	}
+
+	return feedPosts(resp.Items, count), next, nil
+}
+
+// GetEngagement returns the current engagement counters of a post
+func (a *InstagramAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	var resp struct {
+		Items []instagramMedia `json:"items"`
+	}
+
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/media/"+url.PathEscape(postID)+"/info/", nil, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Items) == 0 {
+		return nil, fmt.Errorf("instagram post %s: %w", postID, ErrTargetNotFound)
+	}
+
+	media := resp.Items[0]
+	return &Engagement{
+		PostID:   postID,
+		Likes:    media.LikeCount,
+		Comments: media.CommentCount,
+		Views:    media.PlayCount,
+	}, nil
+}
+
+// GetFollowers returns accounts following the target
+func (a *InstagramAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	// The followers endpoint is keyed by the numeric user ID
+	userID := targetID
+	if _, err := strconv.ParseUint(targetID, 10, 64); err != nil {
+		user, err := a.fetchUser(ctx, targetID)
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	}
+
+	var resp struct {
+		Users []struct {
+			PK       string `json:"pk"`
+			Username string `json:"username"`
+			FullName string `json:"full_name"`
+		} `json:"users"`
+	}
+
+	query := url.Values{"count": {strconv.Itoa(count)}}
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/friendships/"+userID+"/followers/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	followers := make([]Follower, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		followers = append(followers, Follower{
+			ID:          user.PK,
+			Username:    user.Username,
+			DisplayName: user.FullName,
+		})
+	}
+
+	return followers, nil
+}
+
+// GetComments returns comments on a post
+func (a *InstagramAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	var resp struct {
+		Comments []instagramComment `json:"comments"`
+	}
+
+	query := url.Values{"can_support_threading": {"true"}}
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/media/"+url.PathEscape(postID)+"/comments/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	var comments []Comment
+	for _, c := range resp.Comments {
+		if len(comments) == count {
+			break
+		}
+		comments = append(comments, Comment{
+			ID:         c.PK,
+			PostID:     postID,
+			ParentID:   c.ParentCommentID,
+			AuthorID:   c.User.PK,
+			AuthorName: c.User.Username,
+			Text:       c.Text,
+			Likes:      c.LikeCount,
+			PostedAt:   time.Unix(c.CreatedAt, 0).UTC(),
+		})
+	}
+
+	return comments, nil
+}
+
+// instagramFeedMedia is a post as returned by Instagram's feed and search endpoints.
+// Primary keys are sent as numbers by some endpoints and as strings by others.
+type instagramFeedMedia struct {
+	PK           json.Number `json:"pk"`
+	Code         string      `json:"code"`
+	TakenAt      int64       `json:"taken_at"`
+	MediaType    int         `json:"media_type"`
+	LikeCount    int         `json:"like_count"`
+	CommentCount int         `json:"comment_count"`
+	PlayCount    int         `json:"play_count"`
+	Duration     float64     `json:"video_duration"`
+	Caption      *struct {
+		Text string `json:"text"`
+	} `json:"caption"`
+	User struct {
+		PK       json.Number `json:"pk"`
+		Username string      `json:"username"`
+	} `json:"user"`
+}
+
+// instagramSections is the grid layout used by hashtag and search results
+type instagramSections struct {
+	Sections []struct {
+		LayoutContent struct {
+			Medias []struct {
+				Media instagramFeedMedia `json:"media"`
+			} `json:"medias"`
+		} `json:"layout_content"`
+	} `json:"sections"`
+}
+
+// medias flattens the posts of every section
+func (s instagramSections) medias() []instagramFeedMedia {
+	var medias []instagramFeedMedia
+	for _, section := range s.Sections {
+		for _, m := range section.LayoutContent.Medias {
+			medias = append(medias, m.Media)
+		}
+	}
+	return medias
+}
+
+// feedPosts converts feed media to posts, skipping duplicates
+func feedPosts(medias []instagramFeedMedia, count int) []Post {
+	seen := make(map[json.Number]bool)
+	var posts []Post
+	for _, m := range medias {
+		if len(posts) == count {
+			break
+		}
+		if seen[m.PK] {
+			continue
+		}
+		seen[m.PK] = true
+
+		post := Post{
+			ID:          m.PK.String(),
+			AuthorID:    m.User.PK.String(),
+			AuthorName:  m.User.Username,
+			URL:         "https://www.instagram.com/p/" + m.Code + "/",
+			ContentType: "image",
+			PostedAt:    time.Unix(m.TakenAt, 0).UTC(),
+			Likes:       m.LikeCount,
+			Comments:    m.CommentCount,
+			Views:       m.PlayCount,
+		}
+		switch m.MediaType {
+		case 2:
+			post.ContentType = "video"
+			post.Duration = m.Duration
+		case 8:
+			post.ContentType = "carousel"
+		}
+		if m.Caption != nil {
+			post.Text = m.Caption.Text
+		}
+
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// SearchPosts returns posts matching a keyword
+func (a *InstagramAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	var resp struct {
+		MediaGrid instagramSections `json:"media_grid"`
+	}
+
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/fbsearch/web/top_serp/", url.Values{"query": {keyword}}, &resp); err != nil {
+		return nil, err
+	}
+
+	return feedPosts(resp.MediaGrid.medias(), count), nil
+}
+
+// GetHashtagPosts returns the most recent posts using a hashtag
+func (a *InstagramAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	var resp struct {
+		Data struct {
+			Recent instagramSections `json:"recent"`
+			Top    instagramSections `json:"top"`
+		} `json:"data"`
+	}
+
+	query := url.Values{"tag_name": {strings.TrimPrefix(hashtag, "#")}}
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/tags/web_info/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return feedPosts(append(resp.Data.Recent.medias(), resp.Data.Top.medias()...), count), nil
+}
+
+// GetMentions returns posts the account is tagged in
+func (a *InstagramAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	// The tagged feed is keyed by the numeric user ID
+	userID, err := a.userID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Items []instagramFeedMedia `json:"items"`
+	}
+
+	query := url.Values{"count": {strconv.Itoa(count)}}
+	if err := a.fetcher.GetJSON(ctx, "/api/v1/usertags/"+userID+"/feed/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return feedPosts(resp.Items, count), nil
+}
@@ -0,0 +1,128 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestInstagramFixtures(t *testing.T) {
+	trek := platform.Post{
+		ID: "3212345678901234567", AuthorID: "1784512345", AuthorName: "acme",
+		URL: "https://www.instagram.com/p/C2xYz1aBcDe/", Text: "Summer collection is here", ContentType: "image",
+		PostedAt: at(2024, 6, 1, 12, 0, 0), Likes: 1523, Comments: 87,
+	}
+	reel := platform.Post{
+		ID: "3212345678901234568", AuthorID: "1784512345", AuthorName: "acme",
+		URL: "https://www.instagram.com/p/C2xYz2fGhIj/", ContentType: "video", Duration: 28.4,
+		PostedAt: at(2024, 5, 30, 12, 0, 0), Likes: 2210, Comments: 143, Views: 20411,
+	}
+	fanVideo := platform.Post{
+		ID: "3213456789012345678", AuthorID: "5566778899", AuthorName: "trailrunner",
+		URL: "https://www.instagram.com/p/C7yTrailFan2/", Text: "New boots held up in the mud #acmeoutdoors #hiking",
+		ContentType: "video", Duration: 21.4, PostedAt: at(2024, 6, 3, 11, 0, 0), Likes: 214, Comments: 9, Views: 3870,
+	}
+	shootout := platform.Post{
+		ID: "3214567890123456789", AuthorID: "6677889900", AuthorName: "gearlab",
+		URL: "https://www.instagram.com/p/C7zGearTest3/", Text: "Acme vs. the rest: tent shootout", ContentType: "carousel",
+		PostedAt: at(2024, 6, 4, 11, 0, 0), Likes: 532, Comments: 41,
+	}
+
+	// The profile's embedded timeline carries media URLs but not the author
+	timelineTrek, timelineReel := trek, reel
+	timelineTrek.AuthorID, timelineTrek.AuthorName = "", ""
+	timelineTrek.MediaURL = "https://scontent.cdninstagram.com/v/t51/acme-1.jpg"
+	timelineReel.AuthorID, timelineReel.AuthorName = "", ""
+	timelineReel.MediaURL = "https://scontent.cdninstagram.com/v/t50/acme-2.mp4"
+
+	taggedTrek := trek
+	taggedTrek.URL = "https://www.instagram.com/p/C7xAcmeTrek1/"
+	taggedTrek.Text = "Three days, one pack #acmeoutdoors"
+	taggedTrek.PostedAt = at(2024, 6, 2, 12, 0, 0)
+
+	mentioned := shootout
+	mentioned.Text = "Acme vs. the rest: tent shootout @acme"
+
+	runFixtureCases(t, "instagram", []fixtureCase{
+		{
+			name: "profile",
+			call: getProfile("acme"),
+			want: &platform.Profile{
+				ID: "1784512345", Username: "acme", DisplayName: "Acme Outdoor Co.",
+				Bio: "Gear for every trail. Tag #acmeoutdoors", URL: "https://www.instagram.com/acme/",
+				Verified: true, Followers: 48210, Following: 312, PostCount: 864,
+			},
+		},
+		{
+			name:    "missing profile",
+			call:    getProfile("missing"),
+			wantErr: platform.ErrTargetNotFound,
+		},
+		{
+			name: "posts",
+			call: getPosts("acme", 10),
+			want: []platform.Post{timelineTrek, timelineReel},
+		},
+		{
+			name: "posts limited to count",
+			call: getPosts("acme", 1),
+			want: []platform.Post{timelineTrek},
+		},
+		{
+			name: "first posts page",
+			call: getPostsPage("acme", ""),
+			want: postsPage{Posts: []platform.Post{trek, reel}, Next: "3212345678901234568_1784512345"},
+		},
+		{
+			name: "last posts page",
+			call: getPostsPage("acme", "3212345678901234568_1784512345"),
+			want: postsPage{Posts: []platform.Post{{
+				ID: "3198765432109876543", AuthorID: "1784512345", AuthorName: "acme",
+				URL: "https://www.instagram.com/p/C1aBcDeFgHi/", Text: "Spring trail guide is live", ContentType: "image",
+				PostedAt: at(2024, 5, 12, 12, 0, 0), Likes: 984, Comments: 52,
+			}}},
+		},
+		{
+			name: "engagement",
+			call: getEngagement("acme", "3212345678901234567"),
+			want: &platform.Engagement{PostID: "3212345678901234567", Likes: 1608, Comments: 91},
+		},
+		{
+			name: "comments",
+			call: getComments("acme", "3212345678901234567"),
+			want: []platform.Comment{
+				{
+					ID: "17998812345678901", PostID: "3212345678901234567", AuthorID: "5512345", AuthorName: "trailrunner",
+					Text: "Love the new colours!", Likes: 12, PostedAt: at(2024, 6, 1, 13, 0, 0),
+				},
+				{
+					ID: "17998812345678902", PostID: "3212345678901234567", ParentID: "17998812345678901",
+					AuthorID: "5598765", AuthorName: "hikerjen", Text: "@trailrunner same", Likes: 1, PostedAt: at(2024, 6, 1, 14, 0, 0),
+				},
+			},
+		},
+		{
+			name: "followers",
+			call: getFollowers("acme", 10),
+			want: []platform.Follower{
+				{ID: "5512345", Username: "trailrunner", DisplayName: "Sam Trail"},
+				{ID: "5598765", Username: "hikerjen", DisplayName: "Jen Hiker"},
+			},
+		},
+		{
+			name: "search",
+			call: searchPosts("acme"),
+			want: []platform.Post{fanVideo, shootout},
+		},
+		{
+			name: "hashtag",
+			call: getHashtagPosts("acmeoutdoors"),
+			want: []platform.Post{fanVideo, taggedTrek},
+		},
+		{
+			name: "mentions",
+			call: getMentions("acme"),
+			want: []platform.Post{mentioned},
+		},
+	})
+}
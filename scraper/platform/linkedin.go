@@ -0,0 +1,301 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func init() {
+	Register(Info{
+		Name:        "linkedin",
+		DisplayName: "LinkedIn",
+		Description: "Professional networking and career development platform",
+		BaseURL:     "https://www.linkedin.com",
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+			repository.JobTypeEngagement,
+			repository.JobTypeComments,
+		},
+		RateLimits: RateLimits{
+			RequestsPerMinute: 10,
+			RequestsPerHour:   100,
+			RequestsPerDay:    1000,
+		},
+		// Public pages are quick to block scrapers, so back off further
+		RetryPolicy: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 2 * time.Minute,
+			MaxBackoff:     time.Hour,
+			Multiplier:     3,
+			Jitter:         0.3,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewLinkedInAPI(fetcher)
+	})
+}
+
+// LinkedInAPI scrapes the structured data embedded in LinkedIn's public company and post pages
+type LinkedInAPI struct {
+	fetcher *Fetcher
+}
+
+// NewLinkedInAPI creates a new LinkedInAPI
+func NewLinkedInAPI(fetcher *Fetcher) *LinkedInAPI {
+	return &LinkedInAPI{fetcher: fetcher}
+}
+
+// linkedInActivityPattern extracts the activity ID from a post URL
+var linkedInActivityPattern = regexp.MustCompile(`activity[:-](\d+)`)
+
+// linkedInNode is a schema.org node from a page's JSON-LD
+type linkedInNode struct {
+	Type                 string          `json:"@type"`
+	Name                 string          `json:"name"`
+	URL                  string          `json:"url"`
+	Description          string          `json:"description"`
+	Text                 string          `json:"text"`
+	ArticleBody          string          `json:"articleBody"`
+	DatePublished        time.Time       `json:"datePublished"`
+	Image                json.RawMessage `json:"image"`
+	Video                json.RawMessage `json:"video"`
+	InteractionStatistic json.RawMessage `json:"interactionStatistic"`
+	Comment              []linkedInNode  `json:"comment"`
+	Author               struct {
+		Name string `json:"name"`
+		URL  string `json:"url"`
+	} `json:"author"`
+}
+
+// interactions returns the interaction counters of a node keyed by action, e.g. "LikeAction"
+func (n linkedInNode) interactions() map[string]int {
+	var stats []struct {
+		InteractionType      string `json:"interactionType"`
+		UserInteractionCount int    `json:"userInteractionCount"`
+	}
+
+	// The statistic is either a single counter or a list of them
+	raw := n.InteractionStatistic
+	if len(raw) > 0 && raw[0] == '{' {
+		raw = append(append([]byte{'['}, raw...), ']')
+	}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &stats)
+	}
+
+	counts := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		action := stat.InteractionType[strings.LastIndex(stat.InteractionType, "/")+1:]
+		counts[action] = stat.UserInteractionCount
+	}
+	return counts
+}
+
+// toPost converts a DiscussionForumPosting node to a Post
+func (n linkedInNode) toPost() Post {
+	counts := n.interactions()
+
+	post := Post{
+		ID:          linkedInPostID(n.URL),
+		URL:         n.URL,
+		Text:        n.ArticleBody,
+		ContentType: "text",
+		PostedAt:    n.DatePublished,
+		Likes:       counts["LikeAction"],
+		Shares:      counts["ShareAction"],
+		Comments:    counts["CommentAction"],
+		Views:       counts["WatchAction"],
+	}
+	if post.Text == "" {
+		post.Text = n.Text
+	}
+	if len(n.Video) > 0 {
+		post.ContentType = "video"
+	} else if len(n.Image) > 0 {
+		post.ContentType = "image"
+	}
+
+	return post
+}
+
+// linkedInPostID returns the activity ID of a post URL, or the URL itself if it has none
+func linkedInPostID(postURL string) string {
+	if match := linkedInActivityPattern.FindStringSubmatch(postURL); match != nil {
+		return match[1]
+	}
+	return postURL
+}
+
+// fetchNodes loads a page and returns the schema.org nodes it embeds
+func (a *LinkedInAPI) fetchNodes(ctx context.Context, path string) ([]linkedInNode, error) {
+	page, err := a.fetcher.Get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []linkedInNode
+	for _, script := range extractScripts(page, `type="application/ld+json"`) {
+		var doc struct {
+			linkedInNode
+			Graph []linkedInNode `json:"@graph"`
+		}
+		if err := json.Unmarshal(script, &doc); err != nil {
+			return nil, fmt.Errorf("failed to parse structured data from %s: %w", path, err)
+		}
+
+		if len(doc.Graph) > 0 {
+			nodes = append(nodes, doc.Graph...)
+		} else {
+			nodes = append(nodes, doc.linkedInNode)
+		}
+	}
+
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no structured data found on %s", path)
+	}
+
+	return nodes, nil
+}
+
+// findPost returns the post node on a post page
+func (a *LinkedInAPI) findPost(ctx context.Context, postID string) (*linkedInNode, error) {
+	nodes, err := a.fetchNodes(ctx, "/feed/update/urn:li:activity:"+url.PathEscape(postID)+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, node := range nodes {
+		if node.Type == "DiscussionForumPosting" || node.Type == "SocialMediaPosting" {
+			return &nodes[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("linkedin post %s: %w", postID, ErrTargetNotFound)
+}
+
+// GetProfile returns the public profile of a company page
+func (a *LinkedInAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	nodes, err := a.fetchNodes(ctx, "/company/"+url.PathEscape(targetID)+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range nodes {
+		if node.Type != "Organization" {
+			continue
+		}
+		return &Profile{
+			ID:          targetID,
+			Username:    targetID,
+			DisplayName: node.Name,
+			Bio:         node.Description,
+			URL:         node.URL,
+			Followers:   node.interactions()["FollowAction"],
+		}, nil
+	}
+
+	return nil, fmt.Errorf("linkedin company %s: %w", targetID, ErrTargetNotFound)
+}
+
+// GetPosts returns the posts shown on a company page
+func (a *LinkedInAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	nodes, err := a.fetchNodes(ctx, "/company/"+url.PathEscape(targetID)+"/")
+	if err != nil {
+		return nil, err
+	}
+
+	var posts []Post
+	for _, node := range nodes {
+		if len(posts) == count {
+			break
+		}
+		if node.Type == "DiscussionForumPosting" || node.Type == "SocialMediaPosting" {
+			posts = append(posts, node.toPost())
+		}
+	}
+
+	return posts, nil
+}
+
+// GetPostsPage returns the posts shown on a company page. The page only shows recent posts
+// and older ones can't be paged to without signing in, so there is never a next page.
+func (a *LinkedInAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	if cursor != "" {
+		return nil, "", nil
+	}
+
+	posts, err := a.GetPosts(ctx, targetID, count)
+	return posts, "", err
+}
+
+// GetEngagement returns the current engagement counters of a post
+func (a *LinkedInAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	node, err := a.findPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	post := node.toPost()
+	return &Engagement{
+		PostID:   postID,
+		Likes:    post.Likes,
+		Shares:   post.Shares,
+		Comments: post.Comments,
+		Views:    post.Views,
+	}, nil
+}
+
+// GetFollowers is not supported; LinkedIn doesn't list followers publicly
+func (a *LinkedInAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	return nil, ErrNotSupported
+}
+
+// GetComments returns the comments shown on a post page
+func (a *LinkedInAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	node, err := a.findPost(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []Comment
+	for i, c := range node.Comment {
+		if len(comments) == count {
+			break
+		}
+
+		// Public pages don't expose comment IDs, so derive a stable one from the position
+		comments = append(comments, Comment{
+			ID:         fmt.Sprintf("%s-%d", postID, i+1),
+			PostID:     postID,
+			AuthorID:   c.Author.URL,
+			AuthorName: c.Author.Name,
+			Text:       c.Text,
+			Likes:      c.interactions()["LikeAction"],
+			PostedAt:   c.DatePublished,
+		})
+	}
+
+	return comments, nil
+}
+
+// SearchPosts is not supported; LinkedIn search requires a signed-in session
+func (a *LinkedInAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHashtagPosts is not supported; LinkedIn hashtag feeds require a signed-in session
+func (a *LinkedInAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
+
+// GetMentions is not supported; LinkedIn doesn't list mentions publicly
+func (a *LinkedInAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
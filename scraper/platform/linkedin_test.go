@@ -0,0 +1,48 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestLinkedInFixtures(t *testing.T) {
+	launch := platform.Post{
+		ID:   "7203456789012345678",
+		URL:  "https://www.linkedin.com/posts/acme-outdoor_summer-collection-activity-7203456789012345678-AbCd",
+		Text: "We're proud to launch our summer collection, made from 80% recycled materials.", ContentType: "image",
+		PostedAt: at(2024, 6, 3, 14, 0, 0), Likes: 318, Shares: 11, Comments: 24,
+	}
+	hiring := platform.Post{
+		ID:   "7201234567890123456",
+		URL:  "https://www.linkedin.com/posts/acme-outdoor_hiring-activity-7201234567890123456-EfGh",
+		Text: "We're hiring product designers in Denver.", ContentType: "text",
+		PostedAt: at(2024, 5, 28, 9, 0, 0), Likes: 95, Comments: 7,
+	}
+
+	runFixtureCases(t, "linkedin", []fixtureCase{
+		{
+			name: "profile",
+			call: getProfile("acme-outdoor"),
+			want: &platform.Profile{
+				ID: "acme-outdoor", Username: "acme-outdoor", DisplayName: "Acme Outdoor Co.", Bio: "Gear for every trail.",
+				URL: "https://www.linkedin.com/company/acme-outdoor", Followers: 12480,
+			},
+		},
+		{
+			name: "posts",
+			call: getPosts("acme-outdoor", 10),
+			want: []platform.Post{launch, hiring},
+		},
+		{
+			name: "posts page",
+			call: getPostsPage("acme-outdoor", ""),
+			want: postsPage{Posts: []platform.Post{launch, hiring}},
+		},
+		{
+			name: "engagement",
+			call: getEngagement("acme-outdoor", "7203456789012345678"),
+			want: &platform.Engagement{PostID: "7203456789012345678", Likes: 341, Shares: 13, Comments: 26},
+		},
+	})
+}
@@ -0,0 +1,49 @@
+package platform
+
+import (
+	"os"
+	"strings"
+)
+
+// Config contains the base URLs, credentials and metadata overrides used to reach each platform
+type Config struct {
+	BaseURLs            map[string]string
+	TwitterBearerToken  string
+	FacebookAccessToken string
+	Overrides           map[string]Override // Usually loaded with LoadOverrides
+}
+
+// ConfigFromEnv builds a Config from the environment.
+// A platform's base URL can be overridden with <PLATFORM>_BASE_URL, e.g. INSTAGRAM_BASE_URL.
+func ConfigFromEnv() Config {
+	cfg := Config{
+		BaseURLs:            make(map[string]string, len(registrations)),
+		TwitterBearerToken:  os.Getenv("TWITTER_BEARER_TOKEN"),
+		FacebookAccessToken: os.Getenv("FACEBOOK_ACCESS_TOKEN"),
+	}
+
+	for name := range registrations {
+		if override := os.Getenv(strings.ToUpper(name) + "_BASE_URL"); override != "" {
+			cfg.BaseURLs[name] = override
+		}
+	}
+
+	return cfg
+}
+
+// baseURL returns the configured base URL for a platform, falling back to its registered default
+func (c Config) baseURL(name string) string {
+	if baseURL := c.BaseURLs[name]; baseURL != "" {
+		return baseURL
+	}
+	return registrations[name].info.BaseURL
+}
+
+// NewAPIs creates the API of every registered platform, ignoring overrides
+func NewAPIs(cfg Config) map[string]API {
+	apis := make(map[string]API, len(registrations))
+	for name, reg := range registrations {
+		apis[name] = reg.factory(NewFetcher(cfg.baseURL(name)), cfg)
+	}
+	return apis
+}
@@ -0,0 +1,247 @@
+package platform
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// Info describes a platform: how to present it, what it can scrape and how hard it can be hit
+type Info struct {
+	Name              string
+	DisplayName       string
+	Description       string
+	BaseURL           string // Public endpoint the platform is scraped from
+	SupportedJobTypes []repository.JobType
+	RateLimits        RateLimits
+	RetryPolicy       RetryPolicy // Zero fields fall back to the scraper's default policy
+}
+
+// RateLimits is the request budget of a platform. Windows with a zero limit are not enforced.
+type RateLimits struct {
+	RequestsPerMinute int `json:"requests_per_minute"`
+	RequestsPerHour   int `json:"requests_per_hour"`
+	RequestsPerDay    int `json:"requests_per_day"`
+}
+
+// RetryPolicy controls how often and how quickly a platform's failed jobs are retried
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64 // Fraction of the backoff that is randomized, between 0 and 1
+}
+
+// Factory creates the API of a platform, fetching from the given base URL
+type Factory func(fetcher *Fetcher, cfg Config) API
+
+// registration is a platform added with Register
+type registration struct {
+	info    Info
+	factory Factory
+}
+
+// Platforms registered by the init functions of their scrapers
+var registrations = make(map[string]registration)
+
+// Register adds a platform to the registry. Each platform's scraper registers itself from an init
+// function, so adding a platform only takes a new file. Registering a name twice panics.
+func Register(info Info, factory Factory) {
+	if _, exists := registrations[info.Name]; exists {
+		panic("platform: " + info.Name + " is registered twice")
+	}
+	registrations[info.Name] = registration{info: info, factory: factory}
+}
+
+// Registered returns the built-in metadata of every registered platform, sorted by name
+func Registered() []Info {
+	platforms := make([]Info, 0, len(registrations))
+	for _, reg := range registrations {
+		platforms = append(platforms, reg.info)
+	}
+
+	sort.Slice(platforms, func(i, j int) bool {
+		return platforms[i].Name < platforms[j].Name
+	})
+
+	return platforms
+}
+
+// Override changes the metadata of a registered platform. Fields left empty or zero keep the registered value.
+type Override struct {
+	Disabled          bool                 `json:"disabled"` // Leaves the platform out of the registry
+	DisplayName       string               `json:"display_name"`
+	Description       string               `json:"description"`
+	BaseURL           string               `json:"base_url"`
+	SupportedJobTypes []repository.JobType `json:"supported_job_types"` // Can only narrow what the scraper supports
+	RateLimits        RateLimits           `json:"rate_limits"`
+	RetryPolicy       RetryOverride        `json:"retry_policy"`
+}
+
+// RetryOverride changes a platform's retry policy. Backoffs are Go durations such as "2m".
+type RetryOverride struct {
+	MaxAttempts    int     `json:"max_attempts"`
+	InitialBackoff string  `json:"initial_backoff"`
+	MaxBackoff     string  `json:"max_backoff"`
+	Multiplier     float64 `json:"multiplier"`
+	Jitter         float64 `json:"jitter"`
+}
+
+// LoadOverrides reads platform overrides from a JSON file of the form {"platforms": {"<name>": {...}}}
+func LoadOverrides(path string) (map[string]Override, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read platform config: %w", err)
+	}
+
+	var file struct {
+		Platforms map[string]Override `json:"platforms"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse platform config %s: %w", path, err)
+	}
+
+	return file.Platforms, nil
+}
+
+// apply returns the platform metadata with the override applied
+func (o Override) apply(info Info) (Info, error) {
+	if o.DisplayName != "" {
+		info.DisplayName = o.DisplayName
+	}
+	if o.Description != "" {
+		info.Description = o.Description
+	}
+	if o.BaseURL != "" {
+		info.BaseURL = o.BaseURL
+	}
+
+	if len(o.SupportedJobTypes) > 0 {
+		for _, jobType := range o.SupportedJobTypes {
+			if !slices.Contains(info.SupportedJobTypes, jobType) {
+				return info, fmt.Errorf("%s jobs are not supported by the %s scraper", jobType.String(), info.Name)
+			}
+		}
+		info.SupportedJobTypes = o.SupportedJobTypes
+	}
+
+	limits := o.RateLimits
+	if limits.RequestsPerMinute < 0 || limits.RequestsPerHour < 0 || limits.RequestsPerDay < 0 {
+		return info, fmt.Errorf("rate limits of %s can't be negative", info.Name)
+	}
+	if limits.RequestsPerMinute > 0 {
+		info.RateLimits.RequestsPerMinute = limits.RequestsPerMinute
+	}
+	if limits.RequestsPerHour > 0 {
+		info.RateLimits.RequestsPerHour = limits.RequestsPerHour
+	}
+	if limits.RequestsPerDay > 0 {
+		info.RateLimits.RequestsPerDay = limits.RequestsPerDay
+	}
+
+	retry := o.RetryPolicy
+	if retry.MaxAttempts < 0 || retry.Multiplier < 0 || retry.Jitter < 0 || retry.Jitter > 1 {
+		return info, fmt.Errorf("invalid retry policy for %s", info.Name)
+	}
+	if retry.MaxAttempts > 0 {
+		info.RetryPolicy.MaxAttempts = retry.MaxAttempts
+	}
+	if retry.InitialBackoff != "" {
+		backoff, err := time.ParseDuration(retry.InitialBackoff)
+		if err != nil || backoff <= 0 {
+			return info, fmt.Errorf("initial_backoff of %s must be a positive duration: %s", info.Name, retry.InitialBackoff)
+		}
+		info.RetryPolicy.InitialBackoff = backoff
+	}
+	if retry.MaxBackoff != "" {
+		backoff, err := time.ParseDuration(retry.MaxBackoff)
+		if err != nil || backoff <= 0 {
+			return info, fmt.Errorf("max_backoff of %s must be a positive duration: %s", info.Name, retry.MaxBackoff)
+		}
+		info.RetryPolicy.MaxBackoff = backoff
+	}
+	if retry.Multiplier > 0 {
+		info.RetryPolicy.Multiplier = retry.Multiplier
+	}
+	if retry.Jitter > 0 {
+		info.RetryPolicy.Jitter = retry.Jitter
+	}
+
+	return info, nil
+}
+
+// Registry holds the platforms the scraper runs with: the registered platforms that aren't
+// disabled, with their config overrides applied, and their APIs
+type Registry struct {
+	platforms map[string]Info
+	apis      map[string]API
+}
+
+// NewRegistry creates the API of every registered platform that isn't disabled. Overrides for
+// platforms that aren't registered are rejected, so a typo in the config file doesn't go unnoticed.
+func NewRegistry(cfg Config) (*Registry, error) {
+	for name := range cfg.Overrides {
+		if _, exists := registrations[name]; !exists {
+			return nil, fmt.Errorf("platform config overrides unknown platform: %s", name)
+		}
+	}
+
+	r := &Registry{
+		platforms: make(map[string]Info, len(registrations)),
+		apis:      make(map[string]API, len(registrations)),
+	}
+
+	for name, reg := range registrations {
+		override := cfg.Overrides[name]
+		if override.Disabled {
+			continue
+		}
+
+		info, err := override.apply(reg.info)
+		if err != nil {
+			return nil, err
+		}
+
+		// Base URLs from the environment win over the config file
+		if baseURL := cfg.BaseURLs[name]; baseURL != "" {
+			info.BaseURL = baseURL
+		}
+
+		r.platforms[name] = info
+		r.apis[name] = reg.factory(NewFetcher(info.BaseURL), cfg)
+	}
+
+	return r, nil
+}
+
+// Platforms returns the metadata of every platform in the registry, sorted by name
+func (r *Registry) Platforms() []Info {
+	platforms := make([]Info, 0, len(r.platforms))
+	for _, info := range r.platforms {
+		platforms = append(platforms, info)
+	}
+
+	sort.Slice(platforms, func(i, j int) bool {
+		return platforms[i].Name < platforms[j].Name
+	})
+
+	return platforms
+}
+
+// Lookup returns the metadata of a platform in the registry
+func (r *Registry) Lookup(name string) (Info, bool) {
+	info, exists := r.platforms[name]
+	return info, exists
+}
+
+// API returns the API of a platform in the registry
+func (r *Registry) API(name string) (API, bool) {
+	api, exists := r.apis[name]
+	return api, exists
+}
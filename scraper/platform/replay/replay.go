@@ -0,0 +1,335 @@
+// Package replay serves recorded platform responses over HTTP so the platform
+// scrapers can be exercised offline, and records new fixtures when a platform changes.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+// manifestFile is the name of the fixture manifest in each platform directory
+const manifestFile = "fixtures.json"
+
+// secretParams are query parameters that are never written to a fixture
+var secretParams = map[string]bool{"access_token": true}
+
+// unsafeChars matches characters that aren't allowed in fixture file names
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+// Fixture is a recorded response to a request
+type Fixture struct {
+	Path        string            `json:"path"`
+	Query       map[string]string `json:"query,omitempty"`
+	Status      int               `json:"status,omitempty"`
+	ContentType string            `json:"content_type,omitempty"`
+	File        string            `json:"file"`
+}
+
+// matches reports whether the fixture was recorded for the request
+func (f Fixture) matches(path string, query map[string][]string) bool {
+	if f.Path != path {
+		return false
+	}
+	for key, value := range f.Query {
+		if values := query[key]; len(values) == 0 || values[0] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Server is an httptest.Server serving the fixtures of every platform under a directory.
+// Requests for a platform are served under /<platform>, e.g. /instagram/api/v1/...
+type Server struct {
+	*httptest.Server
+
+	dir      string
+	upstream platform.Config
+	client   *http.Client
+
+	mu       sync.Mutex
+	fixtures map[string][]Fixture
+}
+
+// NewServer starts a server replaying the fixtures recorded under dir.
+// Each platform has its own subdirectory containing a fixtures.json manifest.
+func NewServer(dir string) (*Server, error) {
+	s, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.replay))
+	return s, nil
+}
+
+// NewRecorder starts a server that forwards requests to the upstream platforms and saves
+// each response as a fixture under dir, replacing any earlier recording of the same request.
+func NewRecorder(dir string, upstream platform.Config) (*Server, error) {
+	s, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	s.upstream = upstream
+	s.client = &http.Client{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.record))
+	return s, nil
+}
+
+// load reads the fixture manifests of every platform under dir
+func load(dir string) (*Server, error) {
+	s := &Server{
+		dir:      dir,
+		fixtures: make(map[string][]Fixture),
+	}
+
+	for _, info := range platform.Registered() {
+		fixtures, err := loadManifest(filepath.Join(dir, info.Name))
+		if err != nil {
+			return nil, err
+		}
+		s.fixtures[info.Name] = fixtures
+	}
+
+	return s, nil
+}
+
+// PlatformConfig returns a platform configuration that points every platform at the server.
+// Credentials are copied from the given configuration so a recorder can authenticate upstream.
+func (s *Server) PlatformConfig(credentials platform.Config) platform.Config {
+	cfg := credentials
+	registered := platform.Registered()
+	cfg.BaseURLs = make(map[string]string, len(registered))
+	for _, info := range registered {
+		cfg.BaseURLs[info.Name] = s.URL + "/" + info.Name
+	}
+	return cfg
+}
+
+// APIs returns platform APIs that read from the server
+func (s *Server) APIs() map[string]platform.API {
+	return platform.NewAPIs(s.PlatformConfig(platform.Config{}))
+}
+
+// registeredBaseURL returns the public endpoint a registered platform is scraped from
+func registeredBaseURL(name string) (string, bool) {
+	for _, info := range platform.Registered() {
+		if info.Name == name {
+			return info.BaseURL, true
+		}
+	}
+	return "", false
+}
+
+// splitPath separates the platform name from the path of a request
+func splitPath(r *http.Request) (string, string) {
+	name, path, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	return name, "/" + path
+}
+
+// replay serves the best matching fixture for a request
+func (s *Server) replay(w http.ResponseWriter, r *http.Request) {
+	name, path := splitPath(r)
+	query := r.URL.Query()
+
+	s.mu.Lock()
+	var match *Fixture
+	for i, f := range s.fixtures[name] {
+		if f.matches(path, query) && (match == nil || len(f.Query) > len(match.Query)) {
+			match = &s.fixtures[name][i]
+		}
+	}
+	s.mu.Unlock()
+
+	if match == nil {
+		http.Error(w, fmt.Sprintf("no %s fixture recorded for %s", name, r.URL.RequestURI()), http.StatusNotImplemented)
+		return
+	}
+
+	body, err := os.ReadFile(filepath.Join(s.dir, name, match.File))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if match.ContentType != "" {
+		w.Header().Set("Content-Type", match.ContentType)
+	}
+	status := match.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// record forwards a request upstream, saves the response as a fixture and passes it on
+func (s *Server) record(w http.ResponseWriter, r *http.Request) {
+	name, path := splitPath(r)
+	baseURL, exists := s.upstream.BaseURLs[name]
+	if !exists {
+		baseURL, exists = registeredBaseURL(name)
+	}
+	if !exists {
+		http.Error(w, "unknown platform: "+name, http.StatusNotFound)
+		return
+	}
+
+	// Platforms without a base URL, such as feeds, are fetched from the URL they are tracked by,
+	// whose host leads the path
+	upstreamURL := strings.TrimRight(baseURL, "/") + path
+	if baseURL == "" {
+		upstreamURL = "https:/" + path
+	}
+
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, upstreamURL+"?"+r.URL.RawQuery, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.Header = r.Header.Clone()
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	fixture := Fixture{
+		Path:        path,
+		Query:       make(map[string]string),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	for key, values := range r.URL.Query() {
+		if !secretParams[key] && len(values) > 0 {
+			fixture.Query[key] = values[0]
+		}
+	}
+
+	if err := s.save(name, fixture, body); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if fixture.ContentType != "" {
+		w.Header().Set("Content-Type", fixture.ContentType)
+	}
+	w.WriteHeader(resp.StatusCode)
+	w.Write(body)
+}
+
+// save writes a recorded response and updates the platform's manifest
+func (s *Server) save(name string, fixture Fixture, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	platformDir := filepath.Join(s.dir, name)
+	if err := os.MkdirAll(platformDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+
+	ext := ".json"
+	switch {
+	case strings.Contains(fixture.ContentType, "html"):
+		ext = ".html"
+	case strings.Contains(fixture.ContentType, "xml"):
+		ext = ".xml"
+	}
+	fixture.File = strings.Trim(unsafeChars.ReplaceAllString(fixture.Path, "_"), "_") + ext
+
+	// Replace an earlier recording of the same request, or add a new one
+	fixtures := s.fixtures[name]
+	replaced := false
+	for i, f := range fixtures {
+		if f.Path == fixture.Path && equalQuery(f.Query, fixture.Query) {
+			fixture.File = f.File
+			fixtures[i] = fixture
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		fixture.File = uniqueFileName(fixtures, fixture.File)
+		fixtures = append(fixtures, fixture)
+	}
+	s.fixtures[name] = fixtures
+
+	if err := os.WriteFile(filepath.Join(platformDir, fixture.File), body, 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture: %w", err)
+	}
+
+	manifest, err := json.MarshalIndent(fixtures, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(platformDir, manifestFile), append(manifest, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write fixture manifest: %w", err)
+	}
+
+	return nil
+}
+
+// loadManifest reads a platform's fixture manifest. A missing manifest means no fixtures.
+func loadManifest(platformDir string) ([]Fixture, error) {
+	data, err := os.ReadFile(filepath.Join(platformDir, manifestFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture manifest: %w", err)
+	}
+
+	var fixtures []Fixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Join(platformDir, manifestFile), err)
+	}
+
+	return fixtures, nil
+}
+
+// equalQuery reports whether two recorded queries are identical
+func equalQuery(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if b[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// uniqueFileName appends a counter to a file name already used by another fixture
+func uniqueFileName(fixtures []Fixture, file string) string {
+	used := make(map[string]bool, len(fixtures))
+	for _, f := range fixtures {
+		used[f.File] = true
+	}
+
+	ext := filepath.Ext(file)
+	base := strings.TrimSuffix(file, ext)
+	for i := 2; used[file]; i++ {
+		file = fmt.Sprintf("%s_%d%s", base, i, ext)
+	}
+	return file
+}
@@ -0,0 +1,357 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func init() {
+	Register(Info{
+		Name:        "tiktok",
+		DisplayName: "TikTok",
+		Description: "Short-form video hosting service",
+		BaseURL:     "https://www.tiktok.com",
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+			repository.JobTypeEngagement,
+			repository.JobTypeComments,
+			repository.JobTypeKeyword,
+			repository.JobTypeHashtag,
+		},
+		RateLimits: RateLimits{
+			RequestsPerMinute: 15,
+			RequestsPerHour:   150,
+			RequestsPerDay:    1500,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewTikTokAPI(fetcher)
+	})
+}
+
+// TikTokAPI scrapes TikTok's public web pages and the JSON endpoints its web client uses
+type TikTokAPI struct {
+	fetcher *Fetcher
+}
+
+// NewTikTokAPI creates a new TikTokAPI
+func NewTikTokAPI(fetcher *Fetcher) *TikTokAPI {
+	return &TikTokAPI{fetcher: fetcher}
+}
+
+type tiktokUserInfo struct {
+	User struct {
+		ID        string `json:"id"`
+		UniqueID  string `json:"uniqueId"`
+		Nickname  string `json:"nickname"`
+		Signature string `json:"signature"`
+		Verified  bool   `json:"verified"`
+		SecUID    string `json:"secUid"`
+	} `json:"user"`
+	Stats struct {
+		FollowerCount  int `json:"followerCount"`
+		FollowingCount int `json:"followingCount"`
+		VideoCount     int `json:"videoCount"`
+	} `json:"stats"`
+}
+
+type tiktokItem struct {
+	ID         string `json:"id"`
+	Desc       string `json:"desc"`
+	CreateTime int64  `json:"createTime"`
+	Author     struct {
+		ID       string `json:"id"`
+		UniqueID string `json:"uniqueId"`
+	} `json:"author"`
+	Video struct {
+		Duration float64 `json:"duration"`
+		Cover    string  `json:"cover"`
+	} `json:"video"`
+	Stats struct {
+		DiggCount    int `json:"diggCount"`
+		ShareCount   int `json:"shareCount"`
+		CommentCount int `json:"commentCount"`
+		PlayCount    int `json:"playCount"`
+	} `json:"stats"`
+}
+
+// fetchUser loads the user data embedded in a profile page
+func (a *TikTokAPI) fetchUser(ctx context.Context, username string) (*tiktokUserInfo, error) {
+	path := "/@" + url.PathEscape(username)
+
+	page, err := a.fetcher.Get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	scripts := extractScripts(page, `id="__UNIVERSAL_DATA_FOR_REHYDRATION__"`)
+	if len(scripts) == 0 {
+		return nil, fmt.Errorf("no embedded data found on %s", path)
+	}
+
+	var data struct {
+		DefaultScope struct {
+			UserDetail struct {
+				UserInfo *tiktokUserInfo `json:"userInfo"`
+			} `json:"webapp.user-detail"`
+		} `json:"__DEFAULT_SCOPE__"`
+	}
+	if err := json.Unmarshal(scripts[0], &data); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded data from %s: %w", path, err)
+	}
+
+	info := data.DefaultScope.UserDetail.UserInfo
+	if info == nil || info.User.ID == "" {
+		return nil, fmt.Errorf("tiktok user %s: %w", username, ErrTargetNotFound)
+	}
+
+	return info, nil
+}
+
+// toPost converts a video item to a Post
+func (item tiktokItem) toPost() Post {
+	return Post{
+		ID:          item.ID,
+		AuthorID:    item.Author.ID,
+		AuthorName:  item.Author.UniqueID,
+		URL:         "https://www.tiktok.com/@" + item.Author.UniqueID + "/video/" + item.ID,
+		Text:        item.Desc,
+		ContentType: "video",
+		MediaURL:    item.Video.Cover,
+		Duration:    item.Video.Duration,
+		PostedAt:    time.Unix(item.CreateTime, 0).UTC(),
+		Likes:       item.Stats.DiggCount,
+		Shares:      item.Stats.ShareCount,
+		Comments:    item.Stats.CommentCount,
+		Views:       item.Stats.PlayCount,
+	}
+}
+
+// GetProfile returns the public profile of an account
+func (a *TikTokAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	info, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		ID:          info.User.ID,
+		Username:    info.User.UniqueID,
+		DisplayName: info.User.Nickname,
+		Bio:         info.User.Signature,
+		URL:         "https://www.tiktok.com/@" + info.User.UniqueID,
+		Verified:    info.User.Verified,
+		Followers:   info.Stats.FollowerCount,
+		Following:   info.Stats.FollowingCount,
+		PostCount:   info.Stats.VideoCount,
+	}, nil
+}
+
+// GetPosts returns the most recent videos of an account
+func (a *TikTokAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	posts, _, err := a.GetPostsPage(ctx, targetID, "", count)
+	return posts, err
+}
+
+// GetPostsPage returns a page of an account's videos, paged by the item list's cursor
+func (a *TikTokAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	// The item list is keyed by the account's secUid, which is only exposed on the profile page
+	info, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var resp struct {
+		Cursor   string       `json:"cursor"`
+		HasMore  bool         `json:"hasMore"`
+		ItemList []tiktokItem `json:"itemList"`
+	}
+
+	if cursor == "" {
+		cursor = "0"
+	}
+
+	query := url.Values{
+		"secUid": {info.User.SecUID},
+		"count":  {strconv.Itoa(count)},
+		"cursor": {cursor},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/post/item_list/", query, &resp); err != nil {
+		return nil, "", err
+	}
+
+	var posts []Post
+	for _, item := range resp.ItemList {
+		if len(posts) == count {
+			break
+		}
+		posts = append(posts, item.toPost())
+	}
+
+	next := ""
+	if resp.HasMore {
+		next = resp.Cursor
+	}
+
+	return posts, next, nil
+}
+
+// GetEngagement returns the current engagement counters of a video
+func (a *TikTokAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	var resp struct {
+		ItemInfo struct {
+			ItemStruct *tiktokItem `json:"itemStruct"`
+		} `json:"itemInfo"`
+	}
+
+	if err := a.fetcher.GetJSON(ctx, "/api/item/detail/", url.Values{"itemId": {postID}}, &resp); err != nil {
+		return nil, err
+	}
+	if resp.ItemInfo.ItemStruct == nil {
+		return nil, fmt.Errorf("tiktok video %s: %w", postID, ErrTargetNotFound)
+	}
+
+	stats := resp.ItemInfo.ItemStruct.Stats
+	return &Engagement{
+		PostID:   postID,
+		Likes:    stats.DiggCount,
+		Shares:   stats.ShareCount,
+		Comments: stats.CommentCount,
+		Views:    stats.PlayCount,
+	}, nil
+}
+
+// GetFollowers is not supported; TikTok doesn't list followers publicly
+func (a *TikTokAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	return nil, ErrNotSupported
+}
+
+// GetComments returns comments on a video
+func (a *TikTokAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	var resp struct {
+		Comments []struct {
+			CID        string `json:"cid"`
+			Text       string `json:"text"`
+			CreateTime int64  `json:"create_time"`
+			DiggCount  int    `json:"digg_count"`
+			ReplyID    string `json:"reply_id"`
+			User       struct {
+				UID      string `json:"uid"`
+				UniqueID string `json:"unique_id"`
+			} `json:"user"`
+		} `json:"comments"`
+	}
+
+	query := url.Values{
+		"aweme_id": {postID},
+		"count":    {strconv.Itoa(count)},
+		"cursor":   {"0"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/comment/list/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	var comments []Comment
+	for _, c := range resp.Comments {
+		if len(comments) == count {
+			break
+		}
+
+		parentID := c.ReplyID
+		if parentID == "0" {
+			parentID = ""
+		}
+
+		comments = append(comments, Comment{
+			ID:         c.CID,
+			PostID:     postID,
+			ParentID:   parentID,
+			AuthorID:   c.User.UID,
+			AuthorName: c.User.UniqueID,
+			Text:       c.Text,
+			Likes:      c.DiggCount,
+			PostedAt:   time.Unix(c.CreateTime, 0).UTC(),
+		})
+	}
+
+	return comments, nil
+}
+
+// itemPosts converts video items to posts
+func itemPosts(items []tiktokItem, count int) []Post {
+	var posts []Post
+	for _, item := range items {
+		if len(posts) == count {
+			break
+		}
+		posts = append(posts, item.toPost())
+	}
+	return posts
+}
+
+// SearchPosts returns videos matching a keyword
+func (a *TikTokAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	var resp struct {
+		ItemList []tiktokItem `json:"item_list"`
+	}
+
+	query := url.Values{
+		"keyword": {keyword},
+		"count":   {strconv.Itoa(count)},
+		"cursor":  {"0"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/search/item/full/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return itemPosts(resp.ItemList, count), nil
+}
+
+// GetHashtagPosts returns recent videos using a hashtag
+func (a *TikTokAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	// The item list is keyed by the challenge ID, so resolve the hashtag first
+	name := strings.TrimPrefix(hashtag, "#")
+
+	var detail struct {
+		ChallengeInfo struct {
+			Challenge struct {
+				ID string `json:"id"`
+			} `json:"challenge"`
+		} `json:"challengeInfo"`
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/challenge/detail/", url.Values{"challengeName": {name}}, &detail); err != nil {
+		return nil, err
+	}
+
+	challengeID := detail.ChallengeInfo.Challenge.ID
+	if challengeID == "" {
+		return nil, fmt.Errorf("tiktok hashtag %s: %w", name, ErrTargetNotFound)
+	}
+
+	var resp struct {
+		ItemList []tiktokItem `json:"itemList"`
+	}
+
+	query := url.Values{
+		"challengeID": {challengeID},
+		"count":       {strconv.Itoa(count)},
+		"cursor":      {"0"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/api/challenge/item_list/", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return itemPosts(resp.ItemList, count), nil
+}
+
+// GetMentions is not supported; TikTok doesn't list videos mentioning an account publicly
+func (a *TikTokAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	return nil, ErrNotSupported
+}
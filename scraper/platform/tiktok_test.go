@@ -0,0 +1,84 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestTikTokFixtures(t *testing.T) {
+	trek := platform.Post{
+		ID: "7376543210987654321", AuthorID: "6812345678901234567", AuthorName: "acme",
+		URL: "https://www.tiktok.com/@acme/video/7376543210987654321", Text: "Packing for a 3-day trek #hiking #acmeoutdoors",
+		ContentType: "video", MediaURL: "https://p16-sign.tiktokcdn.com/obj/acme-trek.jpeg", Duration: 34,
+		PostedAt: at(2024, 6, 2, 12, 0, 0), Likes: 12840, Shares: 402, Comments: 311, Views: 204511,
+	}
+	rain := platform.Post{
+		ID: "7375123456789012345", AuthorID: "6812345678901234567", AuthorName: "acme",
+		URL: "https://www.tiktok.com/@acme/video/7375123456789012345", Text: "Rain jacket vs. waterfall",
+		ContentType: "video", MediaURL: "https://p16-sign.tiktokcdn.com/obj/acme-rain.jpeg", Duration: 18,
+		PostedAt: at(2024, 5, 30, 12, 0, 0), Likes: 50211, Shares: 2301, Comments: 890, Views: 1203344,
+	}
+	carWash := platform.Post{
+		ID: "7377000000000000001", AuthorID: "6900000000000000001", AuthorName: "geartok",
+		URL: "https://www.tiktok.com/@geartok/video/7377000000000000001", Text: "Testing the Acme rain shell in a car wash #acmeoutdoors",
+		ContentType: "video", MediaURL: "https://p16-sign.tiktokcdn.com/obj/carwash.jpeg", Duration: 27,
+		PostedAt: at(2024, 6, 3, 12, 0, 0), Likes: 88120, Shares: 3012, Comments: 1450, Views: 1830022,
+	}
+
+	runFixtureCases(t, "tiktok", []fixtureCase{
+		{
+			name: "profile",
+			call: getProfile("acme"),
+			want: &platform.Profile{
+				ID: "6812345678901234567", Username: "acme", DisplayName: "Acme Outdoor Co.", Bio: "Gear for every trail",
+				URL: "https://www.tiktok.com/@acme", Verified: true, Followers: 91230, Following: 58, PostCount: 214,
+			},
+		},
+		{
+			name: "first posts page",
+			call: getPostsPage("acme", ""),
+			want: postsPage{Posts: []platform.Post{trek, rain}, Next: "1717070400000"},
+		},
+		{
+			name: "last posts page",
+			call: getPostsPage("acme", "1717070400000"),
+			want: postsPage{Posts: []platform.Post{{
+				ID: "7368765432109876543", AuthorID: "6812345678901234567", AuthorName: "acme",
+				URL: "https://www.tiktok.com/@acme/video/7368765432109876543", Text: "Spring trail guide #acmeoutdoors",
+				ContentType: "video", MediaURL: "https://p16-sign.tiktokcdn.com/obj/acme-spring.jpeg", Duration: 41,
+				PostedAt: at(2024, 5, 12, 12, 0, 0), Likes: 8120, Shares: 190, Comments: 204, Views: 130522,
+			}}},
+		},
+		{
+			name: "engagement",
+			call: getEngagement("acme", "7376543210987654321"),
+			want: &platform.Engagement{PostID: "7376543210987654321", Likes: 13502, Shares: 421, Comments: 330, Views: 219870},
+		},
+		{
+			name: "comments",
+			call: getComments("acme", "7376543210987654321"),
+			want: []platform.Comment{
+				{
+					ID: "7376600000000000001", PostID: "7376543210987654321", AuthorID: "7012345678", AuthorName: "trailrunner",
+					Text: "What backpack is that?", Likes: 41, PostedAt: at(2024, 6, 2, 13, 0, 0),
+				},
+				{
+					ID: "7376600000000000002", PostID: "7376543210987654321", ParentID: "7376600000000000001",
+					AuthorID: "6812345678901234567", AuthorName: "acme", Text: "the link is in our bio!", Likes: 6,
+					PostedAt: at(2024, 6, 2, 14, 0, 0),
+				},
+			},
+		},
+		{
+			name: "hashtag",
+			call: getHashtagPosts("acmeoutdoors"),
+			want: []platform.Post{carWash, trek},
+		},
+		{
+			name: "search",
+			call: searchPosts("acme"),
+			want: []platform.Post{carWash},
+		},
+	})
+}
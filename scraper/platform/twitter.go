@@ -0,0 +1,389 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func init() {
+	Register(Info{
+		Name:        "twitter",
+		DisplayName: "Twitter / X",
+		Description: "Short-form microblogging social network",
+		BaseURL:     "https://api.twitter.com",
+		SupportedJobTypes: []repository.JobType{
+			repository.JobTypeProfile,
+			repository.JobTypePosts,
+			repository.JobTypeEngagement,
+			repository.JobTypeComments,
+			repository.JobTypeFollowers,
+			repository.JobTypeKeyword,
+			repository.JobTypeHashtag,
+			repository.JobTypeMentions,
+		},
+		RateLimits: RateLimits{
+			RequestsPerMinute: 50,
+			RequestsPerHour:   1500,
+			RequestsPerDay:    10000,
+		},
+	}, func(fetcher *Fetcher, cfg Config) API {
+		return NewTwitterAPI(fetcher, cfg.TwitterBearerToken)
+	})
+}
+
+// TwitterAPI scrapes Twitter / X through the v2 API
+type TwitterAPI struct {
+	fetcher *Fetcher
+}
+
+// NewTwitterAPI creates a new TwitterAPI authenticated with an app bearer token
+func NewTwitterAPI(fetcher *Fetcher, bearerToken string) *TwitterAPI {
+	if bearerToken != "" {
+		fetcher.Headers.Set("Authorization", "Bearer "+bearerToken)
+	}
+	return &TwitterAPI{fetcher: fetcher}
+}
+
+type twitterUser struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Username      string `json:"username"`
+	Description   string `json:"description"`
+	Verified      bool   `json:"verified"`
+	PublicMetrics struct {
+		FollowersCount int `json:"followers_count"`
+		FollowingCount int `json:"following_count"`
+		TweetCount     int `json:"tweet_count"`
+	} `json:"public_metrics"`
+}
+
+type twitterTweet struct {
+	ID               string    `json:"id"`
+	Text             string    `json:"text"`
+	AuthorID         string    `json:"author_id"`
+	CreatedAt        time.Time `json:"created_at"`
+	ReferencedTweets []struct {
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	} `json:"referenced_tweets"`
+	PublicMetrics struct {
+		RetweetCount    int `json:"retweet_count"`
+		ReplyCount      int `json:"reply_count"`
+		LikeCount       int `json:"like_count"`
+		QuoteCount      int `json:"quote_count"`
+		ImpressionCount int `json:"impression_count"`
+	} `json:"public_metrics"`
+}
+
+const (
+	twitterUserFields  = "description,public_metrics,verified"
+	twitterTweetFields = "author_id,created_at,public_metrics,referenced_tweets"
+)
+
+// toPost converts a tweet to a post
+func (t twitterTweet) toPost() Post {
+	return Post{
+		ID:          t.ID,
+		AuthorID:    t.AuthorID,
+		URL:         "https://x.com/i/web/status/" + t.ID,
+		Text:        t.Text,
+		ContentType: "text",
+		PostedAt:    t.CreatedAt,
+		Likes:       t.PublicMetrics.LikeCount,
+		Shares:      t.PublicMetrics.RetweetCount + t.PublicMetrics.QuoteCount,
+		Comments:    t.PublicMetrics.ReplyCount,
+		Views:       t.PublicMetrics.ImpressionCount,
+	}
+}
+
+// maxResults clamps a requested count to the range accepted by the v2 API
+func maxResults(count, min, max int) string {
+	if count < min {
+		count = min
+	}
+	if count > max {
+		count = max
+	}
+	return strconv.Itoa(count)
+}
+
+// fetchUser loads a user by username
+func (a *TwitterAPI) fetchUser(ctx context.Context, username string) (*twitterUser, error) {
+	var resp struct {
+		Data *twitterUser `json:"data"`
+	}
+
+	query := url.Values{"user.fields": {twitterUserFields}}
+	if err := a.fetcher.GetJSON(ctx, "/2/users/by/username/"+url.PathEscape(username), query, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("twitter user %s: %w", username, ErrTargetNotFound)
+	}
+
+	return resp.Data, nil
+}
+
+// userID resolves a target to its numeric user ID
+func (a *TwitterAPI) userID(ctx context.Context, targetID string) (string, error) {
+	if _, err := strconv.ParseUint(targetID, 10, 64); err == nil {
+		return targetID, nil
+	}
+
+	user, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// GetProfile returns the public profile of an account
+func (a *TwitterAPI) GetProfile(ctx context.Context, targetID string) (*Profile, error) {
+	user, err := a.fetchUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Profile{
+		ID:          user.ID,
+		Username:    user.Username,
+		DisplayName: user.Name,
+		Bio:         user.Description,
+		URL:         "https://x.com/" + user.Username,
+		Verified:    user.Verified,
+		Followers:   user.PublicMetrics.FollowersCount,
+		Following:   user.PublicMetrics.FollowingCount,
+		PostCount:   user.PublicMetrics.TweetCount,
+	}, nil
+}
+
+// GetPosts returns the most recent tweets of an account
+func (a *TwitterAPI) GetPosts(ctx context.Context, targetID string, count int) ([]Post, error) {
+	posts, _, err := a.GetPostsPage(ctx, targetID, "", count)
+	return posts, err
+}
+
+// GetPostsPage returns a page of an account's tweets, paged by the timeline's pagination token
+func (a *TwitterAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error) {
+	userID, err := a.userID(ctx, targetID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var resp struct {
+		Data []twitterTweet `json:"data"`
+		Meta struct {
+			NextToken string `json:"next_token"`
+		} `json:"meta"`
+	}
+
+	query := url.Values{
+		"max_results":  {maxResults(count, 5, 100)},
+		"tweet.fields": {twitterTweetFields},
+	}
+	if cursor != "" {
+		query.Set("pagination_token", cursor)
+	}
+	if err := a.fetcher.GetJSON(ctx, "/2/users/"+userID+"/tweets", query, &resp); err != nil {
+		return nil, "", err
+	}
+
+	var posts []Post
+	for _, tweet := range resp.Data {
+		if len(posts) == count {
+			break
+		}
+		posts = append(posts, tweet.toPost())
+	}
+
+	return posts, resp.Meta.NextToken, nil
+}
+
+// GetEngagement returns the current engagement counters of a tweet
+func (a *TwitterAPI) GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error) {
+	var resp struct {
+		Data *twitterTweet `json:"data"`
+	}
+
+	query := url.Values{"tweet.fields": {twitterTweetFields}}
+	if err := a.fetcher.GetJSON(ctx, "/2/tweets/"+url.PathEscape(postID), query, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, fmt.Errorf("tweet %s: %w", postID, ErrTargetNotFound)
+	}
+
+	metrics := resp.Data.PublicMetrics
+	return &Engagement{
+		PostID:   postID,
+		Likes:    metrics.LikeCount,
+		Shares:   metrics.RetweetCount + metrics.QuoteCount,
+		Comments: metrics.ReplyCount,
+		Views:    metrics.ImpressionCount,
+	}, nil
+}
+
+// GetFollowers returns accounts following the target
+func (a *TwitterAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error) {
+	userID, err := a.userID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Data []twitterUser `json:"data"`
+	}
+
+	query := url.Values{
+		"max_results": {maxResults(count, 1, 1000)},
+		"user.fields": {twitterUserFields},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/2/users/"+userID+"/followers", query, &resp); err != nil {
+		return nil, err
+	}
+
+	followers := make([]Follower, 0, len(resp.Data))
+	for _, user := range resp.Data {
+		followers = append(followers, Follower{
+			ID:          user.ID,
+			Username:    user.Username,
+			DisplayName: user.Name,
+			Followers:   user.PublicMetrics.FollowersCount,
+		})
+	}
+
+	return followers, nil
+}
+
+// GetComments returns the replies in a tweet's conversation
+func (a *TwitterAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error) {
+	var resp struct {
+		Data     []twitterTweet `json:"data"`
+		Includes struct {
+			Users []twitterUser `json:"users"`
+		} `json:"includes"`
+	}
+
+	query := url.Values{
+		"query":        {"conversation_id:" + postID},
+		"max_results":  {maxResults(count, 10, 100)},
+		"tweet.fields": {twitterTweetFields},
+		"expansions":   {"author_id"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/2/tweets/search/recent", query, &resp); err != nil {
+		return nil, err
+	}
+
+	usernames := make(map[string]string, len(resp.Includes.Users))
+	for _, user := range resp.Includes.Users {
+		usernames[user.ID] = user.Username
+	}
+
+	var comments []Comment
+	for _, tweet := range resp.Data {
+		if len(comments) == count {
+			break
+		}
+
+		parentID := ""
+		for _, ref := range tweet.ReferencedTweets {
+			if ref.Type == "replied_to" && ref.ID != postID {
+				parentID = ref.ID
+			}
+		}
+
+		comments = append(comments, Comment{
+			ID:         tweet.ID,
+			PostID:     postID,
+			ParentID:   parentID,
+			AuthorID:   tweet.AuthorID,
+			AuthorName: usernames[tweet.AuthorID],
+			Text:       tweet.Text,
+			Likes:      tweet.PublicMetrics.LikeCount,
+			PostedAt:   tweet.CreatedAt,
+		})
+	}
+
+	return comments, nil
+}
+
+// tweetsWithAuthors is a page of tweets with their authors expanded
+type tweetsWithAuthors struct {
+	Data     []twitterTweet `json:"data"`
+	Includes struct {
+		Users []twitterUser `json:"users"`
+	} `json:"includes"`
+}
+
+// posts converts the tweets to posts attributed to their authors' usernames
+func (r tweetsWithAuthors) posts(count int) []Post {
+	usernames := make(map[string]string, len(r.Includes.Users))
+	for _, user := range r.Includes.Users {
+		usernames[user.ID] = user.Username
+	}
+
+	var posts []Post
+	for _, tweet := range r.Data {
+		if len(posts) == count {
+			break
+		}
+		post := tweet.toPost()
+		post.AuthorName = usernames[tweet.AuthorID]
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// searchRecent returns original tweets from the last seven days matching a search query
+func (a *TwitterAPI) searchRecent(ctx context.Context, search string, count int) ([]Post, error) {
+	var resp tweetsWithAuthors
+
+	query := url.Values{
+		"query":        {search + " -is:retweet"},
+		"max_results":  {maxResults(count, 10, 100)},
+		"tweet.fields": {twitterTweetFields},
+		"expansions":   {"author_id"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/2/tweets/search/recent", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.posts(count), nil
+}
+
+// SearchPosts returns recent tweets matching a keyword
+func (a *TwitterAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error) {
+	return a.searchRecent(ctx, keyword, count)
+}
+
+// GetHashtagPosts returns recent tweets using a hashtag
+func (a *TwitterAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error) {
+	return a.searchRecent(ctx, "#"+strings.TrimPrefix(hashtag, "#"), count)
+}
+
+// GetMentions returns recent tweets mentioning the account
+func (a *TwitterAPI) GetMentions(ctx context.Context, targetID string, count int) ([]Post, error) {
+	userID, err := a.userID(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp tweetsWithAuthors
+
+	query := url.Values{
+		"max_results":  {maxResults(count, 5, 100)},
+		"tweet.fields": {twitterTweetFields},
+		"expansions":   {"author_id"},
+	}
+	if err := a.fetcher.GetJSON(ctx, "/2/users/"+userID+"/mentions", query, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.posts(count), nil
+}
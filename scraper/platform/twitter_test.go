@@ -0,0 +1,117 @@
+package platform_test
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+func TestTwitterFixtures(t *testing.T) {
+	launch := platform.Post{
+		ID: "1797601234567890123", AuthorID: "2244994945", URL: "https://x.com/i/web/status/1797601234567890123",
+		Text: "Summer collection drops today https://t.co/abc123", ContentType: "text",
+		PostedAt: at(2024, 6, 3, 12, 0, 0), Likes: 380, Shares: 47, Comments: 17, Views: 25014,
+	}
+	poll := platform.Post{
+		ID: "1797201234567890123", AuthorID: "2244994945", URL: "https://x.com/i/web/status/1797201234567890123",
+		Text: "Which trail should we feature next?", ContentType: "text",
+		PostedAt: at(2024, 6, 2, 9, 30, 0), Likes: 96, Shares: 4, Comments: 54, Views: 8120,
+	}
+
+	runFixtureCases(t, "twitter", []fixtureCase{
+		{
+			name: "profile",
+			call: getProfile("acme"),
+			want: &platform.Profile{
+				ID: "2244994945", Username: "acme", DisplayName: "Acme Outdoor Co.", Bio: "Gear for every trail.",
+				URL: "https://x.com/acme", Followers: 15320, Following: 410, PostCount: 5230,
+			},
+		},
+		{
+			name: "first posts page",
+			call: getPostsPage("acme", ""),
+			want: postsPage{Posts: []platform.Post{launch, poll}, Next: "7140dibdnow9c7btw3z2"},
+		},
+		{
+			name: "last posts page",
+			call: getPostsPage("acme", "7140dibdnow9c7btw3z2"),
+			want: postsPage{Posts: []platform.Post{{
+				ID: "1790101234567890123", AuthorID: "2244994945", URL: "https://x.com/i/web/status/1790101234567890123",
+				Text: "Spring trail guide is live", ContentType: "text",
+				PostedAt: at(2024, 5, 13, 15, 0, 0), Likes: 143, Shares: 13, Comments: 8, Views: 10233,
+			}}},
+		},
+		{
+			name: "engagement",
+			call: getEngagement("acme", "1797601234567890123"),
+			want: &platform.Engagement{PostID: "1797601234567890123", Likes: 402, Shares: 51, Comments: 19, Views: 27730},
+		},
+		{
+			name: "replies",
+			call: getComments("acme", "1797601234567890123"),
+			want: []platform.Comment{
+				{
+					ID: "1797605555555555555", PostID: "1797601234567890123", AuthorID: "1402345678", AuthorName: "trailrunner",
+					Text: "@acme finally! been waiting for this", Likes: 8, PostedAt: at(2024, 6, 3, 12, 10, 0),
+				},
+				{
+					ID: "1797606666666666666", PostID: "1797601234567890123", ParentID: "1797605555555555555",
+					AuthorID: "1409876543", AuthorName: "hikerjen", Text: "@trailrunner @acme same here", Likes: 2,
+					PostedAt: at(2024, 6, 3, 12, 25, 0),
+				},
+			},
+		},
+		{
+			name: "followers",
+			call: getFollowers("acme", 10),
+			want: []platform.Follower{
+				{ID: "1402345678", Username: "trailrunner", DisplayName: "Sam Trail", Followers: 820},
+				{ID: "1409876543", Username: "hikerjen", DisplayName: "Jen Hiker", Followers: 96},
+			},
+		},
+		{
+			name: "search",
+			call: searchPosts("acme"),
+			want: []platform.Post{
+				{
+					ID: "1797701234567890001", AuthorID: "1402345678", AuthorName: "trailrunner",
+					URL:  "https://x.com/i/web/status/1797701234567890001",
+					Text: "Took the new Acme tent up Mt. Hood this weekend, zero complaints", ContentType: "text",
+					PostedAt: at(2024, 6, 3, 18, 2, 0), Likes: 61, Shares: 5, Comments: 2, Views: 5120,
+				},
+				{
+					ID: "1797709876543210002", AuthorID: "1411122233", AuthorName: "packlight",
+					URL:  "https://x.com/i/web/status/1797709876543210002",
+					Text: "Is Acme or Northpeak better for ultralight packs?", ContentType: "text",
+					PostedAt: at(2024, 6, 3, 20, 40, 0), Likes: 9, Comments: 14, Views: 2210,
+				},
+			},
+		},
+		{
+			name: "hashtag",
+			call: getHashtagPosts("acmeoutdoors"),
+			want: []platform.Post{{
+				ID: "1797712345678900003", AuthorID: "1409876543", AuthorName: "hikerjen",
+				URL: "https://x.com/i/web/status/1797712345678900003", Text: "Sunrise from camp #acmeoutdoors", ContentType: "text",
+				PostedAt: at(2024, 6, 4, 5, 31, 0), Likes: 188, Shares: 14, Comments: 3, Views: 14302,
+			}},
+		},
+		{
+			name: "mentions",
+			call: getMentions("acme"),
+			want: []platform.Post{
+				{
+					ID: "1797605555555555555", AuthorID: "1402345678", AuthorName: "trailrunner",
+					URL: "https://x.com/i/web/status/1797605555555555555", Text: "@acme finally! been waiting for this", ContentType: "text",
+					PostedAt: at(2024, 6, 3, 12, 10, 0), Likes: 8, Comments: 1, Views: 640,
+				},
+				{
+					ID: "1797720000000000004", AuthorID: "1411122233", AuthorName: "packlight",
+					URL:  "https://x.com/i/web/status/1797720000000000004",
+					Text: "Shoutout to @acme support for replacing my zipper in two days", ContentType: "text",
+					PostedAt: at(2024, 6, 4, 9, 15, 0), Likes: 74, Shares: 6, Comments: 1, Views: 6034,
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrNotSupported is returned when a platform doesn't expose the requested data publicly
+var ErrNotSupported = errors.New("operation not supported by platform")
+
+// API defines the operations every platform scraper implements
+type API interface {
+	GetProfile(ctx context.Context, targetID string) (*Profile, error)
+	GetPosts(ctx context.Context, targetID string, count int) ([]Post, error)
+	GetEngagement(ctx context.Context, targetID, postID string) (*Engagement, error)
+	GetFollowers(ctx context.Context, targetID string, count int) ([]Follower, error)
+	GetComments(ctx context.Context, targetID, postID string, count int) ([]Comment, error)
+
+	// GetPostsPage returns a page of an account's posts, newest first, starting at a cursor returned by the
+	// previous page. An empty cursor starts at the newest post, and an empty next cursor means there are no more.
+	GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]Post, string, error)
+
+	// Posts by any account that match a keyword, use a hashtag or mention an account
+	SearchPosts(ctx context.Context, keyword string, count int) ([]Post, error)
+	GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]Post, error)
+	GetMentions(ctx context.Context, targetID string, count int) ([]Post, error)
+}
+
+// ConditionalAPI is implemented by platforms that can tell when an account's posts haven't changed,
+// such as feeds served with an ETag or Last-Modified header
+type ConditionalAPI interface {
+	// GetPostsIfModified returns an account's posts along with the validators of the response, or
+	// ErrNotModified if they haven't changed since the response the validators came from
+	GetPostsIfModified(ctx context.Context, targetID string, count int, validators Validators) ([]Post, Validators, error)
+}
+
+// GetPostsIfModified fetches an account's posts conditionally from platforms that support it,
+// and unconditionally from the rest
+func GetPostsIfModified(ctx context.Context, api API, targetID string, count int, validators Validators) ([]Post, Validators, error) {
+	if conditional, ok := api.(ConditionalAPI); ok {
+		return conditional.GetPostsIfModified(ctx, targetID, count, validators)
+	}
+
+	posts, err := api.GetPosts(ctx, targetID, count)
+	return posts, Validators{}, err
+}
+
+// Profile represents a public account profile
+type Profile struct {
+	ID          string
+	Username    string
+	DisplayName string
+	Bio         string
+	URL         string
+	Verified    bool
+	Followers   int
+	Following   int
+	PostCount   int
+}
+
+// Post represents a single published post
+type Post struct {
+	ID          string
+	AuthorID    string
+	AuthorName  string
+	URL         string
+	Text        string
+	ContentType string
+	MediaURL    string
+	Duration    float64
+	PostedAt    time.Time
+	Likes       int
+	Shares      int
+	Comments    int
+	Views       int
+}
+
+// Engagement represents the current engagement counters of a post
+type Engagement struct {
+	PostID   string
+	Likes    int
+	Shares   int
+	Comments int
+	Views    int
+}
+
+// Follower represents an account following the target
+type Follower struct {
+	ID          string
+	Username    string
+	DisplayName string
+	Followers   int
+}
+
+// Comment represents a comment or reply on a post
+type Comment struct {
+	ID         string
+	PostID     string
+	ParentID   string
+	AuthorID   string
+	AuthorName string
+	Text       string
+	Likes      int
+	PostedAt   time.Time
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/donaldnash/go-competitor/common/db"
@@ -13,17 +15,73 @@ import (
 // ScraperRepository defines the interface for scraper data access
 type ScraperRepository interface {
 	// Job management
-	GetScraperJobs(ctx context.Context, tenantID, platform string, jobType JobType, status JobStatus) ([]ScraperJob, error)
+	GetScraperJobs(ctx context.Context, tenantID, platform string, jobType JobType, status JobStatus, page db.Page) ([]ScraperJob, db.PageInfo, error)
 	GetScraperJob(ctx context.Context, tenantID, jobID string) (*ScraperJob, error)
 	CreateScraperJob(ctx context.Context, job *ScraperJob) (*ScraperJob, error)
 	UpdateScraperJob(ctx context.Context, job *ScraperJob) (*ScraperJob, error)
 	DeleteScraperJob(ctx context.Context, tenantID, jobID string) error
+	GetDueScraperJobs(ctx context.Context, before time.Time) ([]ScraperJob, error)
+	ListScraperJobsForWorker(ctx context.Context, status JobStatus) ([]ScraperJob, error)
+	GetChildScraperJobs(ctx context.Context, tenantID, parentID string) ([]ScraperJob, error)
+	GetScraperJobsByTarget(ctx context.Context, platform string, targetIDs []string) ([]ScraperJob, error)
+
+	// Job leases
+	ClaimScraperJob(ctx context.Context, job *ScraperJob, workerID string, leaseUntil time.Time) (*ScraperJob, error)
+	RenewScraperJobLease(ctx context.Context, tenantID, jobID, workerID string, leaseUntil time.Time) error
+	ReleaseScraperJob(ctx context.Context, job *ScraperJob, workerID string) (*ScraperJob, error)
+	GetExpiredScraperJobs(ctx context.Context, before time.Time) ([]ScraperJob, error)
+	ExpireScraperJobLease(ctx context.Context, job *ScraperJob, before time.Time) (*ScraperJob, error)
 
 	// Data management
-	GetScrapedData(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time) ([]ScrapedDataItem, error)
+	GetScrapedData(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time, page db.Page) ([]ScrapedDataItem, db.PageInfo, error)
 	SaveScrapedData(ctx context.Context, tenantID string, data []ScrapedDataItem) (int, error)
+	GetLatestScrapedItem(ctx context.Context, tenantID, platform, targetID string, dataType DataType) (*ScrapedDataItem, error)
+
+	// Post snapshots
+	GetPostSnapshots(ctx context.Context, tenantID, platform, targetID, postID string, startDate, endDate time.Time) ([]ScrapedDataItem, error)
+	GetLatestPostSnapshots(ctx context.Context, tenantID, platform, targetID string, postIDs []string) ([]ScrapedDataItem, error)
+
+	// Comments
+	GetComments(ctx context.Context, tenantID, platform, postID string, startDate, endDate time.Time, page db.Page) ([]Comment, db.PageInfo, error)
+	SaveComments(ctx context.Context, tenantID string, comments []Comment) (int, error)
+
+	// Mentions
+	GetMentions(ctx context.Context, tenantID, platform string, startDate, endDate time.Time, page db.Page) ([]Mention, db.PageInfo, error)
+	SaveMentions(ctx context.Context, tenantID string, mentions []Mention) (int, error)
+
+	// Run history
+	GetJobRuns(ctx context.Context, tenantID, jobID string, page db.Page) ([]JobRun, db.PageInfo, error)
+	CreateJobRun(ctx context.Context, run *JobRun) (*JobRun, error)
+	UpdateJobRun(ctx context.Context, run *JobRun) (*JobRun, error)
+
+	// Retention
+	GetRetentionPolicies(ctx context.Context, tenantID string) ([]RetentionPolicy, error)
+	SaveRetentionPolicy(ctx context.Context, policy *RetentionPolicy) (*RetentionPolicy, error)
+	PurgeScrapedData(ctx context.Context, tenantID string, dataType DataType, before time.Time) (int, error)
+	GetStorageUsage(ctx context.Context, tenantID string) ([]StorageUsage, error)
+
+	// Webhook deliveries
+	SaveWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (*WebhookDelivery, error)
+	CompleteWebhookDelivery(ctx context.Context, deliveryID string, itemsStored int) error
+	DeleteWebhookDelivery(ctx context.Context, deliveryID string) error
+	PurgeWebhookDeliveries(ctx context.Context, before time.Time) (int, error)
+
+	// Media assets
+	GetMediaAssets(ctx context.Context, tenantID, platform, targetID, postID string, page db.Page) ([]MediaAsset, db.PageInfo, error)
+	GetMediaAsset(ctx context.Context, tenantID, assetID string) (*MediaAsset, error)
+	GetMediaAssetBySource(ctx context.Context, tenantID, sourceURL string) (*MediaAsset, error)
+	SaveMediaAsset(ctx context.Context, asset *MediaAsset) (*MediaAsset, error)
+	DeleteMediaAssets(ctx context.Context, tenantID, jobID string, before time.Time) ([]MediaAsset, error)
+	MediaStorageKeyInUse(ctx context.Context, storageKey string) (bool, error)
 }
 
+// ErrLeaseNotHeld is returned when a job's lease can't be taken or changed because
+// another worker holds it, or because the job is no longer in the expected state
+var ErrLeaseNotHeld = errors.New("scraper job is not leased to this worker")
+
+// ErrDuplicateDelivery is returned when a webhook event has already been delivered
+var ErrDuplicateDelivery = errors.New("webhook event has already been delivered")
+
 // JobType represents the type of scraper job
 type JobType int
 
@@ -34,6 +92,9 @@ const (
 	JobTypeEngagement
 	JobTypeComments
 	JobTypeFollowers
+	JobTypeKeyword
+	JobTypeHashtag
+	JobTypeMentions
 )
 
 // String returns the string representation of JobType
@@ -49,11 +110,33 @@ func (j JobType) String() string {
 		return "comments"
 	case JobTypeFollowers:
 		return "followers"
+	case JobTypeKeyword:
+		return "keyword"
+	case JobTypeHashtag:
+		return "hashtag"
+	case JobTypeMentions:
+		return "mentions"
 	default:
 		return "unspecified"
 	}
 }
 
+// MarshalText stores JobType by name so it matches the string filters used in queries
+func (j JobType) MarshalText() ([]byte, error) {
+	return []byte(j.String()), nil
+}
+
+// UnmarshalText parses a JobType from its name
+func (j *JobType) UnmarshalText(text []byte) error {
+	for candidate := JobTypeUnspecified; candidate <= JobTypeMentions; candidate++ {
+		if candidate.String() == string(text) {
+			*j = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown job type: %s", text)
+}
+
 // JobStatus represents the status of a scraper job
 type JobStatus int
 
@@ -65,6 +148,7 @@ const (
 	JobStatusCompleted
 	JobStatusFailed
 	JobStatusCancelled
+	JobStatusDeadLetter
 )
 
 // String returns the string representation of JobStatus
@@ -82,11 +166,72 @@ func (s JobStatus) String() string {
 		return "failed"
 	case JobStatusCancelled:
 		return "cancelled"
+	case JobStatusDeadLetter:
+		return "dead_letter"
+	default:
+		return "unspecified"
+	}
+}
+
+// MarshalText stores JobStatus by name so it matches the string filters used in queries
+func (s JobStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses a JobStatus from its name
+func (s *JobStatus) UnmarshalText(text []byte) error {
+	for candidate := JobStatusUnspecified; candidate <= JobStatusDeadLetter; candidate++ {
+		if candidate.String() == string(text) {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown job status: %s", text)
+}
+
+// RunStatus represents the outcome of a single job run
+type RunStatus int
+
+const (
+	RunStatusUnspecified RunStatus = iota
+	RunStatusRunning
+	RunStatusCompleted
+	RunStatusFailed
+	RunStatusDeferred
+)
+
+// String returns the string representation of RunStatus
+func (s RunStatus) String() string {
+	switch s {
+	case RunStatusRunning:
+		return "running"
+	case RunStatusCompleted:
+		return "completed"
+	case RunStatusFailed:
+		return "failed"
+	case RunStatusDeferred:
+		return "deferred"
 	default:
 		return "unspecified"
 	}
 }
 
+// MarshalText stores RunStatus by name so it matches the string filters used in queries
+func (s RunStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText parses a RunStatus from its name
+func (s *RunStatus) UnmarshalText(text []byte) error {
+	for candidate := RunStatusUnspecified; candidate <= RunStatusDeferred; candidate++ {
+		if candidate.String() == string(text) {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown run status: %s", text)
+}
+
 // ScheduleFrequency represents the frequency of a scheduled job
 type ScheduleFrequency int
 
@@ -124,6 +269,7 @@ const (
 	DataTypeStory
 	DataTypeComment
 	DataTypeFollower
+	DataTypeMention
 )
 
 // String returns the string representation of DataType
@@ -139,11 +285,29 @@ func (d DataType) String() string {
 		return "comment"
 	case DataTypeFollower:
 		return "follower"
+	case DataTypeMention:
+		return "mention"
 	default:
 		return "unspecified"
 	}
 }
 
+// MarshalText stores DataType by name so it matches the string filters used in queries
+func (d DataType) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses a DataType from its name
+func (d *DataType) UnmarshalText(text []byte) error {
+	for candidate := DataTypeUnspecified; candidate <= DataTypeMention; candidate++ {
+		if candidate.String() == string(text) {
+			*d = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown data type: %s", text)
+}
+
 // ScraperSchedule represents a schedule for a scraper job
 type ScraperSchedule struct {
 	CronExpression string            `json:"cron_expression"`
@@ -156,18 +320,79 @@ type ScraperSchedule struct {
 type ScraperJob struct {
 	ID        string            `json:"id"`
 	TenantID  string            `json:"tenant_id"`
+	ParentID  string            `json:"parent_id"` // Job whose pipeline spawned this one
 	Platform  string            `json:"platform"`
 	TargetID  string            `json:"target_id"`
 	JobType   JobType           `json:"job_type"`
 	Status    JobStatus         `json:"status"`
 	Schedule  ScraperSchedule   `json:"schedule"`
+	Priority  int               `json:"priority"` // Higher runs first among the tenant's due jobs
 	LastError string            `json:"last_error"`
 	RunCount  int               `json:"run_count"`
+	Attempts  int               `json:"attempts"` // Failed attempts of the current run
 	LastRunAt time.Time         `json:"last_run_at"`
 	NextRunAt time.Time         `json:"next_run_at"`
 	Metadata  map[string]string `json:"metadata"`
 	CreatedAt time.Time         `json:"created_at"`
 	UpdatedAt time.Time         `json:"updated_at"`
+
+	// Run of the parent job that spawned this one
+	ParentRunID string `json:"parent_run_id"`
+
+	// Latest run of this job whose pipeline spawned or reran its children
+	PipelineRunID string `json:"pipeline_run_id"`
+
+	// Lease held by the worker running the job
+	ClaimedBy      string    `json:"claimed_by"`
+	LeaseExpiresAt time.Time `json:"lease_expires_at"` // Other workers may requeue the job after this
+
+	// Progress of a posts job walking back through the target's post history, nil for other jobs
+	Backfill *BackfillState `json:"backfill"`
+
+	// Validators of the last posts response stored, for platforms that support conditional requests
+	Validators *ResponseValidators `json:"validators"`
+}
+
+// ResponseValidators are the ETag and Last-Modified headers of a response. They are sent back
+// with the next request so a response that hasn't changed isn't fetched and stored again.
+type ResponseValidators struct {
+	ETag         string `json:"etag"`
+	LastModified string `json:"last_modified"`
+}
+
+// BackfillState is the checkpoint of a backfill. Each run continues from the cursor of the
+// page after the last one stored, so a backfill resumes where it left off after a restart.
+type BackfillState struct {
+	Until        time.Time `json:"until"` // Posts older than this are not collected
+	StartedAt    time.Time `json:"started_at"`
+	Cursor       string    `json:"cursor"`
+	OldestPostAt time.Time `json:"oldest_post_at"` // Oldest post collected so far
+	Pages        int       `json:"pages"`
+	Posts        int       `json:"posts"`
+	CompletedAt  time.Time `json:"completed_at"`
+}
+
+// Completed returns whether the backfill has reached its date or the end of the target's history
+func (b *BackfillState) Completed() bool {
+	return !b.CompletedAt.IsZero()
+}
+
+// PercentComplete estimates how much of the backfill is done from how far back in time it has reached
+func (b *BackfillState) PercentComplete() float64 {
+	if b.Completed() {
+		return 100
+	}
+	if b.OldestPostAt.IsZero() {
+		return 0
+	}
+
+	span := b.StartedAt.Sub(b.Until)
+	if span <= 0 {
+		return 100
+	}
+
+	percent := float64(b.StartedAt.Sub(b.OldestPostAt)) / float64(span) * 100
+	return min(max(percent, 0), 100)
 }
 
 // ScrapedDataItem represents a scraped data item
@@ -177,6 +402,7 @@ type ScrapedDataItem struct {
 	TenantID          string            `json:"tenant_id"`
 	Platform          string            `json:"platform"`
 	TargetID          string            `json:"target_id"`
+	RunID             string            `json:"run_id"`
 	PostID            string            `json:"post_id"`
 	DataType          DataType          `json:"data_type"`
 	PostedAt          time.Time         `json:"posted_at"`
@@ -193,18 +419,133 @@ type ScrapedDataItem struct {
 	CreatedAt         time.Time         `json:"created_at"`
 }
 
-// SupabaseScraperRepository implements ScraperRepository using Supabase
+// Comment represents a comment scraped from a post
+type Comment struct {
+	ID         string    `json:"id"`
+	TenantID   string    `json:"tenant_id"`
+	JobID      string    `json:"job_id"`
+	RunID      string    `json:"run_id"`
+	Platform   string    `json:"platform"`
+	TargetID   string    `json:"target_id"`
+	PostID     string    `json:"post_id"`
+	CommentID  string    `json:"comment_id"` // Platform-specific comment ID
+	ParentID   string    `json:"parent_id"`  // Comment being replied to, empty for top-level comments
+	AuthorID   string    `json:"author_id"`
+	AuthorName string    `json:"author_name"`
+	Text       string    `json:"text"`
+	Likes      int       `json:"likes"`
+	PostedAt   time.Time `json:"posted_at"`
+	ScrapedAt  time.Time `json:"scraped_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Mention represents a post found by a keyword, hashtag or mention tracking job.
+// Mentions attributed to the tenant's own account or a competitor feed share-of-voice reports.
+type Mention struct {
+	ID           string    `json:"id"`
+	TenantID     string    `json:"tenant_id"`
+	JobID        string    `json:"job_id"`
+	RunID        string    `json:"run_id"`
+	Platform     string    `json:"platform"`
+	JobType      JobType   `json:"job_type"`
+	Query        string    `json:"query"` // Keyword, hashtag or account that was tracked
+	PostID       string    `json:"post_id"`
+	URL          string    `json:"url"`
+	AuthorID     string    `json:"author_id"`
+	AuthorName   string    `json:"author_name"`
+	Text         string    `json:"text"`
+	Likes        int       `json:"likes"`
+	Shares       int       `json:"shares"`
+	Comments     int       `json:"comments"`
+	Views        int       `json:"views"`
+	CompetitorID string    `json:"competitor_id"` // Competitor the mention counts towards, if any
+	OwnBrand     bool      `json:"own_brand"`     // Whether the mention counts towards the tenant's own brand
+	PostedAt     time.Time `json:"posted_at"`
+	ScrapedAt    time.Time `json:"scraped_at"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// JobRun represents a single execution of a scraper job.
+// Retries and runs deferred by a rate limit share their run number with the attempt that follows them.
+type JobRun struct {
+	ID           string    `json:"id"`
+	JobID        string    `json:"job_id"`
+	TenantID     string    `json:"tenant_id"`
+	RunNumber    int       `json:"run_number"`
+	Attempt      int       `json:"attempt"`
+	Status       RunStatus `json:"status"`
+	StartedAt    time.Time `json:"started_at"`
+	FinishedAt   time.Time `json:"finished_at"`
+	ItemsScraped int       `json:"items_scraped"`
+	RequestsUsed int       `json:"requests_used"`
+	Error        string    `json:"error"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// RetentionPolicy sets how long a tenant keeps one type of scraped data
+type RetentionPolicy struct {
+	ID            string    `json:"id"`
+	TenantID      string    `json:"tenant_id"`
+	DataType      DataType  `json:"data_type"`
+	RetentionDays int       `json:"retention_days"` // 0 keeps the data forever
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// StorageUsage is the number of rows a tenant stores in one table. Rows in
+// scraped_data are reported per data type.
+type StorageUsage struct {
+	Table    string
+	DataType DataType // Only set for scraped_data
+	Rows     int
+	OldestAt time.Time
+	NewestAt time.Time
+}
+
+// WebhookDelivery records an event pushed by a platform's webhook, so a redelivery of the same event is ignored
+type WebhookDelivery struct {
+	ID          string    `json:"id"`
+	Platform    string    `json:"platform"`
+	EventID     string    `json:"event_id"` // Platform-specific event ID
+	ItemsStored int       `json:"items_stored"`
+	ReceivedAt  time.Time `json:"received_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MediaAsset is a copy of the image or video attached to a scraped post, kept in the media store
+type MediaAsset struct {
+	ID             string    `json:"id"`
+	TenantID       string    `json:"tenant_id"`
+	JobID          string    `json:"job_id"`
+	Platform       string    `json:"platform"`
+	TargetID       string    `json:"target_id"`
+	PostID         string    `json:"post_id"`
+	SourceURL      string    `json:"source_url"`  // Where the media was downloaded from
+	StorageKey     string    `json:"storage_key"` // Key of the copy in the media store
+	ContentType    string    `json:"content_type"`
+	SizeBytes      int64     `json:"size_bytes"`
+	Width          int       `json:"width"`
+	Height         int       `json:"height"`
+	Duration       float64   `json:"duration"`        // Seconds, for video and audio
+	SHA256         string    `json:"sha256"`          // Digest of the content
+	PerceptualHash string    `json:"perceptual_hash"` // Empty for media that isn't a decodable image
+	CapturedAt     time.Time `json:"captured_at"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// SupabaseScraperRepository implements ScraperRepository using Supabase.
+// The scraper runs jobs for every tenant, so its client isn't bound to one: queries for a tenant
+// filter on the tenant ID they are given, and only the worker's queries, such as due and expired
+// jobs, read across tenants.
 type SupabaseScraperRepository struct {
 	client *db.SupabaseClient
 }
 
 // NewSupabaseScraperRepository creates a new SupabaseScraperRepository
-func NewSupabaseScraperRepository(tenantID string) (*SupabaseScraperRepository, error) {
-	if tenantID == "" {
-		return nil, errors.New("tenant ID is required")
-	}
-
-	client, err := db.NewSupabaseClient(tenantID)
+func NewSupabaseScraperRepository() (*SupabaseScraperRepository, error) {
+	client, err := db.NewSupabaseClient("")
 	if err != nil {
 		return nil, err
 	}
@@ -214,9 +555,13 @@ func NewSupabaseScraperRepository(tenantID string) (*SupabaseScraperRepository,
 	}, nil
 }
 
-// GetScraperJobs retrieves all scraper jobs matching the filters
-func (r *SupabaseScraperRepository) GetScraperJobs(ctx context.Context, tenantID, platform string, jobType JobType, status JobStatus) ([]ScraperJob, error) {
-	query := r.client.Query("scraper_jobs").Select("*")
+// GetScraperJobs retrieves a page of the scraper jobs matching the filters. The zero page retrieves all of them.
+func (r *SupabaseScraperRepository) GetScraperJobs(ctx context.Context, tenantID, platform string, jobType JobType, status JobStatus,
+	page db.Page) ([]ScraperJob, db.PageInfo, error) {
+
+	query := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("tenant_id", "eq", tenantID)
 
 	// Apply filters if provided
 	if platform != "" {
@@ -232,11 +577,11 @@ func (r *SupabaseScraperRepository) GetScraperJobs(ctx context.Context, tenantID
 	}
 
 	var jobs []ScraperJob
-	err := query.Execute(&jobs)
+	info, err := query.ExecutePage(ctx, page, &jobs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scraper jobs: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scraper jobs: %w", err)
 	}
-	return jobs, nil
+	return jobs, info, nil
 }
 
 // GetScraperJob retrieves a specific scraper job
@@ -244,15 +589,16 @@ func (r *SupabaseScraperRepository) GetScraperJob(ctx context.Context, tenantID,
 	var jobs []ScraperJob
 	err := r.client.Query("scraper_jobs").
 		Select("*").
+		Where("tenant_id", "eq", tenantID).
 		Where("id", "eq", jobID).
-		Execute(&jobs)
+		Execute(ctx, &jobs)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to get scraper job: %w", err)
 	}
 
 	if len(jobs) == 0 {
-		return nil, errors.New("scraper job not found")
+		return nil, fmt.Errorf("scraper job %w", db.ErrNotFound)
 	}
 
 	return &jobs[0], nil
@@ -264,7 +610,10 @@ func (r *SupabaseScraperRepository) CreateScraperJob(ctx context.Context, job *S
 		job.ID = uuid.New().String()
 	}
 
-	job.TenantID = r.client.TenantID
+	// Jobs are stored under the tenant that created them, which the queue shares workers by
+	if job.TenantID == "" {
+		return nil, db.ErrTenantRequired
+	}
 	if job.CreatedAt.IsZero() {
 		job.CreatedAt = time.Now()
 	}
@@ -281,7 +630,7 @@ func (r *SupabaseScraperRepository) CreateScraperJob(ctx context.Context, job *S
 // UpdateScraperJob updates an existing scraper job
 func (r *SupabaseScraperRepository) UpdateScraperJob(ctx context.Context, job *ScraperJob) (*ScraperJob, error) {
 	// Make sure the job belongs to the tenant
-	existing, err := r.GetScraperJob(ctx, r.client.TenantID, job.ID)
+	existing, err := r.GetScraperJob(ctx, job.TenantID, job.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -291,7 +640,7 @@ func (r *SupabaseScraperRepository) UpdateScraperJob(ctx context.Context, job *S
 	job.CreatedAt = existing.CreatedAt
 	job.UpdatedAt = time.Now()
 
-	err = r.client.Update(ctx, "scraper_jobs", "id", job.ID, job)
+	_, err = r.client.Update(ctx, db.TenantScope(job.TenantID), "scraper_jobs", "id", job.ID, job)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update scraper job: %w", err)
 	}
@@ -299,7 +648,7 @@ func (r *SupabaseScraperRepository) UpdateScraperJob(ctx context.Context, job *S
 	return job, nil
 }
 
-// DeleteScraperJob deletes a scraper job
+// DeleteScraperJob deletes a scraper job along with its runs and the data it scraped
 func (r *SupabaseScraperRepository) DeleteScraperJob(ctx context.Context, tenantID, jobID string) error {
 	// Verify the job exists and belongs to the tenant
 	_, err := r.GetScraperJob(ctx, tenantID, jobID)
@@ -307,7 +656,14 @@ func (r *SupabaseScraperRepository) DeleteScraperJob(ctx context.Context, tenant
 		return err
 	}
 
-	err = r.client.Delete(ctx, "scraper_jobs", "id", jobID)
+	// Delete the job's rows first, so a failure leaves the job in place to retry the delete
+	for _, table := range []string{"scraped_data", "scraped_comments", "mentions", "media_assets", "scraper_job_runs"} {
+		if _, err := deleteRows(ctx, db.TenantScope(tenantID), r.client.Query(table).Where("job_id", "eq", jobID)); err != nil {
+			return fmt.Errorf("failed to delete %s of scraper job: %w", table, err)
+		}
+	}
+
+	_, err = r.client.Delete(ctx, db.TenantScope(tenantID), "scraper_jobs", "id", jobID)
 	if err != nil {
 		return fmt.Errorf("failed to delete scraper job: %w", err)
 	}
@@ -315,17 +671,232 @@ func (r *SupabaseScraperRepository) DeleteScraperJob(ctx context.Context, tenant
 	return nil
 }
 
-// GetScrapedData retrieves scraped data for a specific job within a date range
-func (r *SupabaseScraperRepository) GetScrapedData(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time) ([]ScrapedDataItem, error) {
+// GetDueScraperJobs retrieves pending and scheduled jobs whose next run is at or before the given time
+func (r *SupabaseScraperRepository) GetDueScraperJobs(ctx context.Context, before time.Time) ([]ScraperJob, error) {
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("status", "in", fmt.Sprintf("(%s,%s)", JobStatusPending.String(), JobStatusScheduled.String())).
+		Where("next_run_at", "lte", before.Format(time.RFC3339)).
+		Order("next_run_at", false).
+		Execute(ctx, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due scraper jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// ListScraperJobsForWorker retrieves the jobs of every tenant with the given status, or all jobs when the
+// status is unspecified. It is meant for the worker's own bookkeeping, never for requests made on behalf of a tenant.
+func (r *SupabaseScraperRepository) ListScraperJobsForWorker(ctx context.Context, status JobStatus) ([]ScraperJob, error) {
+	query := r.client.Query("scraper_jobs").Select("*")
+
+	if status != JobStatusUnspecified {
+		query = query.Where("status", "eq", status.String())
+	}
+
+	var jobs []ScraperJob
+	if err := query.Order("created_at", false).Execute(ctx, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to list scraper jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// GetChildScraperJobs retrieves the jobs spawned by a job's pipeline, oldest first
+func (r *SupabaseScraperRepository) GetChildScraperJobs(ctx context.Context, tenantID, parentID string) ([]ScraperJob, error) {
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("parent_id", "eq", parentID).
+		Order("created_at", false).
+		Execute(ctx, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get child scraper jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// GetScraperJobsByTarget retrieves the jobs of every tenant that track any of the given targets on a platform,
+// except cancelled ones
+func (r *SupabaseScraperRepository) GetScraperJobsByTarget(ctx context.Context, platform string, targetIDs []string) ([]ScraperJob, error) {
+	if len(targetIDs) == 0 {
+		return nil, nil
+	}
+
+	quoted := make([]string, len(targetIDs))
+	for i, targetID := range targetIDs {
+		quoted[i] = strconv.Quote(targetID)
+	}
+
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("platform", "eq", platform).
+		Where("target_id", "in", "("+strings.Join(quoted, ",")+")").
+		Where("status", "neq", JobStatusCancelled.String()).
+		Order("created_at", false).
+		Execute(ctx, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scraper jobs by target: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// ClaimScraperJob marks a pending or scheduled job as running and leases it to a worker until the given time.
+// The claim is a single conditional update that only succeeds while the job is due and hasn't been updated
+// since it was loaded, so when several workers race for the same job, or the job was rescheduled or edited
+// in the meantime, only one claim succeeds and the others get ErrLeaseNotHeld. Only the status, worker and
+// lease columns are written.
+func (r *SupabaseScraperRepository) ClaimScraperJob(ctx context.Context, job *ScraperJob, workerID string, leaseUntil time.Time) (*ScraperJob, error) {
+	now := time.Now()
+	claim := map[string]interface{}{
+		"status":           JobStatusRunning,
+		"claimed_by":       workerID,
+		"lease_expires_at": leaseUntil,
+		"updated_at":       now,
+	}
+
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("id", "eq", job.ID).
+		Where("status", "in", fmt.Sprintf("(%s,%s)", JobStatusPending.String(), JobStatusScheduled.String())).
+		Where("next_run_at", "lte", now.Format(time.RFC3339Nano)).
+		Where("updated_at", "eq", job.UpdatedAt.Format(time.RFC3339Nano)).
+		Update(ctx, db.TenantScope(job.TenantID), claim, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scraper job: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		return nil, ErrLeaseNotHeld
+	}
+
+	return &jobs[0], nil
+}
+
+// RenewScraperJobLease extends the lease a worker holds on a running job.
+// It returns ErrLeaseNotHeld once the job has been requeued or released.
+func (r *SupabaseScraperRepository) RenewScraperJobLease(ctx context.Context, tenantID, jobID, workerID string,
+	leaseUntil time.Time) error {
+
+	renewal := map[string]interface{}{
+		"lease_expires_at": leaseUntil,
+		"updated_at":       time.Now(),
+	}
+
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("id").
+		Where("id", "eq", jobID).
+		Where("status", "eq", JobStatusRunning.String()).
+		Where("claimed_by", "eq", workerID).
+		Update(ctx, db.TenantScope(tenantID), renewal, &jobs)
+
+	if err != nil {
+		return fmt.Errorf("failed to renew scraper job lease: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		return ErrLeaseNotHeld
+	}
+
+	return nil
+}
+
+// ReleaseScraperJob saves the outcome of a run and gives up the worker's lease on the job.
+// It returns ErrLeaseNotHeld if the job was requeued while the worker was running it.
+func (r *SupabaseScraperRepository) ReleaseScraperJob(ctx context.Context, job *ScraperJob, workerID string) (*ScraperJob, error) {
+	job.ClaimedBy = ""
+	job.LeaseExpiresAt = time.Time{}
+	job.UpdatedAt = time.Now()
+
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("id", "eq", job.ID).
+		Where("status", "eq", JobStatusRunning.String()).
+		Where("claimed_by", "eq", workerID).
+		Update(ctx, db.TenantScope(job.TenantID), job, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to release scraper job: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		return nil, ErrLeaseNotHeld
+	}
+
+	return &jobs[0], nil
+}
+
+// GetExpiredScraperJobs retrieves running jobs whose lease expired before the given time
+func (r *SupabaseScraperRepository) GetExpiredScraperJobs(ctx context.Context, before time.Time) ([]ScraperJob, error) {
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("status", "eq", JobStatusRunning.String()).
+		Where("lease_expires_at", "lt", before.Format(time.RFC3339)).
+		Order("lease_expires_at", false).
+		Execute(ctx, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired scraper jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// ExpireScraperJobLease saves a running job whose lease expired before the given time and clears the lease,
+// typically to requeue it. It returns ErrLeaseNotHeld if the lease was renewed or the job released meanwhile.
+func (r *SupabaseScraperRepository) ExpireScraperJobLease(ctx context.Context, job *ScraperJob, before time.Time) (*ScraperJob, error) {
+	job.ClaimedBy = ""
+	job.LeaseExpiresAt = time.Time{}
+	job.UpdatedAt = time.Now()
+
+	var jobs []ScraperJob
+	err := r.client.Query("scraper_jobs").
+		Select("*").
+		Where("id", "eq", job.ID).
+		Where("status", "eq", JobStatusRunning.String()).
+		Where("lease_expires_at", "lt", before.Format(time.RFC3339)).
+		Update(ctx, db.TenantScope(job.TenantID), job, &jobs)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to expire scraper job lease: %w", err)
+	}
+
+	if len(jobs) == 0 {
+		return nil, ErrLeaseNotHeld
+	}
+
+	return &jobs[0], nil
+}
+
+// GetScrapedData retrieves a page of the scraped data for a specific job within a date range.
+// The zero page retrieves all of it.
+func (r *SupabaseScraperRepository) GetScrapedData(ctx context.Context, tenantID, jobID string, startDate, endDate time.Time,
+	page db.Page) ([]ScrapedDataItem, db.PageInfo, error) {
+
 	// First verify the job exists and belongs to the tenant
 	_, err := r.GetScraperJob(ctx, tenantID, jobID)
 	if err != nil {
-		return nil, err
+		return nil, db.PageInfo{}, err
 	}
 
 	var items []ScrapedDataItem
 	query := r.client.Query("scraped_data").
 		Select("*").
+		Where("tenant_id", "eq", tenantID).
 		Where("job_id", "eq", jobID)
 
 	if !startDate.IsZero() {
@@ -336,18 +907,18 @@ func (r *SupabaseScraperRepository) GetScrapedData(ctx context.Context, tenantID
 		query = query.Where("scraped_at", "lte", endDate.Format(time.RFC3339))
 	}
 
-	err = query.Order("scraped_at", false).Execute(&items)
+	info, err := query.Order("scraped_at", false).ExecutePage(ctx, page, &items)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to get scraped data: %w", err)
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get scraped data: %w", err)
 	}
 
-	return items, nil
+	return items, info, nil
 }
 
-// SaveScrapedData saves scraped data items
+// SaveScrapedData saves scraped data items in batches. An item that fails doesn't stop the others
+// from being saved. It returns how many were saved, with an error listing the index of each item that failed.
 func (r *SupabaseScraperRepository) SaveScrapedData(ctx context.Context, tenantID string, data []ScrapedDataItem) (int, error) {
-	// Insert each data item
 	for i := range data {
 		// Set IDs and ensure tenant ID is set
 		if data[i].ID == "" {
@@ -358,12 +929,671 @@ func (r *SupabaseScraperRepository) SaveScrapedData(ctx context.Context, tenantI
 		if data[i].CreatedAt.IsZero() {
 			data[i].CreatedAt = time.Now()
 		}
+	}
 
-		err := r.client.Insert(ctx, "scraped_data", data[i])
+	result, err := r.client.InsertBatch(ctx, "scraped_data", data)
+	if err == nil {
+		err = result.Err()
+	}
+	if err != nil {
+		return result.Written, fmt.Errorf("failed to save data items: %w", err)
+	}
+
+	return result.Written, nil
+}
+
+// GetLatestScrapedItem retrieves the most recently scraped item of a data type that a tenant stores for a target.
+// It returns nil without an error when nothing has been scraped yet.
+func (r *SupabaseScraperRepository) GetLatestScrapedItem(ctx context.Context, tenantID, platform, targetID string, dataType DataType) (*ScrapedDataItem, error) {
+	var items []ScrapedDataItem
+	err := r.client.Query("scraped_data").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("platform", "eq", platform).
+		Where("target_id", "eq", targetID).
+		Where("data_type", "eq", dataType.String()).
+		Order("scraped_at", true).
+		Limit(1).
+		Execute(ctx, &items)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest scraped item: %w", err)
+	}
+
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	return &items[0], nil
+}
+
+// GetPostSnapshots retrieves the stored snapshots of a target's posts within a date range, oldest first.
+// The post ID is optional and limits the snapshots to one post.
+func (r *SupabaseScraperRepository) GetPostSnapshots(ctx context.Context, tenantID, platform, targetID, postID string, startDate, endDate time.Time) ([]ScrapedDataItem, error) {
+	query := r.client.Query("scraped_data").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("platform", "eq", platform).
+		Where("target_id", "eq", targetID).
+		Where("data_type", "eq", DataTypePost.String())
+
+	if postID != "" {
+		query = query.Where("post_id", "eq", postID)
+	}
+
+	if !startDate.IsZero() {
+		query = query.Where("scraped_at", "gte", startDate.Format(time.RFC3339))
+	}
+
+	if !endDate.IsZero() {
+		query = query.Where("scraped_at", "lte", endDate.Format(time.RFC3339))
+	}
+
+	var items []ScrapedDataItem
+	err := query.Order("scraped_at", false).Execute(ctx, &items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get post snapshots: %w", err)
+	}
+
+	return items, nil
+}
+
+// GetLatestPostSnapshots retrieves the most recent snapshot of each of a target's posts.
+// Posts without a snapshot are left out.
+func (r *SupabaseScraperRepository) GetLatestPostSnapshots(ctx context.Context, tenantID, platform, targetID string, postIDs []string) ([]ScrapedDataItem, error) {
+	if len(postIDs) == 0 {
+		return nil, nil
+	}
+
+	quoted := make([]string, len(postIDs))
+	for i, postID := range postIDs {
+		quoted[i] = strconv.Quote(postID)
+	}
+
+	// Newest first, so the first snapshot of each post is its latest
+	var items []ScrapedDataItem
+	err := r.client.Query("scraped_data").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("platform", "eq", platform).
+		Where("target_id", "eq", targetID).
+		Where("data_type", "eq", DataTypePost.String()).
+		Where("post_id", "in", "("+strings.Join(quoted, ",")+")").
+		Order("scraped_at", true).
+		Execute(ctx, &items)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest post snapshots: %w", err)
+	}
+
+	latest := make([]ScrapedDataItem, 0, len(postIDs))
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if seen[item.PostID] {
+			continue
+		}
+		seen[item.PostID] = true
+		latest = append(latest, item)
+	}
+
+	return latest, nil
+}
+
+// GetComments retrieves a page of the comments on a post, oldest first. The platform and date range are optional,
+// and the zero page retrieves all of them.
+func (r *SupabaseScraperRepository) GetComments(ctx context.Context, tenantID, platform, postID string, startDate, endDate time.Time,
+	page db.Page) ([]Comment, db.PageInfo, error) {
+
+	query := r.client.Query("scraped_comments").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("post_id", "eq", postID)
+
+	if platform != "" {
+		query = query.Where("platform", "eq", platform)
+	}
+
+	if !startDate.IsZero() {
+		query = query.Where("posted_at", "gte", startDate.Format(time.RFC3339))
+	}
+
+	if !endDate.IsZero() {
+		query = query.Where("posted_at", "lte", endDate.Format(time.RFC3339))
+	}
+
+	var comments []Comment
+	info, err := query.Order("posted_at", false).ExecutePage(ctx, page, &comments)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	return comments, info, nil
+}
+
+// SaveComments saves scraped comments.
+// A comment that was scraped before is updated with its latest text and likes instead of being duplicated.
+func (r *SupabaseScraperRepository) SaveComments(ctx context.Context, tenantID string, comments []Comment) (int, error) {
+	for i := range comments {
+		comments[i].TenantID = tenantID
+		comments[i].UpdatedAt = time.Now()
+
+		var existing []Comment
+		err := r.client.Query("scraped_comments").
+			Select("id,created_at").
+			Where("tenant_id", "eq", tenantID).
+			Where("platform", "eq", comments[i].Platform).
+			Where("comment_id", "eq", comments[i].CommentID).
+			Execute(ctx, &existing)
+		if err != nil {
+			return i, fmt.Errorf("failed to look up comment at index %d: %w", i, err)
+		}
+
+		if len(existing) > 0 {
+			comments[i].ID = existing[0].ID
+			comments[i].CreatedAt = existing[0].CreatedAt
+			_, err = r.client.Update(ctx, db.TenantScope(tenantID), "scraped_comments", "id", comments[i].ID, comments[i])
+		} else {
+			if comments[i].ID == "" {
+				comments[i].ID = uuid.New().String()
+			}
+			comments[i].CreatedAt = time.Now()
+			err = r.client.Insert(ctx, "scraped_comments", comments[i])
+		}
+
+		if err != nil {
+			return i, fmt.Errorf("failed to save comment at index %d: %w", i, err)
+		}
+	}
+
+	return len(comments), nil
+}
+
+// GetMentions retrieves a page of the mentions posted within a date range, oldest first. The platform and dates
+// are optional, and the zero page retrieves all of them.
+func (r *SupabaseScraperRepository) GetMentions(ctx context.Context, tenantID, platform string, startDate, endDate time.Time,
+	page db.Page) ([]Mention, db.PageInfo, error) {
+
+	query := r.client.Query("mentions").
+		Select("*").
+		Where("tenant_id", "eq", tenantID)
+
+	if platform != "" {
+		query = query.Where("platform", "eq", platform)
+	}
+
+	if !startDate.IsZero() {
+		query = query.Where("posted_at", "gte", startDate.Format(time.RFC3339))
+	}
+
+	if !endDate.IsZero() {
+		query = query.Where("posted_at", "lte", endDate.Format(time.RFC3339))
+	}
+
+	var mentions []Mention
+	info, err := query.Order("posted_at", false).ExecutePage(ctx, page, &mentions)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get mentions: %w", err)
+	}
+
+	return mentions, info, nil
+}
+
+// SaveMentions saves tracked mentions.
+// A post found again by the same query is updated with its latest counters instead of being duplicated.
+func (r *SupabaseScraperRepository) SaveMentions(ctx context.Context, tenantID string, mentions []Mention) (int, error) {
+	for i := range mentions {
+		mentions[i].TenantID = tenantID
+		mentions[i].UpdatedAt = time.Now()
+
+		var existing []Mention
+		err := r.client.Query("mentions").
+			Select("id,created_at").
+			Where("tenant_id", "eq", tenantID).
+			Where("platform", "eq", mentions[i].Platform).
+			Where("query", "eq", mentions[i].Query).
+			Where("post_id", "eq", mentions[i].PostID).
+			Execute(ctx, &existing)
+		if err != nil {
+			return i, fmt.Errorf("failed to look up mention at index %d: %w", i, err)
+		}
+
+		if len(existing) > 0 {
+			mentions[i].ID = existing[0].ID
+			mentions[i].CreatedAt = existing[0].CreatedAt
+			_, err = r.client.Update(ctx, db.TenantScope(tenantID), "mentions", "id", mentions[i].ID, mentions[i])
+		} else {
+			if mentions[i].ID == "" {
+				mentions[i].ID = uuid.New().String()
+			}
+			mentions[i].CreatedAt = time.Now()
+			err = r.client.Insert(ctx, "mentions", mentions[i])
+		}
+
+		if err != nil {
+			return i, fmt.Errorf("failed to save mention at index %d: %w", i, err)
+		}
+	}
+
+	return len(mentions), nil
+}
+
+// GetJobRuns retrieves a page of the runs of a job, most recent first. The zero page retrieves all of them.
+func (r *SupabaseScraperRepository) GetJobRuns(ctx context.Context, tenantID, jobID string, page db.Page) ([]JobRun, db.PageInfo, error) {
+	// First verify the job exists and belongs to the tenant
+	_, err := r.GetScraperJob(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, db.PageInfo{}, err
+	}
+
+	var runs []JobRun
+	info, err := r.client.Query("scraper_job_runs").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("job_id", "eq", jobID).
+		Order("started_at", true).
+		ExecutePage(ctx, page, &runs)
+
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get job runs: %w", err)
+	}
+
+	return runs, info, nil
+}
+
+// CreateJobRun records the start of a job run
+func (r *SupabaseScraperRepository) CreateJobRun(ctx context.Context, run *JobRun) (*JobRun, error) {
+	if run.ID == "" {
+		run.ID = uuid.New().String()
+	}
+
+	if run.TenantID == "" {
+		return nil, db.ErrTenantRequired
+	}
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now()
+	}
+
+	err := r.client.Insert(ctx, "scraper_job_runs", run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job run: %w", err)
+	}
+
+	return run, nil
+}
+
+// UpdateJobRun updates a job run, typically to record its outcome
+func (r *SupabaseScraperRepository) UpdateJobRun(ctx context.Context, run *JobRun) (*JobRun, error) {
+	_, err := r.client.Update(ctx, db.TenantScope(run.TenantID), "scraper_job_runs", "id", run.ID, run)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update job run: %w", err)
+	}
+
+	return run, nil
+}
+
+// GetRetentionPolicies retrieves the retention policies a tenant has set
+func (r *SupabaseScraperRepository) GetRetentionPolicies(ctx context.Context, tenantID string) ([]RetentionPolicy, error) {
+	var policies []RetentionPolicy
+	err := r.client.Query("scraper_retention_policies").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Order("data_type", false).
+		Execute(ctx, &policies)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get retention policies: %w", err)
+	}
+
+	return policies, nil
+}
+
+// SaveRetentionPolicy creates or replaces the tenant's retention policy for a data type
+func (r *SupabaseScraperRepository) SaveRetentionPolicy(ctx context.Context, policy *RetentionPolicy) (*RetentionPolicy, error) {
+	if policy.TenantID == "" {
+		return nil, db.ErrTenantRequired
+	}
+	policy.UpdatedAt = time.Now()
+
+	var existing []RetentionPolicy
+	err := r.client.Query("scraper_retention_policies").
+		Select("id,created_at").
+		Where("tenant_id", "eq", policy.TenantID).
+		Where("data_type", "eq", policy.DataType.String()).
+		Execute(ctx, &existing)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up retention policy: %w", err)
+	}
+
+	if len(existing) > 0 {
+		policy.ID = existing[0].ID
+		policy.CreatedAt = existing[0].CreatedAt
+		_, err = r.client.Update(ctx, db.TenantScope(policy.TenantID), "scraper_retention_policies", "id", policy.ID, policy)
+	} else {
+		if policy.ID == "" {
+			policy.ID = uuid.New().String()
+		}
+		policy.CreatedAt = time.Now()
+		err = r.client.Insert(ctx, "scraper_retention_policies", policy)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to save retention policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+// PurgeScrapedData deletes the tenant's data of a type that was scraped before the given time and returns
+// the number of rows deleted. Comments and mentions are also removed from their own tables.
+func (r *SupabaseScraperRepository) PurgeScrapedData(ctx context.Context, tenantID string, dataType DataType, before time.Time) (int, error) {
+	cutoff := before.Format(time.RFC3339)
+
+	purged, err := deleteRows(ctx, db.TenantScope(tenantID), r.client.Query("scraped_data").
+		Where("data_type", "eq", dataType.String()).
+		Where("scraped_at", "lt", cutoff))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge scraped data: %w", err)
+	}
+
+	table := ""
+	switch dataType {
+	case DataTypeComment:
+		table = "scraped_comments"
+	case DataTypeMention:
+		table = "mentions"
+	}
+
+	if table != "" {
+		deleted, err := deleteRows(ctx, db.TenantScope(tenantID), r.client.Query(table).Where("scraped_at", "lt", cutoff))
 		if err != nil {
-			return i, fmt.Errorf("failed to save data item at index %d: %w", i, err)
+			return purged, fmt.Errorf("failed to purge %s: %w", table, err)
 		}
+		purged += deleted
+	}
+
+	return purged, nil
+}
+
+// GetStorageUsage counts the rows a tenant stores in each scraper table. Rows are counted by the
+// database and only the oldest and newest row of each table or data type are fetched.
+func (r *SupabaseScraperRepository) GetStorageUsage(ctx context.Context, tenantID string) ([]StorageUsage, error) {
+	tables := []struct {
+		name      string
+		timestamp string
+		byType    bool
+	}{
+		{name: "scraped_data", timestamp: "scraped_at", byType: true},
+		{name: "scraped_comments", timestamp: "scraped_at"},
+		{name: "mentions", timestamp: "scraped_at"},
+		{name: "scraper_job_runs", timestamp: "started_at"},
+	}
+
+	var usage []StorageUsage
+	for _, table := range tables {
+		if !table.byType {
+			u, err := r.tableUsage(ctx, tenantID, table.name, table.timestamp, DataTypeUnspecified)
+			if err != nil {
+				return nil, err
+			}
+			usage = append(usage, u)
+			continue
+		}
+
+		found := false
+		for dataType := DataTypeProfile; dataType <= DataTypeMention; dataType++ {
+			u, err := r.tableUsage(ctx, tenantID, table.name, table.timestamp, dataType)
+			if err != nil {
+				return nil, err
+			}
+			if u.Rows > 0 {
+				usage = append(usage, u)
+				found = true
+			}
+		}
+
+		// Tables without rows are still reported
+		if !found {
+			usage = append(usage, StorageUsage{Table: table.name})
+		}
+	}
+
+	return usage, nil
+}
+
+// tableUsage counts a tenant's rows in a table, only those of a data type unless it is unspecified,
+// and looks up when the oldest and newest of them were stored
+func (r *SupabaseScraperRepository) tableUsage(ctx context.Context, tenantID, table, timestamp string,
+	dataType DataType) (StorageUsage, error) {
+
+	type storedRow struct {
+		At time.Time `json:"at"`
+	}
+
+	query := func(desc bool) *db.QueryBuilder {
+		q := r.client.Query(table).
+			Select("at:"+timestamp).
+			Where("tenant_id", "eq", tenantID)
+		if dataType != DataTypeUnspecified {
+			q = q.Where("data_type", "eq", dataType.String())
+		}
+		return q.Order(timestamp, desc).Limit(1)
+	}
+
+	usage := StorageUsage{Table: table, DataType: dataType}
+
+	var oldest []storedRow
+	count, err := query(false).ExecuteWithCount(ctx, &oldest)
+	if err != nil {
+		return usage, fmt.Errorf("failed to get storage usage of %s: %w", table, err)
+	}
+	if count < 0 {
+		return usage, fmt.Errorf("failed to get storage usage of %s: row count not returned", table)
+	}
+	if count == 0 || len(oldest) == 0 {
+		return usage, nil
+	}
+
+	var newest []storedRow
+	if err := query(true).Execute(ctx, &newest); err != nil {
+		return usage, fmt.Errorf("failed to get storage usage of %s: %w", table, err)
+	}
+
+	usage.Rows = count
+	usage.OldestAt = oldest[0].At
+	if len(newest) > 0 {
+		usage.NewestAt = newest[0].At
+	}
+
+	return usage, nil
+}
+
+// SaveWebhookDelivery records the delivery of a webhook event. Deliveries are unique per platform and
+// event ID, so when the same event is delivered more than once only the first delivery is recorded
+// and the others get ErrDuplicateDelivery.
+func (r *SupabaseScraperRepository) SaveWebhookDelivery(ctx context.Context, delivery *WebhookDelivery) (*WebhookDelivery, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	delivery.CreatedAt = time.Now()
+
+	err := r.client.Insert(ctx, "webhook_deliveries", delivery)
+	if errors.Is(err, db.ErrConflict) {
+		return nil, ErrDuplicateDelivery
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to save webhook delivery: %w", err)
+	}
+
+	return delivery, nil
+}
+
+// CompleteWebhookDelivery records how many items the events of a delivery stored
+func (r *SupabaseScraperRepository) CompleteWebhookDelivery(ctx context.Context, deliveryID string, itemsStored int) error {
+	_, err := r.client.Update(ctx, db.SystemScope(), "webhook_deliveries", "id", deliveryID, map[string]interface{}{
+		"items_stored": itemsStored,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to complete webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteWebhookDelivery forgets a delivery, so the event is stored when it is delivered again
+func (r *SupabaseScraperRepository) DeleteWebhookDelivery(ctx context.Context, deliveryID string) error {
+	_, err := r.client.Delete(ctx, db.SystemScope(), "webhook_deliveries", "id", deliveryID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeWebhookDeliveries deletes the deliveries received before the given time and returns how many were deleted.
+// Deliveries aren't stored per tenant, so the purge is a system operation.
+func (r *SupabaseScraperRepository) PurgeWebhookDeliveries(ctx context.Context, before time.Time) (int, error) {
+	purged, err := deleteRows(ctx, db.SystemScope(), r.client.Query("webhook_deliveries").
+		Where("received_at", "lt", before.Format(time.RFC3339)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge webhook deliveries: %w", err)
+	}
+
+	return purged, nil
+}
+
+// GetMediaAssets retrieves a page of the captured media of a tenant, most recent first.
+// The platform, target and post are optional filters, and the zero page retrieves all of them.
+func (r *SupabaseScraperRepository) GetMediaAssets(ctx context.Context, tenantID, platform, targetID, postID string,
+	page db.Page) ([]MediaAsset, db.PageInfo, error) {
+
+	query := r.client.Query("media_assets").
+		Select("*").
+		Where("tenant_id", "eq", tenantID)
+
+	if platform != "" {
+		query = query.Where("platform", "eq", platform)
+	}
+
+	if targetID != "" {
+		query = query.Where("target_id", "eq", targetID)
 	}
 
-	return len(data), nil
+	if postID != "" {
+		query = query.Where("post_id", "eq", postID)
+	}
+
+	var assets []MediaAsset
+	info, err := query.Order("captured_at", true).ExecutePage(ctx, page, &assets)
+	if err != nil {
+		return nil, db.PageInfo{}, fmt.Errorf("failed to get media assets: %w", err)
+	}
+
+	return assets, info, nil
+}
+
+// GetMediaAsset retrieves a captured media asset by ID
+func (r *SupabaseScraperRepository) GetMediaAsset(ctx context.Context, tenantID, assetID string) (*MediaAsset, error) {
+	var assets []MediaAsset
+	err := r.client.Query("media_assets").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("id", "eq", assetID).
+		Execute(ctx, &assets)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media asset: %w", err)
+	}
+
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("media asset %w", db.ErrNotFound)
+	}
+
+	return &assets[0], nil
+}
+
+// GetMediaAssetBySource retrieves the asset captured from a URL.
+// It returns nil without an error when the URL hasn't been captured.
+func (r *SupabaseScraperRepository) GetMediaAssetBySource(ctx context.Context, tenantID, sourceURL string) (*MediaAsset, error) {
+	var assets []MediaAsset
+	err := r.client.Query("media_assets").
+		Select("*").
+		Where("tenant_id", "eq", tenantID).
+		Where("source_url", "eq", sourceURL).
+		Limit(1).
+		Execute(ctx, &assets)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get media asset: %w", err)
+	}
+
+	if len(assets) == 0 {
+		return nil, nil
+	}
+
+	return &assets[0], nil
+}
+
+// SaveMediaAsset records a captured media asset
+func (r *SupabaseScraperRepository) SaveMediaAsset(ctx context.Context, asset *MediaAsset) (*MediaAsset, error) {
+	if asset.ID == "" {
+		asset.ID = uuid.New().String()
+	}
+	asset.CreatedAt = time.Now()
+
+	err := r.client.Insert(ctx, "media_assets", asset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save media asset: %w", err)
+	}
+
+	return asset, nil
+}
+
+// DeleteMediaAssets deletes a tenant's captured media assets and returns them, so the caller can release
+// their copies in the media store. The job and the capture time are optional filters: an empty job ID
+// matches every job, and the zero time matches assets captured at any time.
+func (r *SupabaseScraperRepository) DeleteMediaAssets(ctx context.Context, tenantID, jobID string, before time.Time) ([]MediaAsset, error) {
+	query := r.client.Query("media_assets").Select("*")
+
+	if jobID != "" {
+		query = query.Where("job_id", "eq", jobID)
+	}
+
+	if !before.IsZero() {
+		query = query.Where("captured_at", "lt", before.Format(time.RFC3339))
+	}
+
+	var assets []MediaAsset
+	if err := query.Delete(ctx, db.TenantScope(tenantID), &assets); err != nil {
+		return nil, fmt.Errorf("failed to delete media assets: %w", err)
+	}
+
+	return assets, nil
+}
+
+// MediaStorageKeyInUse reports whether any asset, of any tenant, still refers to a key in the media
+// store. Identical files share a key, so a copy can only be removed once nothing refers to it.
+func (r *SupabaseScraperRepository) MediaStorageKeyInUse(ctx context.Context, storageKey string) (bool, error) {
+	var assets []MediaAsset
+	err := r.client.Query("media_assets").
+		Select("id").
+		Where("storage_key", "eq", storageKey).
+		Limit(1).
+		Execute(ctx, &assets)
+
+	if err != nil {
+		return false, fmt.Errorf("failed to look up media storage key: %w", err)
+	}
+
+	return len(assets) > 0, nil
+}
+
+// deleteRows deletes the rows matching a query within scope and returns how many were deleted
+func deleteRows(ctx context.Context, scope db.Scope, query *db.QueryBuilder) (int, error) {
+	var deleted []struct {
+		ID string `json:"id"`
+	}
+	if err := query.Select("id").Delete(ctx, scope, &deleted); err != nil {
+		return 0, err
+	}
+	return len(deleted), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
 	"github.com/donaldnash/go-competitor/scraper/pb"
 	"github.com/donaldnash/go-competitor/scraper/repository"
 	"github.com/donaldnash/go-competitor/scraper/service"
@@ -65,10 +66,16 @@ func (s *ScraperServer) CreateScraperJob(ctx context.Context, req *pb.CreateScra
 		}
 	}
 
+	var backfillUntil time.Time
+	if req.BackfillUntil != nil {
+		backfillUntil = req.BackfillUntil.AsTime()
+	}
+
 	// Create the job
-	job, err := s.service.CreateScraperJob(ctx, req.TenantId, req.Platform, req.TargetId, jobType, schedule, req.Metadata)
+	job, err := s.service.CreateScraperJob(ctx, req.TenantId, req.Platform, req.TargetId, jobType, schedule, int(req.Priority),
+		req.Metadata, backfillUntil)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert the job to protobuf format
@@ -85,12 +92,12 @@ func (s *ScraperServer) GetScraperJob(ctx context.Context, req *pb.GetScraperJob
 		return nil, status.Error(codes.InvalidArgument, "job ID is required")
 	}
 
-	job, err := s.service.GetScraperJob(ctx, req.TenantId, req.JobId)
+	tree, err := s.service.GetScraperJobTree(ctx, req.TenantId, req.JobId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
-	return convertJobToProto(job), nil
+	return convertJobTreeToProto(tree), nil
 }
 
 // ListScraperJobs handles the ListScraperJobs RPC call
@@ -103,9 +110,10 @@ func (s *ScraperServer) ListScraperJobs(ctx context.Context, req *pb.ListScraper
 	jobType := convertJobTypeFromProto(req.JobType)
 	jobStatus := convertJobStatusFromProto(req.Status)
 
-	jobs, err := s.service.GetScraperJobs(ctx, req.TenantId, req.Platform, jobType, jobStatus)
+	jobs, info, err := s.service.GetScraperJobs(ctx, req.TenantId, req.Platform, jobType, jobStatus,
+		db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert jobs to protobuf format
@@ -115,7 +123,9 @@ func (s *ScraperServer) ListScraperJobs(ctx context.Context, req *pb.ListScraper
 	}
 
 	return &pb.ListScraperJobsResponse{
-		Jobs: protoJobs,
+		Jobs:          protoJobs,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
 	}, nil
 }
 
@@ -131,7 +141,7 @@ func (s *ScraperServer) CancelScraperJob(ctx context.Context, req *pb.CancelScra
 
 	job, err := s.service.CancelScraperJob(ctx, req.TenantId, req.JobId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return convertJobToProto(job), nil
@@ -149,12 +159,60 @@ func (s *ScraperServer) DeleteScraperJob(ctx context.Context, req *pb.DeleteScra
 
 	err := s.service.DeleteScraperJob(ctx, req.TenantId, req.JobId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// RequeueScraperJob handles the RequeueScraperJob RPC call
+func (s *ScraperServer) RequeueScraperJob(ctx context.Context, req *pb.RequeueScraperJobRequest) (*pb.ScraperJob, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if req.JobId == "" {
+		return nil, status.Error(codes.InvalidArgument, "job ID is required")
+	}
+
+	job, err := s.service.RequeueScraperJob(ctx, req.TenantId, req.JobId)
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	return convertJobToProto(job), nil
+}
+
+// WatchScraperJobs handles the WatchScraperJobs RPC call, streaming job events until the client disconnects
+func (s *ScraperServer) WatchScraperJobs(req *pb.WatchScraperJobsRequest, stream pb.ScraperService_WatchScraperJobsServer) error {
+	if req.TenantId == "" {
+		return status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	filter := service.JobFilter{
+		TenantID: req.TenantId,
+		Platform: req.Platform,
+		JobID:    req.JobId,
+	}
+
+	watch, err := s.service.WatchScraperJobs(stream.Context(), filter)
+	if err != nil {
+		return status.Error(db.Code(err), err.Error())
+	}
+
+	for event := range watch.Events() {
+		if err := stream.Send(convertJobEventToProto(&event)); err != nil {
+			return err
+		}
+	}
+
+	if err := watch.Err(); err != nil {
+		return status.Error(db.Code(err), err.Error())
+	}
+
+	return nil
+}
+
 // ListSupportedPlatforms handles the ListSupportedPlatforms RPC call
 func (s *ScraperServer) ListSupportedPlatforms(ctx context.Context, req *pb.ListSupportedPlatformsRequest) (*pb.ListSupportedPlatformsResponse, error) {
 	platforms := s.service.GetSupportedPlatforms(ctx)
@@ -197,7 +255,7 @@ func (s *ScraperServer) GetPlatformStatus(ctx context.Context, req *pb.GetPlatfo
 
 	platformStatus, err := s.service.GetPlatformStatus(ctx, req.Platform)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert status to protobuf format
@@ -221,6 +279,39 @@ func (s *ScraperServer) GetPlatformStatus(ctx context.Context, req *pb.GetPlatfo
 	return protoStatus, nil
 }
 
+// GetQueueStatus reports the tenant's queued and running jobs along with the totals across all tenants
+func (s *ScraperServer) GetQueueStatus(ctx context.Context, req *pb.GetQueueStatusRequest) (*pb.QueueStatus, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	queueStatus := s.service.GetQueueStatus(ctx, req.TenantId)
+
+	// Convert status to protobuf format
+	protoStatus := &pb.QueueStatus{
+		Workers: int32(queueStatus.Workers),
+		Queued:  int32(queueStatus.Queued),
+		Running: int32(queueStatus.Running),
+		Tenants: make([]*pb.TenantQueueStatus, len(queueStatus.Tenants)),
+	}
+
+	for i, tenant := range queueStatus.Tenants {
+		protoStatus.Tenants[i] = &pb.TenantQueueStatus{
+			TenantId:          tenant.TenantID,
+			Tier:              tenant.Tier,
+			Weight:            int32(tenant.Weight),
+			MaxConcurrent:     int32(tenant.MaxConcurrent),
+			Queued:            int32(tenant.Queued),
+			Running:           int32(tenant.Running),
+			OldestWaitSeconds: tenant.OldestWait.Seconds(),
+			AvgWaitSeconds:    tenant.AvgWait.Seconds(),
+			Dispatched:        int32(tenant.Dispatched),
+		}
+	}
+
+	return protoStatus, nil
+}
+
 // GetScrapedData handles the GetScrapedData RPC call
 func (s *ScraperServer) GetScrapedData(ctx context.Context, req *pb.GetScrapedDataRequest) (*pb.GetScrapedDataResponse, error) {
 	if req.TenantId == "" {
@@ -240,9 +331,10 @@ func (s *ScraperServer) GetScrapedData(ctx context.Context, req *pb.GetScrapedDa
 		endDate = req.EndDate.AsTime()
 	}
 
-	items, err := s.service.GetScrapedData(ctx, req.TenantId, req.JobId, startDate, endDate)
+	items, info, err := s.service.GetScrapedData(ctx, req.TenantId, req.JobId, startDate, endDate,
+		db.RequestedPage(req.PageSize, req.PageToken))
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(db.Code(err), err.Error())
 	}
 
 	// Convert items to protobuf format
@@ -252,7 +344,311 @@ func (s *ScraperServer) GetScrapedData(ctx context.Context, req *pb.GetScrapedDa
 	}
 
 	return &pb.GetScrapedDataResponse{
-		Items: protoItems,
+		Items:         protoItems,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
+	}, nil
+}
+
+// ListComments handles the ListComments RPC call
+func (s *ScraperServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post ID is required")
+	}
+
+	var startDate, endDate time.Time
+	if req.StartDate != nil {
+		startDate = req.StartDate.AsTime()
+	}
+
+	if req.EndDate != nil {
+		endDate = req.EndDate.AsTime()
+	}
+
+	comments, info, err := s.service.ListComments(ctx, req.TenantId, req.Platform, req.PostId, startDate, endDate,
+		db.RequestedPage(req.PageSize, req.PageToken))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert comments to protobuf format
+	protoComments := make([]*pb.Comment, len(comments))
+	for i, comment := range comments {
+		protoComments[i] = convertCommentToProto(&comment)
+	}
+
+	return &pb.ListCommentsResponse{
+		Comments:      protoComments,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
+	}, nil
+}
+
+// ListJobRuns handles the ListJobRuns RPC call
+func (s *ScraperServer) ListJobRuns(ctx context.Context, req *pb.ListJobRunsRequest) (*pb.ListJobRunsResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if req.JobId == "" {
+		return nil, status.Error(codes.InvalidArgument, "job ID is required")
+	}
+
+	runs, info, err := s.service.ListJobRuns(ctx, req.TenantId, req.JobId, db.RequestedPage(req.PageSize, req.PageToken))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert runs to protobuf format
+	protoRuns := make([]*pb.JobRun, len(runs))
+	for i, run := range runs {
+		protoRuns[i] = convertJobRunToProto(&run)
+	}
+
+	return &pb.ListJobRunsResponse{
+		Runs:          protoRuns,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
+	}, nil
+}
+
+// ListMentions retrieves the mentions found by keyword, hashtag and mention tracking jobs
+func (s *ScraperServer) ListMentions(ctx context.Context, req *pb.ListMentionsRequest) (*pb.ListMentionsResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	var startDate, endDate time.Time
+	if req.StartDate != nil {
+		startDate = req.StartDate.AsTime()
+	}
+
+	if req.EndDate != nil {
+		endDate = req.EndDate.AsTime()
+	}
+
+	mentions, info, err := s.service.ListMentions(ctx, req.TenantId, req.Platform, startDate, endDate,
+		db.RequestedPage(req.PageSize, req.PageToken))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert mentions to protobuf format
+	protoMentions := make([]*pb.Mention, len(mentions))
+	for i, mention := range mentions {
+		protoMentions[i] = convertMentionToProto(&mention)
+	}
+
+	return &pb.ListMentionsResponse{
+		Mentions:      protoMentions,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
+	}, nil
+}
+
+// GetShareOfVoice compares mentions of the tenant's brand and its competitors over time
+func (s *ScraperServer) GetShareOfVoice(ctx context.Context, req *pb.GetShareOfVoiceRequest) (*pb.GetShareOfVoiceResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	var startDate, endDate time.Time
+	if req.StartDate != nil {
+		startDate = req.StartDate.AsTime()
+	}
+
+	if req.EndDate != nil {
+		endDate = req.EndDate.AsTime()
+	}
+
+	shares, err := s.service.GetShareOfVoice(ctx, req.TenantId, req.Platform, req.Interval, startDate, endDate)
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert shares to protobuf format
+	protoShares := make([]*pb.ShareOfVoice, len(shares))
+	for i, share := range shares {
+		protoShares[i] = &pb.ShareOfVoice{
+			PeriodStart:     timestamppb.New(share.PeriodStart),
+			CompetitorId:    share.CompetitorID,
+			OwnBrand:        share.OwnBrand,
+			Mentions:        int32(share.Mentions),
+			Engagement:      int32(share.Engagement),
+			MentionShare:    share.MentionShare,
+			EngagementShare: share.EngagementShare,
+		}
+	}
+
+	return &pb.GetShareOfVoiceResponse{
+		Shares: protoShares,
+	}, nil
+}
+
+// GetPostVelocity reports how fast a target's posts gained engagement, from the snapshots stored each time their counters changed
+func (s *ScraperServer) GetPostVelocity(ctx context.Context, req *pb.GetPostVelocityRequest) (*pb.GetPostVelocityResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if req.Platform == "" || req.TargetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "platform and target ID are required")
+	}
+
+	var startDate, endDate time.Time
+	if req.StartDate != nil {
+		startDate = req.StartDate.AsTime()
+	}
+
+	if req.EndDate != nil {
+		endDate = req.EndDate.AsTime()
+	}
+
+	velocities, err := s.service.GetPostVelocity(ctx, req.TenantId, req.Platform, req.TargetId, req.PostId, startDate, endDate)
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert velocities to protobuf format
+	protoPosts := make([]*pb.PostVelocity, len(velocities))
+	for i, velocity := range velocities {
+		protoPosts[i] = convertPostVelocityToProto(&velocity)
+	}
+
+	return &pb.GetPostVelocityResponse{
+		Posts: protoPosts,
+	}, nil
+}
+
+// ListRetentionPolicies handles the ListRetentionPolicies RPC call
+func (s *ScraperServer) ListRetentionPolicies(ctx context.Context, req *pb.ListRetentionPoliciesRequest) (*pb.ListRetentionPoliciesResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	policies, err := s.service.ListRetentionPolicies(ctx, req.TenantId)
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert policies to protobuf format
+	protoPolicies := make([]*pb.RetentionPolicy, len(policies))
+	for i := range policies {
+		protoPolicies[i] = convertRetentionPolicyToProto(&policies[i])
+	}
+
+	return &pb.ListRetentionPoliciesResponse{
+		Policies: protoPolicies,
+	}, nil
+}
+
+// SetRetentionPolicy handles the SetRetentionPolicy RPC call
+func (s *ScraperServer) SetRetentionPolicy(ctx context.Context, req *pb.SetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	policy, err := s.service.SetRetentionPolicy(ctx, req.TenantId, convertDataTypeFromProto(req.DataType), int(req.RetentionDays))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	return convertRetentionPolicyToProto(policy), nil
+}
+
+// GetStorageUsage reports how many rows the tenant stores in each scraper table
+func (s *ScraperServer) GetStorageUsage(ctx context.Context, req *pb.GetStorageUsageRequest) (*pb.GetStorageUsageResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	usage, err := s.service.GetStorageUsage(ctx, req.TenantId)
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert usage to protobuf format
+	resp := &pb.GetStorageUsageResponse{
+		Usage: make([]*pb.StorageUsage, len(usage)),
+	}
+
+	for i, u := range usage {
+		protoUsage := &pb.StorageUsage{
+			Table:    u.Table,
+			DataType: convertDataTypeToProto(u.DataType),
+			Rows:     int64(u.Rows),
+		}
+
+		if !u.OldestAt.IsZero() {
+			protoUsage.OldestAt = timestamppb.New(u.OldestAt)
+		}
+
+		if !u.NewestAt.IsZero() {
+			protoUsage.NewestAt = timestamppb.New(u.NewestAt)
+		}
+
+		resp.Usage[i] = protoUsage
+		resp.TotalRows += int64(u.Rows)
+	}
+
+	return resp, nil
+}
+
+// ListMediaAssets handles the ListMediaAssets RPC call
+func (s *ScraperServer) ListMediaAssets(ctx context.Context, req *pb.ListMediaAssetsRequest) (*pb.ListMediaAssetsResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	assets, info, err := s.service.ListMediaAssets(ctx, req.TenantId, req.Platform, req.TargetId, req.PostId,
+		db.RequestedPage(req.PageSize, req.PageToken))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert assets to protobuf format
+	protoAssets := make([]*pb.MediaAsset, len(assets))
+	for i, asset := range assets {
+		protoAssets[i] = convertMediaAssetToProto(&asset)
+	}
+
+	return &pb.ListMediaAssetsResponse{
+		Assets:        protoAssets,
+		NextPageToken: info.NextToken,
+		TotalCount:    int32(info.TotalCount),
+	}, nil
+}
+
+// FindSimilarMedia finds the captured images that look like a given one, such as a creative reused by several competitors
+func (s *ScraperServer) FindSimilarMedia(ctx context.Context, req *pb.FindSimilarMediaRequest) (*pb.FindSimilarMediaResponse, error) {
+	if req.TenantId == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant ID is required")
+	}
+
+	if req.AssetId == "" {
+		return nil, status.Error(codes.InvalidArgument, "asset ID is required")
+	}
+
+	matches, err := s.service.FindSimilarMedia(ctx, req.TenantId, req.AssetId, int(req.MaxDistance))
+	if err != nil {
+		return nil, status.Error(db.Code(err), err.Error())
+	}
+
+	// Convert matches to protobuf format
+	protoMatches := make([]*pb.SimilarMedia, len(matches))
+	for i, match := range matches {
+		protoMatches[i] = &pb.SimilarMedia{
+			Asset:    convertMediaAssetToProto(&match.Asset),
+			Distance: int32(match.Distance),
+		}
+	}
+
+	return &pb.FindSimilarMediaResponse{
+		Matches: protoMatches,
 	}, nil
 }
 
@@ -271,6 +667,12 @@ func convertJobTypeFromProto(jobType pb.ScraperJobType) repository.JobType {
 		return repository.JobTypeComments
 	case pb.ScraperJobType_JOB_TYPE_FOLLOWERS:
 		return repository.JobTypeFollowers
+	case pb.ScraperJobType_JOB_TYPE_KEYWORD:
+		return repository.JobTypeKeyword
+	case pb.ScraperJobType_JOB_TYPE_HASHTAG:
+		return repository.JobTypeHashtag
+	case pb.ScraperJobType_JOB_TYPE_MENTIONS:
+		return repository.JobTypeMentions
 	default:
 		return repository.JobTypeUnspecified
 	}
@@ -289,6 +691,12 @@ func convertJobTypeToProto(jobType repository.JobType) pb.ScraperJobType {
 		return pb.ScraperJobType_JOB_TYPE_COMMENTS
 	case repository.JobTypeFollowers:
 		return pb.ScraperJobType_JOB_TYPE_FOLLOWERS
+	case repository.JobTypeKeyword:
+		return pb.ScraperJobType_JOB_TYPE_KEYWORD
+	case repository.JobTypeHashtag:
+		return pb.ScraperJobType_JOB_TYPE_HASHTAG
+	case repository.JobTypeMentions:
+		return pb.ScraperJobType_JOB_TYPE_MENTIONS
 	default:
 		return pb.ScraperJobType_JOB_TYPE_UNSPECIFIED
 	}
@@ -318,6 +726,8 @@ func convertJobStatusFromProto(status pb.ScraperJobStatus) repository.JobStatus
 		return repository.JobStatusFailed
 	case pb.ScraperJobStatus_JOB_STATUS_CANCELLED:
 		return repository.JobStatusCancelled
+	case pb.ScraperJobStatus_JOB_STATUS_DEAD_LETTER:
+		return repository.JobStatusDeadLetter
 	default:
 		return repository.JobStatusUnspecified
 	}
@@ -338,6 +748,8 @@ func convertJobStatusToProto(status repository.JobStatus) pb.ScraperJobStatus {
 		return pb.ScraperJobStatus_JOB_STATUS_FAILED
 	case repository.JobStatusCancelled:
 		return pb.ScraperJobStatus_JOB_STATUS_CANCELLED
+	case repository.JobStatusDeadLetter:
+		return pb.ScraperJobStatus_JOB_STATUS_DEAD_LETTER
 	default:
 		return pb.ScraperJobStatus_JOB_STATUS_UNSPECIFIED
 	}
@@ -388,11 +800,49 @@ func convertDataTypeToProto(dataType repository.DataType) pb.ScraperDataType {
 		return pb.ScraperDataType_DATA_TYPE_COMMENT
 	case repository.DataTypeFollower:
 		return pb.ScraperDataType_DATA_TYPE_FOLLOWER
+	case repository.DataTypeMention:
+		return pb.ScraperDataType_DATA_TYPE_MENTION
 	default:
 		return pb.ScraperDataType_DATA_TYPE_UNSPECIFIED
 	}
 }
 
+// convertDataTypeFromProto converts a data type from protobuf to repository format
+func convertDataTypeFromProto(dataType pb.ScraperDataType) repository.DataType {
+	switch dataType {
+	case pb.ScraperDataType_DATA_TYPE_PROFILE:
+		return repository.DataTypeProfile
+	case pb.ScraperDataType_DATA_TYPE_POST:
+		return repository.DataTypePost
+	case pb.ScraperDataType_DATA_TYPE_STORY:
+		return repository.DataTypeStory
+	case pb.ScraperDataType_DATA_TYPE_COMMENT:
+		return repository.DataTypeComment
+	case pb.ScraperDataType_DATA_TYPE_FOLLOWER:
+		return repository.DataTypeFollower
+	case pb.ScraperDataType_DATA_TYPE_MENTION:
+		return repository.DataTypeMention
+	default:
+		return repository.DataTypeUnspecified
+	}
+}
+
+// convertRunStatusToProto converts a run status from repository to protobuf format
+func convertRunStatusToProto(runStatus repository.RunStatus) pb.JobRunStatus {
+	switch runStatus {
+	case repository.RunStatusRunning:
+		return pb.JobRunStatus_RUN_STATUS_RUNNING
+	case repository.RunStatusCompleted:
+		return pb.JobRunStatus_RUN_STATUS_COMPLETED
+	case repository.RunStatusFailed:
+		return pb.JobRunStatus_RUN_STATUS_FAILED
+	case repository.RunStatusDeferred:
+		return pb.JobRunStatus_RUN_STATUS_DEFERRED
+	default:
+		return pb.JobRunStatus_RUN_STATUS_UNSPECIFIED
+	}
+}
+
 // convertJobToProto converts a job from repository to protobuf format
 func convertJobToProto(job *repository.ScraperJob) *pb.ScraperJob {
 	if job == nil {
@@ -410,9 +860,14 @@ func convertJobToProto(job *repository.ScraperJob) *pb.ScraperJob {
 			CronExpression: job.Schedule.CronExpression,
 			Frequency:      convertScheduleFrequencyToProto(job.Schedule.Frequency),
 		},
-		LastError: job.LastError,
-		RunCount:  int32(job.RunCount),
-		Metadata:  job.Metadata,
+		LastError:   job.LastError,
+		RunCount:    int32(job.RunCount),
+		Attempts:    int32(job.Attempts),
+		Priority:    int32(job.Priority),
+		Metadata:    job.Metadata,
+		ClaimedBy:   job.ClaimedBy,
+		ParentId:    job.ParentID,
+		ParentRunId: job.ParentRunID,
 	}
 
 	// Convert timestamps if present
@@ -440,9 +895,61 @@ func convertJobToProto(job *repository.ScraperJob) *pb.ScraperJob {
 		protoJob.UpdatedAt = timestamppb.New(job.UpdatedAt)
 	}
 
+	if !job.LeaseExpiresAt.IsZero() {
+		protoJob.LeaseExpiresAt = timestamppb.New(job.LeaseExpiresAt)
+	}
+
+	if job.Backfill != nil {
+		protoJob.Backfill = convertBackfillToProto(job.Backfill)
+	}
+
 	return protoJob
 }
 
+// convertBackfillToProto converts a backfill checkpoint from repository to protobuf format
+func convertBackfillToProto(backfill *repository.BackfillState) *pb.BackfillProgress {
+	progress := &pb.BackfillProgress{
+		Until:           timestamppb.New(backfill.Until),
+		StartedAt:       timestamppb.New(backfill.StartedAt),
+		Pages:           int32(backfill.Pages),
+		Posts:           int32(backfill.Posts),
+		PercentComplete: backfill.PercentComplete(),
+	}
+
+	if !backfill.OldestPostAt.IsZero() {
+		progress.OldestPostAt = timestamppb.New(backfill.OldestPostAt)
+	}
+
+	if !backfill.CompletedAt.IsZero() {
+		progress.CompletedAt = timestamppb.New(backfill.CompletedAt)
+	}
+
+	return progress
+}
+
+// convertJobTreeToProto converts a job tree from service to protobuf format
+func convertJobTreeToProto(tree *service.JobTree) *pb.ScraperJob {
+	protoJob := convertJobToProto(tree.Job)
+	protoJob.RollupStatus = convertJobStatusToProto(tree.RollupStatus)
+
+	for i := range tree.Children {
+		protoJob.Children = append(protoJob.Children, convertJobTreeToProto(&tree.Children[i]))
+	}
+
+	return protoJob
+}
+
+// convertJobEventToProto converts a job event from service to protobuf format
+func convertJobEventToProto(event *service.JobEvent) *pb.ScraperJobEvent {
+	return &pb.ScraperJobEvent{
+		Job:          convertJobToProto(&event.Job),
+		RunId:        event.RunID,
+		ItemsScraped: int32(event.ItemsScraped),
+		Error:        event.Error,
+		OccurredAt:   timestamppb.New(event.OccurredAt),
+	}
+}
+
 // convertDataItemToProto converts a data item from repository to protobuf format
 func convertDataItemToProto(item *repository.ScrapedDataItem) *pb.ScrapedDataItem {
 	if item == nil {
@@ -466,6 +973,7 @@ func convertDataItemToProto(item *repository.ScrapedDataItem) *pb.ScrapedDataIte
 		ContentType:       item.ContentType,
 		ContentUrl:        item.ContentURL,
 		ContentAttributes: item.ContentAttributes,
+		RunId:             item.RunID,
 	}
 
 	// Convert timestamps if present
@@ -483,3 +991,184 @@ func convertDataItemToProto(item *repository.ScrapedDataItem) *pb.ScrapedDataIte
 
 	return protoItem
 }
+
+// convertJobRunToProto converts a job run from repository to protobuf format
+func convertJobRunToProto(run *repository.JobRun) *pb.JobRun {
+	if run == nil {
+		return nil
+	}
+
+	protoRun := &pb.JobRun{
+		Id:           run.ID,
+		JobId:        run.JobID,
+		TenantId:     run.TenantID,
+		RunNumber:    int32(run.RunNumber),
+		Attempt:      int32(run.Attempt),
+		Status:       convertRunStatusToProto(run.Status),
+		ItemsScraped: int32(run.ItemsScraped),
+		RequestsUsed: int32(run.RequestsUsed),
+		Error:        run.Error,
+	}
+
+	// Convert timestamps if present
+	if !run.StartedAt.IsZero() {
+		protoRun.StartedAt = timestamppb.New(run.StartedAt)
+	}
+
+	if !run.FinishedAt.IsZero() {
+		protoRun.FinishedAt = timestamppb.New(run.FinishedAt)
+	}
+
+	return protoRun
+}
+
+// convertCommentToProto converts a comment from repository to protobuf format
+func convertCommentToProto(comment *repository.Comment) *pb.Comment {
+	if comment == nil {
+		return nil
+	}
+
+	protoComment := &pb.Comment{
+		Id:         comment.ID,
+		TenantId:   comment.TenantID,
+		JobId:      comment.JobID,
+		RunId:      comment.RunID,
+		Platform:   comment.Platform,
+		TargetId:   comment.TargetID,
+		PostId:     comment.PostID,
+		CommentId:  comment.CommentID,
+		ParentId:   comment.ParentID,
+		AuthorId:   comment.AuthorID,
+		AuthorName: comment.AuthorName,
+		Text:       comment.Text,
+		Likes:      int32(comment.Likes),
+	}
+
+	// Convert timestamps if present
+	if !comment.PostedAt.IsZero() {
+		protoComment.PostedAt = timestamppb.New(comment.PostedAt)
+	}
+
+	if !comment.ScrapedAt.IsZero() {
+		protoComment.ScrapedAt = timestamppb.New(comment.ScrapedAt)
+	}
+
+	return protoComment
+}
+
+// convertMentionToProto converts a mention from repository to protobuf format
+func convertMentionToProto(mention *repository.Mention) *pb.Mention {
+	if mention == nil {
+		return nil
+	}
+
+	protoMention := &pb.Mention{
+		Id:           mention.ID,
+		TenantId:     mention.TenantID,
+		JobId:        mention.JobID,
+		RunId:        mention.RunID,
+		Platform:     mention.Platform,
+		JobType:      convertJobTypeToProto(mention.JobType),
+		Query:        mention.Query,
+		PostId:       mention.PostID,
+		Url:          mention.URL,
+		AuthorId:     mention.AuthorID,
+		AuthorName:   mention.AuthorName,
+		Text:         mention.Text,
+		Likes:        int32(mention.Likes),
+		Shares:       int32(mention.Shares),
+		Comments:     int32(mention.Comments),
+		Views:        int32(mention.Views),
+		CompetitorId: mention.CompetitorID,
+		OwnBrand:     mention.OwnBrand,
+	}
+
+	// Convert timestamps if present
+	if !mention.PostedAt.IsZero() {
+		protoMention.PostedAt = timestamppb.New(mention.PostedAt)
+	}
+
+	if !mention.ScrapedAt.IsZero() {
+		protoMention.ScrapedAt = timestamppb.New(mention.ScrapedAt)
+	}
+
+	return protoMention
+}
+
+// convertPostVelocityToProto converts a post's velocity from service to protobuf format
+func convertPostVelocityToProto(velocity *service.PostVelocity) *pb.PostVelocity {
+	protoVelocity := &pb.PostVelocity{
+		Platform:        velocity.Platform,
+		TargetId:        velocity.TargetID,
+		PostId:          velocity.PostID,
+		Likes:           int32(velocity.Likes),
+		Shares:          int32(velocity.Shares),
+		Comments:        int32(velocity.Comments),
+		Views:           int32(velocity.Views),
+		LikesPerHour:    velocity.LikesPerHour,
+		SharesPerHour:   velocity.SharesPerHour,
+		CommentsPerHour: velocity.CommentsPerHour,
+		Snapshots:       make([]*pb.PostSnapshot, len(velocity.Snapshots)),
+	}
+
+	if !velocity.PostedAt.IsZero() {
+		protoVelocity.PostedAt = timestamppb.New(velocity.PostedAt)
+	}
+
+	for i, snapshot := range velocity.Snapshots {
+		protoVelocity.Snapshots[i] = &pb.PostSnapshot{
+			ScrapedAt:       timestamppb.New(snapshot.ScrapedAt),
+			Likes:           int32(snapshot.Likes),
+			Shares:          int32(snapshot.Shares),
+			Comments:        int32(snapshot.Comments),
+			Views:           int32(snapshot.Views),
+			LikesPerHour:    snapshot.LikesPerHour,
+			SharesPerHour:   snapshot.SharesPerHour,
+			CommentsPerHour: snapshot.CommentsPerHour,
+		}
+	}
+
+	return protoVelocity
+}
+
+// convertRetentionPolicyToProto converts a retention policy from service to protobuf format
+func convertRetentionPolicyToProto(policy *service.RetentionPolicy) *pb.RetentionPolicy {
+	protoPolicy := &pb.RetentionPolicy{
+		DataType:      convertDataTypeToProto(policy.DataType),
+		RetentionDays: int32(policy.RetentionDays),
+		IsDefault:     policy.Default,
+	}
+
+	if !policy.UpdatedAt.IsZero() {
+		protoPolicy.UpdatedAt = timestamppb.New(policy.UpdatedAt)
+	}
+
+	return protoPolicy
+}
+
+// convertMediaAssetToProto converts a media asset from repository to protobuf format
+func convertMediaAssetToProto(asset *repository.MediaAsset) *pb.MediaAsset {
+	protoAsset := &pb.MediaAsset{
+		Id:             asset.ID,
+		TenantId:       asset.TenantID,
+		JobId:          asset.JobID,
+		Platform:       asset.Platform,
+		TargetId:       asset.TargetID,
+		PostId:         asset.PostID,
+		SourceUrl:      asset.SourceURL,
+		StorageKey:     asset.StorageKey,
+		ContentType:    asset.ContentType,
+		SizeBytes:      asset.SizeBytes,
+		Width:          int32(asset.Width),
+		Height:         int32(asset.Height),
+		Duration:       asset.Duration,
+		Sha256:         asset.SHA256,
+		PerceptualHash: asset.PerceptualHash,
+	}
+
+	if !asset.CapturedAt.IsZero() {
+		protoAsset.CapturedAt = timestamppb.New(asset.CapturedAt)
+	}
+
+	return protoAsset
+}
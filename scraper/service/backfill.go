@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// backfillPagesPerRun is how many pages of post history a backfill walks through in one run.
+// Running in chunks keeps each run well within the job timeout and gives other jobs a turn
+// at the platform's request budget.
+const backfillPagesPerRun = 5
+
+// newBackfill validates a backfill date and returns the initial checkpoint of a job's backfill
+func newBackfill(jobType repository.JobType, until time.Time) (*repository.BackfillState, error) {
+	if jobType != repository.JobTypePosts {
+		return nil, fmt.Errorf("only posts jobs can backfill, not %s jobs", jobType.String())
+	}
+
+	now := time.Now()
+	if !until.Before(now) {
+		return nil, errors.New("backfill date must be in the past")
+	}
+
+	return &repository.BackfillState{Until: until, StartedAt: now}, nil
+}
+
+// backfilling returns whether a job still has post history to walk through
+func backfilling(job *repository.ScraperJob) bool {
+	return job.Backfill != nil && !job.Backfill.Completed()
+}
+
+// copyBackfill returns a copy of a job's backfill checkpoint, or nil if the job isn't a backfill
+func copyBackfill(job *repository.ScraperJob) *repository.BackfillState {
+	if job.Backfill == nil {
+		return nil
+	}
+	checkpoint := *job.Backfill
+	return &checkpoint
+}
+
+// backfillPosts walks back through a target's post history from the job's checkpoint, a chunk of
+// pages at a time, and moves the checkpoint past the pages it collected. Every page is a request
+// against the platform's rate limit; when a later page is rejected or fails, the pages collected
+// so far are kept and the next run continues from there.
+func backfillPosts(ctx context.Context, api PlatformAPI, job *repository.ScraperJob,
+	pageSize int) ([]repository.ScrapedDataItem, error) {
+
+	state := job.Backfill
+
+	var collected []platform.Post
+	for page := 0; page < backfillPagesPerRun; page++ {
+		posts, next, err := api.GetPostsPage(ctx, job.TargetID, state.Cursor, pageSize)
+		if err != nil {
+			if page == 0 {
+				return nil, fmt.Errorf("failed to get posts: %w", err)
+			}
+			log.Printf("Pausing backfill of scraper job %s after %d pages: %v", job.ID, page, err)
+			break
+		}
+
+		reachedUntil := false
+		for _, post := range posts {
+			// An undated post says nothing about how far back the backfill has reached
+			if post.PostedAt.IsZero() {
+				collected = append(collected, post)
+				continue
+			}
+			if post.PostedAt.Before(state.Until) {
+				reachedUntil = true
+				continue
+			}
+			if state.OldestPostAt.IsZero() || post.PostedAt.Before(state.OldestPostAt) {
+				state.OldestPostAt = post.PostedAt
+			}
+			collected = append(collected, post)
+		}
+
+		state.Pages++
+		state.Cursor = next
+
+		if next == "" || reachedUntil || len(posts) == 0 {
+			state.Cursor = ""
+			state.CompletedAt = time.Now()
+			break
+		}
+	}
+
+	state.Posts += len(collected)
+	return postItems(job, collected), nil
+}
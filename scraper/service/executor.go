@@ -0,0 +1,581 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+const (
+	// defaultWorkerCount is the number of jobs that can run concurrently
+	defaultWorkerCount = 4
+
+	// tenantQueueSize is the number of due jobs each tenant can have waiting for a free worker
+	tenantQueueSize = 100
+
+	// dispatchSpec is how often the scheduler looks for due jobs
+	dispatchSpec = "*/15 * * * * *"
+
+	// jobTimeout bounds a single job execution
+	jobTimeout = 5 * time.Minute
+
+	// defaultItemLimit is used when a job doesn't set "post_limit" in its metadata
+	defaultItemLimit = 20
+)
+
+// startWorkers starts the worker pool that executes queued jobs
+func (s *ScraperService) startWorkers(count int) {
+	for i := 0; i < count; i++ {
+		s.workers.Add(1)
+		go func() {
+			defer s.workers.Done()
+			for {
+				job := s.queue.pop()
+				if job == nil {
+					return
+				}
+				s.runJob(job)
+			}
+		}()
+	}
+}
+
+// dispatchDueJobs requeues jobs that lost their worker and queues every job whose next run time has passed
+func (s *ScraperService) dispatchDueJobs() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	s.requeueExpiredJobs(ctx)
+
+	jobs, err := s.repo.GetDueScraperJobs(ctx, time.Now())
+	if err != nil {
+		log.Printf("Error loading due scraper jobs: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		s.enqueue(&jobs[i])
+	}
+}
+
+// enqueue hands a job to the worker pool unless it is already queued or running.
+// When the tenant's queue is full the job is left for the next dispatch.
+func (s *ScraperService) enqueue(job *repository.ScraperJob) {
+	tier, limits := s.tenantTier(job.TenantID)
+	if !s.queue.push(job, tier, limits) {
+		log.Printf("Job queue of tenant %s is full, job %s will be retried on the next dispatch", job.TenantID, job.ID)
+	}
+}
+
+// runJob claims a job, executes it and records the outcome. Other replicas may have queued the
+// same job, so it only runs if the claim succeeds, and the claim is kept alive with heartbeats
+// until the outcome has been recorded.
+func (s *ScraperService) runJob(queued *repository.ScraperJob) {
+	defer s.queue.done(queued.ID)
+
+	// Only the scrape itself is bounded by the job timeout, so a run that times out can still record its outcome
+	ctx := context.Background()
+
+	// Reload the job so cancellations made while it was queued are respected
+	job, err := s.repo.GetScraperJob(ctx, queued.TenantID, queued.ID)
+	if err != nil {
+		log.Printf("Error loading scraper job %s: %v", queued.ID, err)
+		return
+	}
+
+	if job.Status != repository.JobStatusPending && job.Status != repository.JobStatusScheduled {
+		return
+	}
+
+	// The job may have run and been rescheduled by another replica while it was queued here
+	startedAt := time.Now()
+	if job.NextRunAt.IsZero() || job.NextRunAt.After(startedAt) {
+		return
+	}
+
+	// Claim the job and mark it as running
+	job, err = s.repo.ClaimScraperJob(ctx, job, s.workerID, startedAt.Add(leaseDuration))
+	if errors.Is(err, repository.ErrLeaseNotHeld) {
+		// Another replica got to it first, or it changed since it was reloaded
+		return
+	}
+	if err != nil {
+		log.Printf("Error claiming scraper job %s: %v", queued.ID, err)
+		return
+	}
+	job.LastRunAt = startedAt
+
+	leaseCtx, releaseLease := s.holdLease(job.TenantID, job.ID)
+	defer releaseLease()
+
+	// Record the run. The job still runs if this fails, it just won't show up in the history.
+	run := &repository.JobRun{
+		JobID:     job.ID,
+		TenantID:  job.TenantID,
+		RunNumber: job.RunCount + 1,
+		Attempt:   job.Attempts + 1,
+		Status:    repository.RunStatusRunning,
+		StartedAt: job.LastRunAt,
+	}
+	if created, err := s.repo.CreateJobRun(ctx, run); err != nil {
+		log.Printf("Error recording run of scraper job %s: %v", job.ID, err)
+	} else {
+		run = created
+	}
+	s.publishJobEvent(job, run)
+
+	// Backfills only move their checkpoint past pages whose posts have been stored, and
+	// conditional requests only keep the validators of responses that have been stored
+	checkpoint := copyBackfill(job)
+	validators := job.Validators
+
+	// Collect and store the data
+	execCtx, cancel := context.WithTimeout(leaseCtx, jobTimeout)
+	items, err := s.executeJob(execCtx, job, run)
+	cancel()
+
+	// A job whose lease was lost has been requeued, and its run recorded as failed, by another replica
+	if leaseCtx.Err() != nil {
+		log.Printf("Discarding results of scraper job %s after losing its lease", job.ID)
+		return
+	}
+	if err == nil && len(items) > 0 {
+		for i := range items {
+			items[i].RunID = run.ID
+		}
+
+		// Posts are only stored again when their counters changed, so each stored post is a new snapshot
+		if changed := s.changedSnapshots(ctx, job, items); len(changed) > 0 {
+			s.captureMedia(ctx, job, changed)
+			run.ItemsScraped, err = s.repo.SaveScrapedData(ctx, job.TenantID, changed)
+		}
+	}
+
+	// Comments are also kept in their own table so conversations can be queried by post
+	if comments := commentsFromItems(items); err == nil && len(comments) > 0 {
+		_, err = s.repo.SaveComments(ctx, job.TenantID, comments)
+	}
+
+	// Tracked mentions are kept in their own table for share-of-voice reports
+	if mentions := mentionsFromItems(job, items); err == nil && len(mentions) > 0 {
+		_, err = s.repo.SaveMentions(ctx, job.TenantID, mentions)
+	}
+
+	if err != nil {
+		job.Backfill = checkpoint
+		job.Validators = validators
+	}
+
+	// Feed the scraped posts into competitor or personal metrics. A failure here
+	// doesn't fail the run since the raw data has already been stored.
+	if err == nil && s.normalizer != nil {
+		if _, normErr := s.normalizer.Normalize(ctx, job, items); normErr != nil {
+			log.Printf("Error normalizing data for scraper job %s: %v", job.ID, normErr)
+		}
+	}
+
+	// Pipelines continue with child jobs for what this run found
+	if err == nil {
+		s.spawnChildJobs(ctx, job, run, items)
+	}
+
+	s.finishRun(ctx, run, err)
+
+	// Stop the heartbeat before releasing the job so it can't race with the release
+	releaseLease()
+
+	// Jobs that ran out of request budget are deferred until it refills
+	var rateErr *RateLimitError
+	if errors.As(err, &rateErr) {
+		job.Status = repository.JobStatusScheduled
+		job.NextRunAt = rateErr.RetryAt
+		job.LastError = err.Error()
+		if released, err := s.repo.ReleaseScraperJob(ctx, job, s.workerID); err != nil {
+			log.Printf("Error deferring scraper job %s: %v", job.ID, err)
+		} else {
+			s.publishJobEvent(released, run)
+		}
+		return
+	}
+
+	// Transient failures are retried with backoff until the job runs out of attempts
+	if err != nil && isTransient(err) {
+		job.Attempts++
+		job.LastError = err.Error()
+
+		policy, _ := s.retryPolicy(job)
+		if job.Attempts < policy.MaxAttempts {
+			job.NextRunAt = time.Now().Add(retryDelay(policy, job.Attempts, err))
+			job.Status = statusForNextRun(job.NextRunAt)
+		} else {
+			// Park the job until someone requeues it
+			job.NextRunAt = time.Time{}
+			job.Status = repository.JobStatusDeadLetter
+		}
+
+		if released, err := s.repo.ReleaseScraperJob(ctx, job, s.workerID); err != nil {
+			log.Printf("Error scheduling retry of scraper job %s: %v", job.ID, err)
+		} else {
+			s.publishJobEvent(released, run)
+		}
+		return
+	}
+
+	// Record the outcome
+	job.RunCount++
+	job.Attempts = 0
+	if err != nil {
+		job.LastError = err.Error()
+	} else {
+		job.LastError = ""
+	}
+
+	// Recurring jobs go back to waiting for their next run, the rest are finished.
+	// Backfills that haven't reached their date carry on with the next chunk right away.
+	job.NextRunAt = s.nextRunAfterRun(job)
+	if err == nil && backfilling(job) {
+		job.NextRunAt = time.Now()
+	}
+	switch {
+	case !job.NextRunAt.IsZero():
+		job.Status = statusForNextRun(job.NextRunAt)
+	case err != nil:
+		job.Status = repository.JobStatusFailed
+	default:
+		job.Status = repository.JobStatusCompleted
+	}
+
+	if released, err := s.repo.ReleaseScraperJob(ctx, job, s.workerID); err != nil {
+		log.Printf("Error updating scraper job %s after run: %v", job.ID, err)
+	} else {
+		s.publishJobEvent(released, run)
+	}
+}
+
+// finishRun records the outcome of a run
+func (s *ScraperService) finishRun(ctx context.Context, run *repository.JobRun, err error) {
+	run.FinishedAt = time.Now()
+	switch {
+	case errors.Is(err, ErrRateLimited):
+		run.Status = repository.RunStatusDeferred
+		run.Error = err.Error()
+	case err != nil:
+		run.Status = repository.RunStatusFailed
+		run.Error = err.Error()
+	default:
+		run.Status = repository.RunStatusCompleted
+	}
+
+	// Runs that couldn't be recorded when they started have nothing to update
+	if run.ID == "" {
+		return
+	}
+
+	if _, err := s.repo.UpdateJobRun(ctx, run); err != nil {
+		log.Printf("Error recording outcome of run %s: %v", run.ID, err)
+	}
+}
+
+// executeJob calls the platform API that matches the job type and converts the results to data items.
+// The requests made against the platform's rate limit are recorded on the run.
+func (s *ScraperService) executeJob(ctx context.Context, job *repository.ScraperJob, run *repository.JobRun) ([]repository.ScrapedDataItem, error) {
+	platformAPI, exists := s.platformAPI[job.Platform]
+	if !exists {
+		return nil, fmt.Errorf("platform not supported: %s", job.Platform)
+	}
+
+	api := &countingAPI{api: platformAPI}
+	defer func() {
+		run.RequestsUsed = api.requests
+	}()
+
+	limit := itemLimit(job)
+
+	switch job.JobType {
+	case repository.JobTypeProfile:
+		profile, err := api.GetProfile(ctx, job.TargetID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get profile: %w", err)
+		}
+		return []repository.ScrapedDataItem{profileItem(job, profile)}, nil
+
+	case repository.JobTypePosts:
+		if backfilling(job) {
+			return backfillPosts(ctx, api, job, limit)
+		}
+		posts, validators, err := platform.GetPostsIfModified(ctx, api, job.TargetID, limit, lastValidators(job))
+		if errors.Is(err, platform.ErrNotModified) {
+			// Nothing has been posted since the last run
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get posts: %w", err)
+		}
+		job.Validators = responseValidators(validators)
+		return postItems(job, posts), nil
+
+	case repository.JobTypeEngagement:
+		postID := job.Metadata["post_id"]
+		if postID == "" {
+			return nil, errors.New("engagement jobs require a post_id in metadata")
+		}
+		engagement, err := api.GetEngagement(ctx, job.TargetID, postID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get engagement: %w", err)
+		}
+		return []repository.ScrapedDataItem{engagementItem(job, engagement)}, nil
+
+	case repository.JobTypeComments:
+		postID := job.Metadata["post_id"]
+		if postID == "" {
+			return nil, errors.New("comment jobs require a post_id in metadata")
+		}
+		comments, err := api.GetComments(ctx, job.TargetID, postID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comments: %w", err)
+		}
+		return commentItems(job, comments), nil
+
+	case repository.JobTypeFollowers:
+		followers, err := api.GetFollowers(ctx, job.TargetID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get followers: %w", err)
+		}
+		return followerItems(job, followers), nil
+
+	case repository.JobTypeKeyword:
+		posts, err := api.SearchPosts(ctx, job.TargetID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search posts: %w", err)
+		}
+		return mentionItems(job, posts), nil
+
+	case repository.JobTypeHashtag:
+		posts, err := api.GetHashtagPosts(ctx, job.TargetID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hashtag posts: %w", err)
+		}
+		return mentionItems(job, posts), nil
+
+	case repository.JobTypeMentions:
+		posts, err := api.GetMentions(ctx, job.TargetID, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mentions: %w", err)
+		}
+		return mentionItems(job, posts), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported job type: %s", job.JobType.String())
+	}
+}
+
+// itemLimit returns the number of items a job should fetch
+func itemLimit(job *repository.ScraperJob) int {
+	if limit, err := strconv.Atoi(job.Metadata["post_limit"]); err == nil && limit > 0 {
+		return limit
+	}
+	return defaultItemLimit
+}
+
+// lastValidators returns the validators of the last posts response stored for a job
+func lastValidators(job *repository.ScraperJob) platform.Validators {
+	if job.Validators == nil {
+		return platform.Validators{}
+	}
+	return platform.Validators{ETag: job.Validators.ETag, LastModified: job.Validators.LastModified}
+}
+
+// responseValidators converts the validators of a response to the form kept on a job, or nil if it had none
+func responseValidators(validators platform.Validators) *repository.ResponseValidators {
+	if validators == (platform.Validators{}) {
+		return nil
+	}
+	return &repository.ResponseValidators{ETag: validators.ETag, LastModified: validators.LastModified}
+}
+
+// newDataItem creates an empty data item for a job
+func newDataItem(job *repository.ScraperJob, dataType repository.DataType) repository.ScrapedDataItem {
+	return repository.ScrapedDataItem{
+		JobID:             job.ID,
+		TenantID:          job.TenantID,
+		Platform:          job.Platform,
+		TargetID:          job.TargetID,
+		DataType:          dataType,
+		ContentAttributes: make(map[string]string),
+		ScrapedAt:         time.Now(),
+	}
+}
+
+// profileItem converts a profile to a data item. The follower count is kept as the
+// "followers" attribute, which the normalizer uses to compute engagement rates.
+func profileItem(job *repository.ScraperJob, profile *platform.Profile) repository.ScrapedDataItem {
+	item := newDataItem(job, repository.DataTypeProfile)
+	item.ContentURL = profile.URL
+	item.ContentAttributes["id"] = profile.ID
+	item.ContentAttributes["username"] = profile.Username
+	item.ContentAttributes["display_name"] = profile.DisplayName
+	item.ContentAttributes["bio"] = profile.Bio
+	item.ContentAttributes["verified"] = strconv.FormatBool(profile.Verified)
+	item.ContentAttributes["followers"] = strconv.Itoa(profile.Followers)
+	item.ContentAttributes["following"] = strconv.Itoa(profile.Following)
+	item.ContentAttributes["post_count"] = strconv.Itoa(profile.PostCount)
+	return item
+}
+
+// postItems converts posts to data items
+func postItems(job *repository.ScraperJob, posts []platform.Post) []repository.ScrapedDataItem {
+	items := make([]repository.ScrapedDataItem, len(posts))
+	for i, post := range posts {
+		item := newDataItem(job, repository.DataTypePost)
+		item.PostID = post.ID
+		item.PostedAt = post.PostedAt
+		item.Likes = post.Likes
+		item.Shares = post.Shares
+		item.Comments = post.Comments
+		item.ContentType = post.ContentType
+		item.ContentURL = post.URL
+		item.ContentAttributes["text"] = post.Text
+		item.ContentAttributes["views"] = strconv.Itoa(post.Views)
+		if post.MediaURL != "" {
+			item.ContentAttributes["media_url"] = post.MediaURL
+		}
+		if post.Duration > 0 {
+			item.ContentAttributes["duration"] = strconv.FormatFloat(post.Duration, 'f', -1, 64)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+// engagementItem converts the engagement counters of a post to a data item
+func engagementItem(job *repository.ScraperJob, engagement *platform.Engagement) repository.ScrapedDataItem {
+	item := newDataItem(job, repository.DataTypePost)
+	item.PostID = engagement.PostID
+	item.Likes = engagement.Likes
+	item.Shares = engagement.Shares
+	item.Comments = engagement.Comments
+	item.ContentAttributes["views"] = strconv.Itoa(engagement.Views)
+	return item
+}
+
+// followerItems converts followers to data items
+func followerItems(job *repository.ScraperJob, followers []platform.Follower) []repository.ScrapedDataItem {
+	items := make([]repository.ScrapedDataItem, len(followers))
+	for i, follower := range followers {
+		item := newDataItem(job, repository.DataTypeFollower)
+		item.ContentAttributes["id"] = follower.ID
+		item.ContentAttributes["username"] = follower.Username
+		item.ContentAttributes["display_name"] = follower.DisplayName
+		item.ContentAttributes["followers"] = strconv.Itoa(follower.Followers)
+		items[i] = item
+	}
+	return items
+}
+
+// commentItems converts comments to data items
+func commentItems(job *repository.ScraperJob, comments []platform.Comment) []repository.ScrapedDataItem {
+	items := make([]repository.ScrapedDataItem, len(comments))
+	for i, comment := range comments {
+		item := newDataItem(job, repository.DataTypeComment)
+		item.PostID = comment.PostID
+		item.PostedAt = comment.PostedAt
+		item.Likes = comment.Likes
+		item.ContentAttributes["id"] = comment.ID
+		item.ContentAttributes["parent_id"] = comment.ParentID
+		item.ContentAttributes["author_id"] = comment.AuthorID
+		item.ContentAttributes["author_name"] = comment.AuthorName
+		item.ContentAttributes["text"] = comment.Text
+		items[i] = item
+	}
+	return items
+}
+
+// commentsFromItems extracts the comments from a run's data items
+func commentsFromItems(items []repository.ScrapedDataItem) []repository.Comment {
+	var comments []repository.Comment
+	for _, item := range items {
+		if item.DataType != repository.DataTypeComment {
+			continue
+		}
+
+		comments = append(comments, repository.Comment{
+			JobID:      item.JobID,
+			RunID:      item.RunID,
+			Platform:   item.Platform,
+			TargetID:   item.TargetID,
+			PostID:     item.PostID,
+			CommentID:  item.ContentAttributes["id"],
+			ParentID:   item.ContentAttributes["parent_id"],
+			AuthorID:   item.ContentAttributes["author_id"],
+			AuthorName: item.ContentAttributes["author_name"],
+			Text:       item.ContentAttributes["text"],
+			Likes:      item.Likes,
+			PostedAt:   item.PostedAt,
+			ScrapedAt:  item.ScrapedAt,
+		})
+	}
+	return comments
+}
+
+// mentionItems converts posts found by a keyword, hashtag or mention job to data items.
+// They are stored as mentions rather than posts so the normalizer doesn't treat them as the target's own posts.
+func mentionItems(job *repository.ScraperJob, posts []platform.Post) []repository.ScrapedDataItem {
+	items := make([]repository.ScrapedDataItem, len(posts))
+	for i, post := range posts {
+		item := newDataItem(job, repository.DataTypeMention)
+		item.PostID = post.ID
+		item.PostedAt = post.PostedAt
+		item.Likes = post.Likes
+		item.Shares = post.Shares
+		item.Comments = post.Comments
+		item.ContentType = post.ContentType
+		item.ContentURL = post.URL
+		item.ContentAttributes["author_id"] = post.AuthorID
+		item.ContentAttributes["author_name"] = post.AuthorName
+		item.ContentAttributes["text"] = post.Text
+		item.ContentAttributes["views"] = strconv.Itoa(post.Views)
+		items[i] = item
+	}
+	return items
+}
+
+// mentionsFromItems extracts the mentions from a run's data items. Jobs whose metadata sets
+// "own_account" to "true" count towards the tenant's brand and jobs with a "competitor_id" count
+// towards that competitor; other mentions are stored unattributed.
+func mentionsFromItems(job *repository.ScraperJob, items []repository.ScrapedDataItem) []repository.Mention {
+	var mentions []repository.Mention
+	for _, item := range items {
+		if item.DataType != repository.DataTypeMention {
+			continue
+		}
+
+		views, _ := strconv.Atoi(item.ContentAttributes["views"])
+		mentions = append(mentions, repository.Mention{
+			JobID:        item.JobID,
+			RunID:        item.RunID,
+			Platform:     item.Platform,
+			JobType:      job.JobType,
+			Query:        job.TargetID,
+			PostID:       item.PostID,
+			URL:          item.ContentURL,
+			AuthorID:     item.ContentAttributes["author_id"],
+			AuthorName:   item.ContentAttributes["author_name"],
+			Text:         item.ContentAttributes["text"],
+			Likes:        item.Likes,
+			Shares:       item.Shares,
+			Comments:     item.Comments,
+			Views:        views,
+			CompetitorID: job.Metadata["competitor_id"],
+			OwnBrand:     job.Metadata["own_account"] == "true",
+			PostedAt:     item.PostedAt,
+			ScrapedAt:    item.ScrapedAt,
+		})
+	}
+	return mentions
+}
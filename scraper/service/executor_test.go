@@ -0,0 +1,30 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func TestRunJobSkipsJobsThatAreNoLongerDue(t *testing.T) {
+	// Queued while due, then run and rescheduled by another replica before a worker got to it here
+	queued := repository.ScraperJob{
+		ID: "a1", TenantID: "tenant-a", Status: repository.JobStatusScheduled, NextRunAt: time.Now().Add(-time.Minute),
+	}
+	rescheduled := queued
+	rescheduled.NextRunAt = time.Now().Add(time.Hour)
+	rescheduled.RunCount = 1
+
+	repo := newFakeRepository(rescheduled)
+	s := newTestService(t, repo)
+
+	s.runJob(&queued)
+
+	if repo.claims != 0 {
+		t.Errorf("claimed a job that isn't due until %v", rescheduled.NextRunAt)
+	}
+	if job := repo.job("a1"); job.Status != repository.JobStatusScheduled || job.RunCount != 1 {
+		t.Errorf("job = %s after %d runs, want it left scheduled after 1", job.Status.String(), job.RunCount)
+	}
+}
@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/common/db"
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// fakeRepository keeps scraper jobs in memory and filters them the way the Supabase repository does.
+// Methods a test doesn't need fall through to the embedded nil interface and panic.
+type fakeRepository struct {
+	repository.ScraperRepository
+
+	mu     sync.Mutex
+	jobs   map[string]repository.ScraperJob
+	assets []repository.MediaAsset
+	purged map[string]int // Purge calls per tenant
+	claims int            // Successful claims
+
+	deliveries    map[string]repository.WebhookDelivery // By platform and event ID
+	targetLookups int                                   // Calls to GetScraperJobsByTarget
+	targetErr     error                                 // Returned once by GetScraperJobsByTarget
+}
+
+func newFakeRepository(jobs ...repository.ScraperJob) *fakeRepository {
+	f := &fakeRepository{
+		jobs:       make(map[string]repository.ScraperJob),
+		purged:     make(map[string]int),
+		deliveries: make(map[string]repository.WebhookDelivery),
+	}
+	for _, job := range jobs {
+		f.jobs[job.ID] = job
+	}
+	return f
+}
+
+// newTestService returns a service around a repository, with the registered platforms, without starting
+// its workers or scheduler
+func newTestService(t *testing.T, repo repository.ScraperRepository) *ScraperService {
+	t.Helper()
+
+	platforms, err := platform.NewRegistry(platform.Config{})
+	if err != nil {
+		t.Fatalf("NewRegistry() error = %v", err)
+	}
+
+	return &ScraperService{
+		repo:      repo,
+		platforms: platforms,
+		queue:     newJobQueue(tenantQueueSize),
+		workerID:  "test-worker",
+		events:    newJobBroker(),
+		tierCache: make(map[string]cachedTier),
+	}
+}
+
+// job returns the stored copy of a job
+func (f *fakeRepository) job(jobID string) repository.ScraperJob {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.jobs[jobID]
+}
+
+// list returns the stored jobs that match, ordered by ID
+func (f *fakeRepository) list(match func(job repository.ScraperJob) bool) []repository.ScraperJob {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var jobs []repository.ScraperJob
+	for _, job := range f.jobs {
+		if match(job) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs
+}
+
+func (f *fakeRepository) GetScraperJobs(ctx context.Context, tenantID, platform string, jobType repository.JobType,
+	status repository.JobStatus, page db.Page) ([]repository.ScraperJob, db.PageInfo, error) {
+
+	jobs := f.list(func(job repository.ScraperJob) bool {
+		return job.TenantID == tenantID &&
+			(platform == "" || job.Platform == platform) &&
+			(jobType == repository.JobTypeUnspecified || job.JobType == jobType) &&
+			(status == repository.JobStatusUnspecified || job.Status == status)
+	})
+	return jobs, db.PageInfo{}, nil
+}
+
+func (f *fakeRepository) GetScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error) {
+	job := f.job(jobID)
+	if job.ID == "" || job.TenantID != tenantID {
+		return nil, fmt.Errorf("scraper job %w", db.ErrNotFound)
+	}
+	return &job, nil
+}
+
+func (f *fakeRepository) CreateScraperJob(ctx context.Context, job *repository.ScraperJob) (*repository.ScraperJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	created := *job
+	if created.ID == "" {
+		created.ID = fmt.Sprintf("job-%d", len(f.jobs)+1)
+	}
+	f.jobs[created.ID] = created
+	return &created, nil
+}
+
+func (f *fakeRepository) UpdateScraperJob(ctx context.Context, job *repository.ScraperJob) (*repository.ScraperJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored, exists := f.jobs[job.ID]
+	if !exists || stored.TenantID != job.TenantID {
+		return nil, fmt.Errorf("scraper job %w", db.ErrNotFound)
+	}
+
+	updated := *job
+	updated.UpdatedAt = time.Now()
+	f.jobs[job.ID] = updated
+	return &updated, nil
+}
+
+func (f *fakeRepository) DeleteScraperJob(ctx context.Context, tenantID, jobID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if job, exists := f.jobs[jobID]; !exists || job.TenantID != tenantID {
+		return fmt.Errorf("scraper job %w", db.ErrNotFound)
+	}
+	delete(f.jobs, jobID)
+	return nil
+}
+
+func (f *fakeRepository) GetChildScraperJobs(ctx context.Context, tenantID, parentID string) ([]repository.ScraperJob, error) {
+	return f.list(func(job repository.ScraperJob) bool {
+		return job.TenantID == tenantID && job.ParentID == parentID
+	}), nil
+}
+
+func (f *fakeRepository) ListScraperJobsForWorker(ctx context.Context, status repository.JobStatus) ([]repository.ScraperJob, error) {
+	return f.list(func(job repository.ScraperJob) bool {
+		return status == repository.JobStatusUnspecified || job.Status == status
+	}), nil
+}
+
+func (f *fakeRepository) GetDueScraperJobs(ctx context.Context, before time.Time) ([]repository.ScraperJob, error) {
+	return f.list(func(job repository.ScraperJob) bool {
+		return (job.Status == repository.JobStatusPending || job.Status == repository.JobStatusScheduled) &&
+			!job.NextRunAt.After(before)
+	}), nil
+}
+
+func (f *fakeRepository) GetExpiredScraperJobs(ctx context.Context, before time.Time) ([]repository.ScraperJob, error) {
+	return f.list(func(job repository.ScraperJob) bool {
+		return job.Status == repository.JobStatusRunning && job.ClaimedBy != "" && job.LeaseExpiresAt.Before(before)
+	}), nil
+}
+
+func (f *fakeRepository) ClaimScraperJob(ctx context.Context, job *repository.ScraperJob, workerID string,
+	leaseUntil time.Time) (*repository.ScraperJob, error) {
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	stored, exists := f.jobs[job.ID]
+	if !exists || stored.TenantID != job.TenantID ||
+		(stored.Status != repository.JobStatusPending && stored.Status != repository.JobStatusScheduled) ||
+		stored.NextRunAt.After(now) || !stored.UpdatedAt.Equal(job.UpdatedAt) {
+		return nil, repository.ErrLeaseNotHeld
+	}
+
+	stored.Status = repository.JobStatusRunning
+	stored.ClaimedBy = workerID
+	stored.LeaseExpiresAt = leaseUntil
+	stored.UpdatedAt = now
+	f.jobs[job.ID] = stored
+	f.claims++
+	return &stored, nil
+}
+
+func (f *fakeRepository) GetRetentionPolicies(ctx context.Context, tenantID string) ([]repository.RetentionPolicy, error) {
+	return nil, nil
+}
+
+func (f *fakeRepository) PurgeScrapedData(ctx context.Context, tenantID string, dataType repository.DataType, before time.Time) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.purged[tenantID]++
+	return 0, nil
+}
+
+func (f *fakeRepository) PurgeWebhookDeliveries(ctx context.Context, before time.Time) (int, error) {
+	return 0, nil
+}
+
+func (f *fakeRepository) DeleteMediaAssets(ctx context.Context, tenantID, jobID string, before time.Time) ([]repository.MediaAsset, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var deleted, kept []repository.MediaAsset
+	for _, asset := range f.assets {
+		if asset.TenantID == tenantID && (jobID == "" || asset.JobID == jobID) &&
+			(before.IsZero() || asset.CapturedAt.Before(before)) {
+			deleted = append(deleted, asset)
+		} else {
+			kept = append(kept, asset)
+		}
+	}
+	f.assets = kept
+	return deleted, nil
+}
+
+func (f *fakeRepository) MediaStorageKeyInUse(ctx context.Context, storageKey string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, asset := range f.assets {
+		if asset.StorageKey == storageKey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeRepository) GetScraperJobsByTarget(ctx context.Context, platform string, targetIDs []string) ([]repository.ScraperJob, error) {
+	f.mu.Lock()
+	f.targetLookups++
+	err := f.targetErr
+	f.targetErr = nil
+	f.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return f.list(func(job repository.ScraperJob) bool {
+		return job.Platform == platform && slices.Contains(targetIDs, job.TargetID)
+	}), nil
+}
+
+func (f *fakeRepository) SaveWebhookDelivery(ctx context.Context, delivery *repository.WebhookDelivery) (*repository.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := delivery.Platform + "/" + delivery.EventID
+	if _, exists := f.deliveries[key]; exists {
+		return nil, repository.ErrDuplicateDelivery
+	}
+
+	saved := *delivery
+	saved.ID = key
+	f.deliveries[key] = saved
+	return &saved, nil
+}
+
+func (f *fakeRepository) CompleteWebhookDelivery(ctx context.Context, deliveryID string, itemsStored int) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delivery := f.deliveries[deliveryID]
+	delivery.ItemsStored = itemsStored
+	f.deliveries[deliveryID] = delivery
+	return nil
+}
+
+func (f *fakeRepository) DeleteWebhookDelivery(ctx context.Context, deliveryID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.deliveries, deliveryID)
+	return nil
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"github.com/donaldnash/go-competitor/common/db"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// leaseDuration is how long a worker's claim on a job lasts without a heartbeat.
+	// Running jobs whose lease has expired are assumed to have lost their worker and are requeued.
+	leaseDuration = 2 * time.Minute
+
+	// heartbeatInterval is how often a worker renews the lease on the job it is running
+	heartbeatInterval = 30 * time.Second
+)
+
+// newWorkerID returns an ID that identifies this replica's workers in job leases
+func newWorkerID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "scraper"
+	}
+	return hostname + "-" + uuid.New().String()[:8]
+}
+
+// holdLease renews the lease on a claimed job in the background until the returned cancel function
+// is called. The returned context is cancelled early if the lease is lost, which happens when the job
+// was requeued by another replica after this one missed its heartbeats.
+func (s *ScraperService) holdLease(tenantID, jobID string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		ticker := time.NewTicker(heartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := s.repo.RenewScraperJobLease(ctx, tenantID, jobID, s.workerID, time.Now().Add(leaseDuration))
+			switch {
+			case errors.Is(err, repository.ErrLeaseNotHeld):
+				log.Printf("Lost lease on scraper job %s", jobID)
+				cancel()
+				return
+			case err != nil && ctx.Err() == nil:
+				// The lease is still valid until it expires, so keep trying
+				log.Printf("Error renewing lease on scraper job %s: %v", jobID, err)
+			}
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// requeueExpiredJobs requeues running jobs whose worker stopped renewing their lease, usually
+// because its replica crashed or lost its connection. The interrupted run counts as a failed
+// attempt, so a job that keeps killing its worker ends up dead-lettered rather than retried forever.
+func (s *ScraperService) requeueExpiredJobs(ctx context.Context) {
+	now := time.Now()
+
+	jobs, err := s.repo.GetExpiredScraperJobs(ctx, now)
+	if err != nil {
+		log.Printf("Error loading scraper jobs with expired leases: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		job := &jobs[i]
+		worker := job.ClaimedBy
+
+		job.Attempts++
+		job.LastError = "worker " + worker + " stopped renewing its lease"
+
+		policy, _ := s.retryPolicy(job)
+		if job.Attempts < policy.MaxAttempts {
+			job.NextRunAt = now
+			job.Status = repository.JobStatusScheduled
+		} else {
+			job.NextRunAt = time.Time{}
+			job.Status = repository.JobStatusDeadLetter
+		}
+
+		// Another replica may have requeued the job first, or its worker may have come back
+		job, err = s.repo.ExpireScraperJobLease(ctx, job, now)
+		if errors.Is(err, repository.ErrLeaseNotHeld) {
+			continue
+		}
+		if err != nil {
+			log.Printf("Error requeueing scraper job %s: %v", jobs[i].ID, err)
+			continue
+		}
+
+		log.Printf("Requeued scraper job %s after the lease held by %s expired", job.ID, worker)
+		s.failInterruptedRun(ctx, job)
+		s.publishJobEvent(job, nil)
+	}
+}
+
+// failInterruptedRun marks the latest run of a requeued job as failed if it was left running
+func (s *ScraperService) failInterruptedRun(ctx context.Context, job *repository.ScraperJob) {
+	runs, _, err := s.repo.GetJobRuns(ctx, job.TenantID, job.ID, db.Page{Size: 1})
+	if err != nil {
+		log.Printf("Error loading runs of scraper job %s: %v", job.ID, err)
+		return
+	}
+
+	if len(runs) == 0 || runs[0].Status != repository.RunStatusRunning {
+		return
+	}
+
+	s.finishRun(ctx, &runs[0], errors.New(job.LastError))
+}
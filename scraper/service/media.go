@@ -0,0 +1,283 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/donaldnash/go-competitor/common/db"
+	"github.com/donaldnash/go-competitor/scraper/media"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+const (
+	// maxMediaSize caps how much media is downloaded for one post. Downloads are streamed to a
+	// temporary file, so this bounds disk use rather than memory.
+	maxMediaSize = 200 << 20
+
+	// mediaDownloadTimeout bounds the download of one piece of media
+	mediaDownloadTimeout = 2 * time.Minute
+
+	// defaultSimilarDistance is how many bits of two perceptual hashes may differ for the images to match
+	defaultSimilarDistance = 10
+)
+
+// SimilarMedia is a captured image that looks like another one
+type SimilarMedia struct {
+	Asset    repository.MediaAsset
+	Distance int // Bits the perceptual hashes differ in, 0 for the same image
+}
+
+// MediaCapturer downloads the media attached to scraped posts into a media store
+type MediaCapturer struct {
+	repo   repository.ScraperRepository
+	store  media.Store
+	client *http.Client
+}
+
+// NewMediaCapturer creates a new MediaCapturer that keeps media in the given store
+func NewMediaCapturer(repo repository.ScraperRepository, store media.Store) *MediaCapturer {
+	return &MediaCapturer{
+		repo:   repo,
+		store:  store,
+		client: &http.Client{Timeout: mediaDownloadTimeout},
+	}
+}
+
+// Capture downloads the media of each post that has a media URL and records it as an asset.
+// Media already captured from the same URL isn't downloaded again. Each post is annotated with
+// its asset's ID, size, dimensions, duration and perceptual hash. Posts whose media can't be
+// captured are logged and left as they are. It returns the number of posts annotated.
+func (c *MediaCapturer) Capture(ctx context.Context, job *repository.ScraperJob, items []repository.ScrapedDataItem) int {
+	captured := 0
+	for i := range items {
+		item := &items[i]
+		sourceURL := item.ContentAttributes["media_url"]
+		if item.DataType != repository.DataTypePost || sourceURL == "" {
+			continue
+		}
+
+		asset, err := c.repo.GetMediaAssetBySource(ctx, job.TenantID, sourceURL)
+		if err == nil && asset == nil {
+			asset, err = c.capture(ctx, job, item, sourceURL)
+		}
+		if err != nil {
+			log.Printf("Error capturing media of post %s for scraper job %s: %v", item.PostID, job.ID, err)
+			continue
+		}
+
+		annotateMedia(item, asset)
+		captured++
+	}
+
+	return captured
+}
+
+// capture downloads media, writes it to the store under its digest and records the asset
+func (c *MediaCapturer) capture(ctx context.Context, job *repository.ScraperJob, item *repository.ScrapedDataItem,
+	sourceURL string) (*repository.MediaAsset, error) {
+
+	file, size, contentType, err := c.download(ctx, sourceURL)
+	if err != nil {
+		return nil, err
+	}
+	defer removeTemp(file)
+
+	info, err := media.Probe(file, size, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/%s/%s%s", job.Platform, info.SHA256[:2], info.SHA256, media.Extension(info.ContentType))
+	if err := c.store.Put(ctx, key, io.NewSectionReader(file, 0, size)); err != nil {
+		return nil, err
+	}
+
+	return c.repo.SaveMediaAsset(ctx, &repository.MediaAsset{
+		TenantID:       job.TenantID,
+		JobID:          job.ID,
+		Platform:       job.Platform,
+		TargetID:       job.TargetID,
+		PostID:         item.PostID,
+		SourceURL:      sourceURL,
+		StorageKey:     key,
+		ContentType:    info.ContentType,
+		SizeBytes:      info.Size,
+		Width:          info.Width,
+		Height:         info.Height,
+		Duration:       info.Duration,
+		SHA256:         info.SHA256,
+		PerceptualHash: info.PerceptualHash,
+		CapturedAt:     time.Now(),
+	})
+}
+
+// download streams media into a temporary file, refusing anything larger than maxMediaSize.
+// It returns the file with its size and the content type the server sent; the caller removes
+// the file with removeTemp.
+func (c *MediaCapturer) download(ctx context.Context, sourceURL string) (*os.File, int64, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceURL, nil)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to create media request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to download media: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, "", fmt.Errorf("media download failed with status: %d", resp.StatusCode)
+	}
+
+	if resp.ContentLength > maxMediaSize {
+		return nil, 0, "", fmt.Errorf("media is larger than %d bytes", maxMediaSize)
+	}
+
+	file, err := os.CreateTemp("", "media-*")
+	if err != nil {
+		return nil, 0, "", fmt.Errorf("failed to create media file: %w", err)
+	}
+
+	size, err := io.Copy(file, io.LimitReader(resp.Body, maxMediaSize+1))
+	if err != nil {
+		removeTemp(file)
+		return nil, 0, "", fmt.Errorf("failed to download media: %w", err)
+	}
+	if size > maxMediaSize {
+		removeTemp(file)
+		return nil, 0, "", fmt.Errorf("media is larger than %d bytes", maxMediaSize)
+	}
+
+	return file, size, resp.Header.Get("Content-Type"), nil
+}
+
+// removeTemp closes and removes a temporary download
+func removeTemp(file *os.File) {
+	file.Close()
+	os.Remove(file.Name())
+}
+
+// release removes the copies of deleted assets from the media store. Identical files share a copy,
+// so copies that another asset still refers to are kept. Failures are logged, since the assets
+// themselves are already gone.
+func (c *MediaCapturer) release(ctx context.Context, assets []repository.MediaAsset) {
+	released := make(map[string]bool)
+	for _, asset := range assets {
+		if asset.StorageKey == "" || released[asset.StorageKey] {
+			continue
+		}
+		released[asset.StorageKey] = true
+
+		inUse, err := c.repo.MediaStorageKeyInUse(ctx, asset.StorageKey)
+		if err != nil {
+			log.Printf("Error checking media %s before removing it: %v", asset.StorageKey, err)
+			continue
+		}
+		if inUse {
+			continue
+		}
+
+		if err := c.store.Delete(ctx, asset.StorageKey); err != nil {
+			log.Printf("Error removing media %s: %v", asset.StorageKey, err)
+		}
+	}
+}
+
+// annotateMedia adds what was learned about a post's media to its attributes. Durations the
+// platform reported are kept, the others are filled in from the media itself.
+func annotateMedia(item *repository.ScrapedDataItem, asset *repository.MediaAsset) {
+	attributes := item.ContentAttributes
+	attributes["media_asset_id"] = asset.ID
+	attributes["media_type"] = asset.ContentType
+	attributes["media_size"] = strconv.FormatInt(asset.SizeBytes, 10)
+	if asset.Width > 0 && asset.Height > 0 {
+		attributes["media_width"] = strconv.Itoa(asset.Width)
+		attributes["media_height"] = strconv.Itoa(asset.Height)
+	}
+	if asset.Duration > 0 && attributes["duration"] == "" {
+		attributes["duration"] = strconv.FormatFloat(asset.Duration, 'f', -1, 64)
+	}
+	if asset.PerceptualHash != "" {
+		attributes["media_hash"] = asset.PerceptualHash
+	}
+}
+
+// captureMedia captures the media of a job's posts when the job's metadata sets "capture_media"
+// to "true" and the service has a media capturer
+func (s *ScraperService) captureMedia(ctx context.Context, job *repository.ScraperJob, items []repository.ScrapedDataItem) {
+	if s.media == nil || job.Metadata["capture_media"] != "true" {
+		return
+	}
+	s.media.Capture(ctx, job, items)
+}
+
+// deleteMedia deletes a tenant's media assets, optionally only those of one job or captured before a time,
+// and releases their copies in the media store. It returns the number of assets deleted.
+func (s *ScraperService) deleteMedia(ctx context.Context, tenantID, jobID string, before time.Time) (int, error) {
+	assets, err := s.repo.DeleteMediaAssets(ctx, tenantID, jobID, before)
+	if err != nil {
+		return 0, err
+	}
+
+	if s.media != nil {
+		s.media.release(ctx, assets)
+	}
+
+	return len(assets), nil
+}
+
+// ListMediaAssets retrieves a page of the media captured for a tenant, most recent first.
+// The platform, target and post are optional filters.
+func (s *ScraperService) ListMediaAssets(ctx context.Context, tenantID, platform, targetID, postID string,
+	page db.Page) ([]repository.MediaAsset, db.PageInfo, error) {
+
+	return s.repo.GetMediaAssets(ctx, tenantID, platform, targetID, postID, page)
+}
+
+// FindSimilarMedia finds the tenant's captured images whose perceptual hash is at most maxDistance bits
+// from the given asset's, closest first, across every platform and target. A maxDistance of 0 or less
+// uses defaultSimilarDistance. The asset itself is left out.
+func (s *ScraperService) FindSimilarMedia(ctx context.Context, tenantID, assetID string, maxDistance int) ([]SimilarMedia, error) {
+	asset, err := s.repo.GetMediaAsset(ctx, tenantID, assetID)
+	if err != nil {
+		return nil, err
+	}
+	if asset.PerceptualHash == "" {
+		return nil, fmt.Errorf("media asset %s has no perceptual hash", assetID)
+	}
+
+	if maxDistance <= 0 {
+		maxDistance = defaultSimilarDistance
+	}
+
+	assets, _, err := s.repo.GetMediaAssets(ctx, tenantID, "", "", "", db.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	var similar []SimilarMedia
+	for _, candidate := range assets {
+		if candidate.ID == asset.ID || candidate.PerceptualHash == "" {
+			continue
+		}
+		distance, err := media.Distance(asset.PerceptualHash, candidate.PerceptualHash)
+		if err != nil || distance > maxDistance {
+			continue
+		}
+		similar = append(similar, SimilarMedia{Asset: candidate, Distance: distance})
+	}
+
+	sort.SliceStable(similar, func(i, j int) bool {
+		return similar[i].Distance < similar[j].Distance
+	})
+
+	return similar, nil
+}
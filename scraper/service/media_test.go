@@ -0,0 +1,107 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/media"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// memoryStore is a media.Store that keeps media in memory
+type memoryStore struct {
+	mu    sync.Mutex
+	blobs map[string][]byte
+}
+
+func (m *memoryStore) Put(ctx context.Context, key string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.blobs[key] = data
+	return nil
+}
+
+func (m *memoryStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, exists := m.blobs[key]
+	if !exists {
+		return nil, media.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memoryStore) Delete(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.blobs, key)
+	return nil
+}
+
+func TestDeleteScraperJobReleasesItsMedia(t *testing.T) {
+	repo := newFakeRepository(
+		repository.ScraperJob{ID: "a1", TenantID: "tenant-a"},
+		repository.ScraperJob{ID: "a2", TenantID: "tenant-a", ParentID: "a1"},
+		repository.ScraperJob{ID: "b1", TenantID: "tenant-b"},
+	)
+	repo.assets = []repository.MediaAsset{
+		{ID: "m1", TenantID: "tenant-a", JobID: "a1", StorageKey: "instagram/aa/only-a1.jpg"},
+		{ID: "m2", TenantID: "tenant-a", JobID: "a2", StorageKey: "instagram/bb/child.jpg"},
+		// The same file captured by another tenant shares its copy
+		{ID: "m3", TenantID: "tenant-a", JobID: "a1", StorageKey: "instagram/cc/shared.jpg"},
+		{ID: "m4", TenantID: "tenant-b", JobID: "b1", StorageKey: "instagram/cc/shared.jpg"},
+	}
+	store := &memoryStore{blobs: map[string][]byte{
+		"instagram/aa/only-a1.jpg": {1},
+		"instagram/bb/child.jpg":   {2},
+		"instagram/cc/shared.jpg":  {3},
+	}}
+
+	s := newTestService(t, repo)
+	s.media = NewMediaCapturer(repo, store)
+
+	if err := s.DeleteScraperJob(context.Background(), "tenant-a", "a1"); err != nil {
+		t.Fatalf("DeleteScraperJob() error = %v", err)
+	}
+
+	if len(repo.assets) != 1 || repo.assets[0].ID != "m4" {
+		t.Errorf("remaining assets = %+v, want only m4", repo.assets)
+	}
+	if len(store.blobs) != 1 || store.blobs["instagram/cc/shared.jpg"] == nil {
+		t.Errorf("remaining media = %v, want only the shared copy", store.blobs)
+	}
+}
+
+func TestPurgeExpiredDataPurgesOldMedia(t *testing.T) {
+	old := time.Now().AddDate(0, 0, -defaultRetentionDays[repository.DataTypePost]-1)
+
+	repo := newFakeRepository(repository.ScraperJob{ID: "a1", TenantID: "tenant-a"})
+	repo.assets = []repository.MediaAsset{
+		{ID: "m1", TenantID: "tenant-a", JobID: "a1", StorageKey: "instagram/aa/old.jpg", CapturedAt: old},
+		{ID: "m2", TenantID: "tenant-a", JobID: "a1", StorageKey: "instagram/bb/new.jpg", CapturedAt: time.Now()},
+	}
+	store := &memoryStore{blobs: map[string][]byte{
+		"instagram/aa/old.jpg": {1},
+		"instagram/bb/new.jpg": {2},
+	}}
+
+	s := newTestService(t, repo)
+	s.media = NewMediaCapturer(repo, store)
+
+	s.purgeExpiredData()
+
+	if len(repo.assets) != 1 || repo.assets[0].ID != "m2" {
+		t.Errorf("remaining assets = %+v, want only m2", repo.assets)
+	}
+	if _, exists := store.blobs["instagram/aa/old.jpg"]; exists {
+		t.Error("media of the purged asset was kept")
+	}
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/donaldnash/go-competitor/common/db"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// ShareOfVoice is one brand's share of the attributed mentions in a period.
+// The tenant's own brand is reported with OwnBrand set and no competitor ID.
+type ShareOfVoice struct {
+	PeriodStart     time.Time
+	CompetitorID    string
+	OwnBrand        bool
+	Mentions        int
+	Engagement      int // Likes, shares and comments on the mentioning posts
+	MentionShare    float64
+	EngagementShare float64
+}
+
+// ListMentions retrieves a page of the tracked mentions posted within a date range, oldest first
+func (s *ScraperService) ListMentions(ctx context.Context, tenantID, platform string,
+	startDate, endDate time.Time, page db.Page) ([]repository.Mention, db.PageInfo, error) {
+
+	return s.repo.GetMentions(ctx, tenantID, platform, startDate, endDate, page)
+}
+
+// GetShareOfVoice compares how often the tenant's brand and each competitor are mentioned, per period.
+// Only mentions attributed to a brand count, and a post found by several queries counts once per brand.
+// The interval is "day", "week" or "month" and defaults to "day".
+func (s *ScraperService) GetShareOfVoice(ctx context.Context, tenantID, platform, interval string,
+	startDate, endDate time.Time) ([]ShareOfVoice, error) {
+
+	switch interval {
+	case "day", "week", "month":
+		// Valid interval
+	default:
+		interval = "day"
+	}
+
+	mentions, _, err := s.repo.GetMentions(ctx, tenantID, platform, startDate, endDate, db.Page{})
+	if err != nil {
+		return nil, err
+	}
+
+	type brandKey struct {
+		period       time.Time
+		competitorID string
+	}
+
+	seen := make(map[string]bool)
+	byBrand := make(map[brandKey]*ShareOfVoice)
+	totals := make(map[time.Time]*ShareOfVoice)
+
+	for _, m := range mentions {
+		if !m.OwnBrand && m.CompetitorID == "" {
+			continue
+		}
+
+		competitorID := m.CompetitorID
+		if m.OwnBrand {
+			competitorID = ""
+		}
+
+		postKey := competitorID + "|" + m.Platform + "|" + m.PostID
+		if seen[postKey] {
+			continue
+		}
+		seen[postKey] = true
+
+		period := periodStart(m.PostedAt, interval)
+		key := brandKey{period: period, competitorID: competitorID}
+
+		share, exists := byBrand[key]
+		if !exists {
+			share = &ShareOfVoice{
+				PeriodStart:  period,
+				CompetitorID: competitorID,
+				OwnBrand:     m.OwnBrand,
+			}
+			byBrand[key] = share
+		}
+
+		total, exists := totals[period]
+		if !exists {
+			total = &ShareOfVoice{PeriodStart: period}
+			totals[period] = total
+		}
+
+		engagement := m.Likes + m.Shares + m.Comments
+		share.Mentions++
+		share.Engagement += engagement
+		total.Mentions++
+		total.Engagement += engagement
+	}
+
+	shares := make([]ShareOfVoice, 0, len(byBrand))
+	for key, share := range byBrand {
+		total := totals[key.period]
+		share.MentionShare = float64(share.Mentions) / float64(total.Mentions)
+		if total.Engagement > 0 {
+			share.EngagementShare = float64(share.Engagement) / float64(total.Engagement)
+		}
+		shares = append(shares, *share)
+	}
+
+	// Oldest period first, with the tenant's own brand ahead of its competitors
+	sort.Slice(shares, func(i, j int) bool {
+		if !shares[i].PeriodStart.Equal(shares[j].PeriodStart) {
+			return shares[i].PeriodStart.Before(shares[j].PeriodStart)
+		}
+		if shares[i].OwnBrand != shares[j].OwnBrand {
+			return shares[i].OwnBrand
+		}
+		return shares[i].CompetitorID < shares[j].CompetitorID
+	})
+
+	return shares, nil
+}
+
+// periodStart returns the start of the day, week or month containing t, in UTC. Weeks start on Monday.
+func periodStart(t time.Time, interval string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case "week":
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
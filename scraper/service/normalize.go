@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	competitorrepo "github.com/donaldnash/go-competitor/competitor/repository"
+	engagementrepo "github.com/donaldnash/go-competitor/engagement/repository"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// CompetitorMetricsStore is the part of the competitor service the normalizer uses
+type CompetitorMetricsStore interface {
+	GetCompetitors(ctx context.Context, tenantID string) ([]competitorrepo.Competitor, error)
+	UpdateCompetitorMetrics(ctx context.Context, tenantID, competitorID string, metrics []competitorrepo.CompetitorMetric) (int, error)
+}
+
+// PersonalMetricsStore is the part of the engagement service the normalizer uses
+type PersonalMetricsStore interface {
+	GetPersonalMetrics(ctx context.Context, tenantID string, startDate, endDate time.Time) ([]engagementrepo.PersonalMetric, error)
+	AddPersonalMetric(ctx context.Context, tenantID string, metric *engagementrepo.PersonalMetric) (*engagementrepo.PersonalMetric, error)
+	UpdatePersonalMetric(ctx context.Context, tenantID string, metric *engagementrepo.PersonalMetric) (*engagementrepo.PersonalMetric, error)
+}
+
+// Normalizer maps scraped posts onto competitor metrics or the tenant's personal metrics
+type Normalizer struct {
+	repo        repository.ScraperRepository
+	competitors CompetitorMetricsStore
+	personal    PersonalMetricsStore
+}
+
+// NewNormalizer creates a new Normalizer
+func NewNormalizer(repo repository.ScraperRepository, competitors CompetitorMetricsStore, personal PersonalMetricsStore) *Normalizer {
+	return &Normalizer{
+		repo:        repo,
+		competitors: competitors,
+		personal:    personal,
+	}
+}
+
+// Normalize stores the post metrics scraped by a job as competitor or personal metrics.
+// Jobs whose metadata sets "own_account" to "true" feed the tenant's personal metrics; otherwise the
+// target must match a registered competitor on the same platform. Targets that are neither are skipped.
+// It returns the number of posts written.
+func (n *Normalizer) Normalize(ctx context.Context, job *repository.ScraperJob, items []repository.ScrapedDataItem) (int, error) {
+	posts := latestPostSnapshots(items)
+	if len(posts) == 0 {
+		return 0, nil
+	}
+
+	followers, err := n.followerCount(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+
+	if job.Metadata["own_account"] == "true" {
+		return n.writePersonalMetrics(ctx, job.TenantID, posts, followers)
+	}
+
+	competitor, err := n.findCompetitor(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+	if competitor == nil {
+		return 0, nil
+	}
+
+	metrics := make([]competitorrepo.CompetitorMetric, len(posts))
+	for i, post := range posts {
+		metrics[i] = competitorrepo.CompetitorMetric{
+			CompetitorID:   competitor.ID,
+			PostID:         post.PostID,
+			Likes:          post.Likes,
+			Shares:         post.Shares,
+			Comments:       post.Comments,
+			CTR:            post.CTR,
+			AvgWatchTime:   post.AvgWatchTime,
+			EngagementRate: engagementRate(post, followers),
+			PostedAt:       postedAt(post),
+		}
+	}
+
+	return n.competitors.UpdateCompetitorMetrics(ctx, job.TenantID, competitor.ID, metrics)
+}
+
+// findCompetitor returns the registered competitor a job targets, or nil if there is none.
+// A "competitor_id" in the job metadata takes precedence over matching the target by name.
+func (n *Normalizer) findCompetitor(ctx context.Context, job *repository.ScraperJob) (*competitorrepo.Competitor, error) {
+	competitors, err := n.competitors.GetCompetitors(ctx, job.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load competitors: %w", err)
+	}
+
+	competitorID := job.Metadata["competitor_id"]
+	for i, c := range competitors {
+		if competitorID != "" {
+			if c.ID == competitorID {
+				return &competitors[i], nil
+			}
+			continue
+		}
+
+		if strings.EqualFold(c.Platform, job.Platform) &&
+			(c.ID == job.TargetID || strings.EqualFold(c.Name, job.TargetID)) {
+			return &competitors[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// writePersonalMetrics updates the tenant's metrics for posts that are already tracked and adds the rest
+func (n *Normalizer) writePersonalMetrics(ctx context.Context, tenantID string, posts []repository.ScrapedDataItem, followers int) (int, error) {
+	// Find which posts are already tracked
+	start, end := postedAt(posts[0]), postedAt(posts[0])
+	for _, post := range posts[1:] {
+		if t := postedAt(post); t.Before(start) {
+			start = t
+		} else if t.After(end) {
+			end = t
+		}
+	}
+
+	existing, err := n.personal.GetPersonalMetrics(ctx, tenantID, start, end)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load personal metrics: %w", err)
+	}
+
+	tracked := make(map[string]bool, len(existing))
+	for _, m := range existing {
+		tracked[m.PostID] = true
+	}
+
+	for i, post := range posts {
+		metric := &engagementrepo.PersonalMetric{
+			TenantID:       tenantID,
+			PostID:         post.PostID,
+			Likes:          post.Likes,
+			Shares:         post.Shares,
+			Comments:       post.Comments,
+			CTR:            post.CTR,
+			AvgWatchTime:   post.AvgWatchTime,
+			EngagementRate: engagementRate(post, followers),
+			PostedAt:       postedAt(post),
+		}
+
+		if tracked[post.PostID] {
+			_, err = n.personal.UpdatePersonalMetric(ctx, tenantID, metric)
+		} else {
+			_, err = n.personal.AddPersonalMetric(ctx, tenantID, metric)
+		}
+
+		if err != nil {
+			return i, fmt.Errorf("failed to write personal metric for post %s: %w", post.PostID, err)
+		}
+	}
+
+	return len(posts), nil
+}
+
+// followerCount returns the follower count from the target's latest profile scrape, or 0 if unknown
+func (n *Normalizer) followerCount(ctx context.Context, job *repository.ScraperJob) (int, error) {
+	profile, err := n.repo.GetLatestScrapedItem(ctx, job.TenantID, job.Platform, job.TargetID, repository.DataTypeProfile)
+	if err != nil {
+		return 0, err
+	}
+	if profile == nil {
+		return 0, nil
+	}
+
+	followers, _ := strconv.Atoi(profile.ContentAttributes["followers"])
+	return followers, nil
+}
+
+// latestPostSnapshots keeps the most recent snapshot of each post
+func latestPostSnapshots(items []repository.ScrapedDataItem) []repository.ScrapedDataItem {
+	index := make(map[string]int)
+	var posts []repository.ScrapedDataItem
+
+	for _, item := range items {
+		if item.DataType != repository.DataTypePost || item.PostID == "" {
+			continue
+		}
+
+		if i, seen := index[item.PostID]; seen {
+			if item.ScrapedAt.After(posts[i].ScrapedAt) {
+				posts[i] = item
+			}
+			continue
+		}
+
+		index[item.PostID] = len(posts)
+		posts = append(posts, item)
+	}
+
+	return posts
+}
+
+// engagementRate returns interactions per follower so rates are comparable across platforms.
+// Platform-reported rates are ignored because each platform defines them differently.
+func engagementRate(post repository.ScrapedDataItem, followers int) float64 {
+	if followers <= 0 {
+		return 0
+	}
+	return float64(post.Likes+post.Shares+post.Comments) / float64(followers)
+}
+
+// postedAt returns when a post was published, falling back to when it was scraped
+func postedAt(post repository.ScrapedDataItem) time.Time {
+	if !post.PostedAt.IsZero() {
+		return post.PostedAt
+	}
+	return post.ScrapedAt
+}
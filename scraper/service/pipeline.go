@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// maxJobTreeDepth bounds how deep GetScraperJobTree follows child jobs. Pipelines are at most
+// profile → posts → engagement/comments, so deeper trees can only come from bad data.
+const maxJobTreeDepth = 3
+
+// Job types that can be steps of a pipeline
+var pipelineJobTypes = []repository.JobType{
+	repository.JobTypePosts,
+	repository.JobTypeEngagement,
+	repository.JobTypeComments,
+}
+
+// JobTree is a job along with the child jobs spawned by its latest run
+type JobTree struct {
+	Job          *repository.ScraperJob
+	Children     []JobTree
+	RollupStatus repository.JobStatus // Status of the job and its children taken together
+}
+
+// pipelineSteps returns the follow-up job types listed in a job's "pipeline" metadata, such as "posts,comments".
+// A profile job's pipeline can spawn a posts job, and a posts job's pipeline spawns engagement and comment
+// jobs for every post it finds.
+func (s *ScraperService) pipelineSteps(job *repository.ScraperJob) ([]repository.JobType, error) {
+	value := job.Metadata["pipeline"]
+	if value == "" {
+		return nil, nil
+	}
+
+	if job.JobType != repository.JobTypeProfile && job.JobType != repository.JobTypePosts {
+		return nil, fmt.Errorf("only profile and posts jobs can start a pipeline, not %s jobs", job.JobType.String())
+	}
+
+	info, _ := s.lookupPlatform(job.Platform)
+
+	var steps []repository.JobType
+	for _, name := range strings.Split(value, ",") {
+		var step repository.JobType
+		if err := step.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil || !slices.Contains(pipelineJobTypes, step) {
+			return nil, fmt.Errorf("pipeline steps must be posts, engagement or comments: %s", name)
+		}
+
+		if !slices.Contains(info.SupportedJobTypes, step) {
+			return nil, fmt.Errorf("%s does not support %s jobs", job.Platform, step.String())
+		}
+
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// spawnChildJobs creates the pipeline's follow-up jobs for a successful run and hands them to the workers.
+// A parent has one child per step and post, so a recurring parent reruns the children its earlier runs
+// spawned instead of adding a new set on every run. The run is recorded as the parent's PipelineRunID,
+// which is saved along with the rest of the run's outcome.
+func (s *ScraperService) spawnChildJobs(ctx context.Context, job *repository.ScraperJob, run *repository.JobRun,
+	items []repository.ScrapedDataItem) {
+
+	steps, err := s.pipelineSteps(job)
+	if err != nil || len(steps) == 0 {
+		return
+	}
+
+	var children []*repository.ScraperJob
+	switch job.JobType {
+	case repository.JobTypeProfile:
+		if slices.Contains(steps, repository.JobTypePosts) {
+			children = append(children, newChildJob(job, run, repository.JobTypePosts, ""))
+		}
+
+	case repository.JobTypePosts:
+		seen := make(map[string]bool)
+		for _, item := range items {
+			if item.DataType != repository.DataTypePost || item.PostID == "" || seen[item.PostID] {
+				continue
+			}
+			seen[item.PostID] = true
+
+			for _, step := range steps {
+				if step == repository.JobTypeEngagement || step == repository.JobTypeComments {
+					children = append(children, newChildJob(job, run, step, item.PostID))
+				}
+			}
+		}
+	}
+
+	existing, err := s.repo.GetChildScraperJobs(ctx, job.TenantID, job.ID)
+	if err != nil {
+		log.Printf("Error loading child jobs for pipeline of scraper job %s: %v", job.ID, err)
+		return
+	}
+	previous := make(map[string]*repository.ScraperJob, len(existing))
+	for i := range existing {
+		previous[childKey(&existing[i])] = &existing[i]
+	}
+
+	job.PipelineRunID = run.ID
+
+	for _, child := range children {
+		spawned, err := s.spawnChildJob(ctx, previous[childKey(child)], child)
+		if err != nil {
+			log.Printf("Error creating %s job for pipeline of scraper job %s: %v", child.JobType.String(), job.ID, err)
+			continue
+		}
+		if spawned == nil {
+			continue
+		}
+		s.publishJobEvent(spawned, nil)
+		s.scheduleJob(spawned)
+	}
+}
+
+// spawnChildJob creates a child job, or reschedules the child an earlier run spawned for the same
+// step and post. A child that is still running is left to finish, and a child that was cancelled
+// stays cancelled; nil is returned for both.
+func (s *ScraperService) spawnChildJob(ctx context.Context, previous, child *repository.ScraperJob) (*repository.ScraperJob, error) {
+	if previous == nil {
+		return s.repo.CreateScraperJob(ctx, child)
+	}
+	if previous.Status == repository.JobStatusRunning || previous.Status == repository.JobStatusCancelled {
+		return nil, nil
+	}
+
+	previous.ParentRunID = child.ParentRunID
+	previous.Priority = child.Priority
+	previous.Metadata = child.Metadata
+	previous.Status = child.Status
+	previous.NextRunAt = child.NextRunAt
+	previous.Attempts = 0
+	previous.LastError = ""
+	return s.repo.UpdateScraperJob(ctx, previous)
+}
+
+// childKey identifies a child job among its parent's children by its step and post
+func childKey(child *repository.ScraperJob) string {
+	return child.JobType.String() + "/" + child.Metadata["post_id"]
+}
+
+// newChildJob creates a one-off job for a pipeline step. It inherits its parent's target, priority
+// and metadata, so attribution and retry settings carry through the pipeline.
+func newChildJob(parent *repository.ScraperJob, run *repository.JobRun, jobType repository.JobType,
+	postID string) *repository.ScraperJob {
+
+	metadata := maps.Clone(parent.Metadata)
+	if metadata == nil {
+		metadata = make(map[string]string)
+	}
+	if jobType != repository.JobTypePosts {
+		// Per-post jobs are the last step
+		delete(metadata, "pipeline")
+	}
+	if postID != "" {
+		metadata["post_id"] = postID
+	}
+
+	now := time.Now()
+	return &repository.ScraperJob{
+		TenantID:    parent.TenantID,
+		ParentID:    parent.ID,
+		ParentRunID: run.ID,
+		Platform:    parent.Platform,
+		TargetID:    parent.TargetID,
+		JobType:     jobType,
+		Status:      repository.JobStatusScheduled,
+		Schedule:    repository.ScraperSchedule{Frequency: repository.FrequencyOnce},
+		Priority:    parent.Priority,
+		Metadata:    metadata,
+		NextRunAt:   now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// GetScraperJobTree retrieves a job along with the child jobs spawned by its latest run, recursively
+func (s *ScraperService) GetScraperJobTree(ctx context.Context, tenantID, jobID string) (*JobTree, error) {
+	job, err := s.repo.GetScraperJob(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := s.buildJobTree(ctx, tenantID, job, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tree, nil
+}
+
+// buildJobTree loads the children of a job and rolls up their status
+func (s *ScraperService) buildJobTree(ctx context.Context, tenantID string, job *repository.ScraperJob,
+	depth int) (JobTree, error) {
+
+	tree := JobTree{Job: job}
+
+	if depth < maxJobTreeDepth {
+		children, err := s.repo.GetChildScraperJobs(ctx, tenantID, job.ID)
+		if err != nil {
+			return tree, err
+		}
+
+		for i := range children {
+			if !inPipelineRun(job, &children[i]) {
+				continue
+			}
+
+			child, err := s.buildJobTree(ctx, tenantID, &children[i], depth+1)
+			if err != nil {
+				return tree, err
+			}
+			tree.Children = append(tree.Children, child)
+		}
+	}
+
+	tree.RollupStatus = rollupStatus(job, tree.Children)
+	return tree, nil
+}
+
+// inPipelineRun returns whether a child belongs to its parent's latest pipeline run. Recurring parents rerun
+// their children on every run, so only the ones the latest run spawned or reran are part of it, along with
+// children still running from an earlier run, which the latest run left to finish. Every child belongs to
+// parents that haven't recorded a run.
+func inPipelineRun(parent, child *repository.ScraperJob) bool {
+	return parent.PipelineRunID == "" || child.ParentRunID == parent.PipelineRunID ||
+		child.Status == repository.JobStatusRunning
+}
+
+// rollupStatus combines the status of a job and its children. The job counts as running while it or any
+// of its children still has work to do, and as failed once everything is done if any child failed.
+func rollupStatus(job *repository.ScraperJob, children []JobTree) repository.JobStatus {
+	if job.Status == repository.JobStatusRunning {
+		return repository.JobStatusRunning
+	}
+
+	inProgress, failed := false, false
+	for _, child := range children {
+		switch child.RollupStatus {
+		case repository.JobStatusPending, repository.JobStatusScheduled, repository.JobStatusRunning:
+			inProgress = true
+		case repository.JobStatusFailed, repository.JobStatusDeadLetter:
+			failed = true
+		}
+	}
+
+	switch {
+	case inProgress:
+		return repository.JobStatusRunning
+	case failed:
+		return repository.JobStatusFailed
+	default:
+		return job.Status
+	}
+}
+
+// deleteChildJobs deletes every job spawned by a job's pipeline, along with their own children
+func (s *ScraperService) deleteChildJobs(ctx context.Context, tenantID, jobID string) error {
+	children, err := s.repo.GetChildScraperJobs(ctx, tenantID, jobID)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if err := s.deleteChildJobs(ctx, tenantID, child.ID); err != nil {
+			return err
+		}
+		if err := s.deleteJob(ctx, tenantID, child.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
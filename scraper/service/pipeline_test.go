@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func TestSpawnChildJobsRerunsChildrenAndLeavesCancelledOnes(t *testing.T) {
+	parent := repository.ScraperJob{
+		ID: "p1", TenantID: "tenant-a", Platform: "instagram", TargetID: "acme", JobType: repository.JobTypePosts,
+		Status: repository.JobStatusRunning, Metadata: map[string]string{"pipeline": "engagement,comments"},
+		PipelineRunID: "run-1",
+	}
+	child := func(id string, jobType repository.JobType, postID string, status repository.JobStatus) repository.ScraperJob {
+		return repository.ScraperJob{
+			ID: id, TenantID: "tenant-a", ParentID: "p1", ParentRunID: "run-1", Platform: "instagram",
+			TargetID: "acme", JobType: jobType, Status: status, Metadata: map[string]string{"post_id": postID},
+		}
+	}
+	repo := newFakeRepository(
+		parent,
+		child("e1", repository.JobTypeEngagement, "post-1", repository.JobStatusCompleted),
+		child("c1", repository.JobTypeComments, "post-1", repository.JobStatusCancelled),
+	)
+	s := newTestService(t, repo)
+
+	items := []repository.ScrapedDataItem{
+		{DataType: repository.DataTypePost, PostID: "post-1"},
+		{DataType: repository.DataTypePost, PostID: "post-2"},
+	}
+	s.spawnChildJobs(context.Background(), &parent, &repository.JobRun{ID: "run-2"}, items)
+
+	if parent.PipelineRunID != "run-2" {
+		t.Errorf("parent's pipeline run = %q, want run-2", parent.PipelineRunID)
+	}
+	if e1 := repo.job("e1"); e1.ParentRunID != "run-2" || e1.Status != repository.JobStatusScheduled {
+		t.Errorf("engagement child of post-1 = %s in %s, want rescheduled in run-2", e1.Status.String(), e1.ParentRunID)
+	}
+	if c1 := repo.job("c1"); c1.ParentRunID != "run-1" || c1.Status != repository.JobStatusCancelled {
+		t.Errorf("cancelled comments child of post-1 = %s in %s, want left cancelled in run-1", c1.Status.String(), c1.ParentRunID)
+	}
+
+	// The parent's outcome is saved with its pipeline run when the run is released
+	if _, err := repo.UpdateScraperJob(context.Background(), &parent); err != nil {
+		t.Fatal(err)
+	}
+	tree, err := s.GetScraperJobTree(context.Background(), "tenant-a", "p1")
+	if err != nil {
+		t.Fatalf("GetScraperJobTree() error = %v", err)
+	}
+
+	got := make(map[string]bool)
+	for _, c := range tree.Children {
+		got[c.Job.JobType.String()+"/"+c.Job.Metadata["post_id"]] = true
+	}
+	want := []string{"engagement/post-1", "engagement/post-2", "comments/post-2"}
+	if len(got) != len(want) {
+		t.Errorf("tree children = %v, want %v", got, want)
+	}
+	for _, key := range want {
+		if !got[key] {
+			t.Errorf("tree children = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
+func TestJobTreeFollowsThePipelineRun(t *testing.T) {
+	ranAt := time.Now().Add(-time.Hour)
+	repo := newFakeRepository(
+		repository.ScraperJob{ID: "p1", TenantID: "tenant-a", JobType: repository.JobTypeProfile, PipelineRunID: "run-2"},
+		// Edited after the latest run, which must not make its stale run look current
+		repository.ScraperJob{
+			ID: "old", TenantID: "tenant-a", ParentID: "p1", ParentRunID: "run-1", Status: repository.JobStatusCompleted,
+			UpdatedAt: time.Now(),
+		},
+		repository.ScraperJob{
+			ID: "new", TenantID: "tenant-a", ParentID: "p1", ParentRunID: "run-2", Status: repository.JobStatusCompleted,
+			UpdatedAt: ranAt,
+		},
+		repository.ScraperJob{ID: "busy", TenantID: "tenant-a", ParentID: "p1", ParentRunID: "run-1", Status: repository.JobStatusRunning},
+	)
+	s := newTestService(t, repo)
+
+	tree, err := s.GetScraperJobTree(context.Background(), "tenant-a", "p1")
+	if err != nil {
+		t.Fatalf("GetScraperJobTree() error = %v", err)
+	}
+
+	var ids []string
+	for _, child := range tree.Children {
+		ids = append(ids, child.Job.ID)
+	}
+	if len(ids) != 2 || ids[0] != "busy" || ids[1] != "new" {
+		t.Errorf("tree children = %v, want busy and new", ids)
+	}
+	if tree.RollupStatus != repository.JobStatusRunning {
+		t.Errorf("rollup status = %s, want running while a child runs", tree.RollupStatus.String())
+	}
+}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"container/heap"
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	authrepo "github.com/donaldnash/go-competitor/auth/repository"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+const (
+	// MinJobPriority and MaxJobPriority bound job priorities. Jobs default to 0.
+	MinJobPriority = -10
+	MaxJobPriority = 10
+
+	// defaultTier is used for tenants whose tier is unknown
+	defaultTier = "standard"
+
+	// tierCacheTTL is how long a tenant's tier is cached before it is looked up again
+	tierCacheTTL = 10 * time.Minute
+)
+
+// TierLimits controls a tenant's share of the worker pool
+type TierLimits struct {
+	Weight        int // Jobs dispatched per round-robin turn
+	MaxConcurrent int // Jobs that can run at the same time
+}
+
+// Queue limits for each organization tier
+var tierLimits = map[string]TierLimits{
+	"free":       {Weight: 1, MaxConcurrent: 1},
+	"standard":   {Weight: 2, MaxConcurrent: 2},
+	"premium":    {Weight: 4, MaxConcurrent: 3},
+	"enterprise": {Weight: 8, MaxConcurrent: 3},
+}
+
+// TenantDirectory is the part of the auth service the queue uses to look up tenant tiers
+type TenantDirectory interface {
+	GetTenant(ctx context.Context, tenantID string) (*authrepo.Organization, error)
+}
+
+// TenantQueueStatus reports the queue state of one tenant
+type TenantQueueStatus struct {
+	TenantID      string
+	Tier          string
+	Weight        int
+	MaxConcurrent int
+	Queued        int
+	Running       int
+	OldestWait    time.Duration // How long the oldest queued job has been waiting
+	AvgWait       time.Duration // Average wait of the jobs dispatched since the service started
+	Dispatched    int
+}
+
+// QueueStatus reports the state of the job queue
+type QueueStatus struct {
+	Workers int
+	Queued  int // Across all tenants
+	Running int // Across all tenants
+	Tenants []TenantQueueStatus
+}
+
+// queuedJob is a job waiting in a tenant's queue
+type queuedJob struct {
+	job        *repository.ScraperJob
+	enqueuedAt time.Time
+	seq        uint64
+}
+
+// jobHeap orders a tenant's queued jobs by priority, then by the order they were queued
+type jobHeap []*queuedJob
+
+func (h jobHeap) Len() int { return len(h) }
+
+func (h jobHeap) Less(i, j int) bool {
+	if h[i].job.Priority != h[j].job.Priority {
+		return h[i].job.Priority > h[j].job.Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h jobHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *jobHeap) Push(x any) { *h = append(*h, x.(*queuedJob)) }
+
+func (h *jobHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return item
+}
+
+// tenantQueue holds one tenant's queued jobs and counters
+type tenantQueue struct {
+	tier       string
+	limits     TierLimits
+	waiting    jobHeap
+	running    int
+	credit     int // Dispatches left in the tenant's current round-robin turn
+	dispatched int
+	totalWait  time.Duration
+}
+
+// jobQueue schedules jobs fairly across tenants. Tenants take turns in weighted round-robin order,
+// each getting as many dispatches per turn as its tier's weight, and a tenant never has more jobs
+// running than its tier allows. Within a tenant, higher priority jobs run first.
+//
+// The queue and its counters are kept in memory, so the turns and concurrency caps apply per replica:
+// with several replicas, a tenant can run up to its tier's MaxConcurrent jobs on each of them.
+type jobQueue struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	tenants  map[string]*tenantQueue
+	ring     []string          // Tenants in round-robin order
+	cursor   int               // Tenant whose turn it is
+	jobs     map[string]string // Queued or running job IDs, mapped to their tenant
+	capacity int               // Queued jobs allowed per tenant
+	seq      uint64
+	closed   bool
+}
+
+// newJobQueue creates a jobQueue that holds up to capacity queued jobs per tenant
+func newJobQueue(capacity int) *jobQueue {
+	q := &jobQueue{
+		tenants:  make(map[string]*tenantQueue),
+		jobs:     make(map[string]string),
+		capacity: capacity,
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// push queues a job unless it is already queued or running. It returns false when
+// the job was not queued because the tenant's queue is full.
+func (q *jobQueue) push(job *repository.ScraperJob, tier string, limits TierLimits) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if _, exists := q.jobs[job.ID]; exists {
+		return true
+	}
+
+	tq, exists := q.tenants[job.TenantID]
+	if !exists {
+		tq = &tenantQueue{}
+		q.tenants[job.TenantID] = tq
+		q.ring = append(q.ring, job.TenantID)
+	}
+	tq.tier = tier
+	tq.limits = limits
+
+	if len(tq.waiting) >= q.capacity {
+		return false
+	}
+
+	q.seq++
+	heap.Push(&tq.waiting, &queuedJob{job: job, enqueuedAt: time.Now(), seq: q.seq})
+	q.jobs[job.ID] = job.TenantID
+	q.cond.Signal()
+
+	return true
+}
+
+// pop blocks until a job can run and returns it, or returns nil once the queue is closed
+func (q *jobQueue) pop() *repository.ScraperJob {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		if q.closed {
+			return nil
+		}
+		if job := q.next(); job != nil {
+			return job
+		}
+		q.cond.Wait()
+	}
+}
+
+// next takes the next job in round-robin order, skipping tenants that have nothing queued
+// or are at their concurrency cap. It must be called with the lock held.
+func (q *jobQueue) next() *repository.ScraperJob {
+	for range len(q.ring) {
+		tenantID := q.ring[q.cursor]
+		tq := q.tenants[tenantID]
+
+		if len(tq.waiting) == 0 || tq.running >= tq.limits.MaxConcurrent {
+			tq.credit = 0
+			q.advance()
+			continue
+		}
+
+		if tq.credit <= 0 {
+			tq.credit = max(tq.limits.Weight, 1)
+		}
+		tq.credit--
+		if tq.credit == 0 {
+			q.advance()
+		}
+
+		item := heap.Pop(&tq.waiting).(*queuedJob)
+		tq.running++
+		tq.dispatched++
+		tq.totalWait += time.Since(item.enqueuedAt)
+
+		return item.job
+	}
+
+	return nil
+}
+
+// advance passes the turn to the next tenant
+func (q *jobQueue) advance() {
+	q.cursor = (q.cursor + 1) % len(q.ring)
+}
+
+// done releases a job that has finished running
+func (q *jobQueue) done(jobID string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	tenantID, exists := q.jobs[jobID]
+	if !exists {
+		return
+	}
+	delete(q.jobs, jobID)
+
+	if tq := q.tenants[tenantID]; tq != nil && tq.running > 0 {
+		tq.running--
+	}
+
+	// A tenant that was at its cap may have queued jobs that can run now
+	q.cond.Broadcast()
+}
+
+// close wakes every waiting worker and stops handing out jobs
+func (q *jobQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.cond.Broadcast()
+}
+
+// status reports the queue state of every tenant that has used the queue
+func (q *jobQueue) status() []TenantQueueStatus {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := time.Now()
+	statuses := make([]TenantQueueStatus, 0, len(q.tenants))
+	for tenantID, tq := range q.tenants {
+		status := TenantQueueStatus{
+			TenantID:      tenantID,
+			Tier:          tq.tier,
+			Weight:        tq.limits.Weight,
+			MaxConcurrent: tq.limits.MaxConcurrent,
+			Queued:        len(tq.waiting),
+			Running:       tq.running,
+			Dispatched:    tq.dispatched,
+		}
+
+		for _, item := range tq.waiting {
+			if wait := now.Sub(item.enqueuedAt); wait > status.OldestWait {
+				status.OldestWait = wait
+			}
+		}
+
+		if tq.dispatched > 0 {
+			status.AvgWait = tq.totalWait / time.Duration(tq.dispatched)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].TenantID < statuses[j].TenantID
+	})
+
+	return statuses
+}
+
+// cachedTier is a tenant tier looked up from the auth service
+type cachedTier struct {
+	tier      string
+	fetchedAt time.Time
+}
+
+// tenantTier returns a tenant's tier and its queue limits. Tiers are cached, and tenants
+// whose tier can't be looked up are treated as the default tier.
+func (s *ScraperService) tenantTier(tenantID string) (string, TierLimits) {
+	tier := s.lookupTier(tenantID)
+
+	limits, exists := tierLimits[tier]
+	if !exists {
+		limits = tierLimits[defaultTier]
+	}
+
+	return tier, limits
+}
+
+// lookupTier returns a tenant's tier from the cache or the auth service
+func (s *ScraperService) lookupTier(tenantID string) string {
+	if s.tenants == nil {
+		return defaultTier
+	}
+
+	s.tierMu.Lock()
+	cached, exists := s.tierCache[tenantID]
+	s.tierMu.Unlock()
+
+	if exists && time.Since(cached.fetchedAt) < tierCacheTTL {
+		return cached.tier
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	org, err := s.tenants.GetTenant(ctx, tenantID)
+	if err != nil {
+		log.Printf("Error looking up tier of tenant %s: %v", tenantID, err)
+		if exists {
+			return cached.tier
+		}
+		return defaultTier
+	}
+
+	tier := org.Tier
+	if tier == "" {
+		tier = defaultTier
+	}
+
+	s.tierMu.Lock()
+	s.tierCache[tenantID] = cachedTier{tier: tier, fetchedAt: time.Now()}
+	s.tierMu.Unlock()
+
+	return tier
+}
+
+// GetQueueStatus reports the queue depth, running jobs and wait times of a tenant,
+// along with the totals across all tenants
+func (s *ScraperService) GetQueueStatus(ctx context.Context, tenantID string) *QueueStatus {
+	status := &QueueStatus{Workers: s.workerCount}
+
+	for _, tenant := range s.queue.status() {
+		status.Queued += tenant.Queued
+		status.Running += tenant.Running
+		if tenant.TenantID == tenantID {
+			status.Tenants = append(status.Tenants, tenant)
+		}
+	}
+
+	// Tenants that haven't queued anything yet still have limits
+	if len(status.Tenants) == 0 {
+		tier, limits := s.tenantTier(tenantID)
+		status.Tenants = append(status.Tenants, TenantQueueStatus{
+			TenantID:      tenantID,
+			Tier:          tier,
+			Weight:        limits.Weight,
+			MaxConcurrent: limits.MaxConcurrent,
+		})
+	}
+
+	return status
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+)
+
+// ErrRateLimited is returned when a platform's request budget is exhausted
+var ErrRateLimited = errors.New("platform rate limit exceeded")
+
+// RateLimitError describes a rejected platform request and when it can be retried
+type RateLimitError struct {
+	Platform string
+	RetryAt  time.Time
+}
+
+// Error implements the error interface
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s rate limit exceeded, retry at %s", e.Platform, e.RetryAt.Format(time.RFC3339))
+}
+
+// Is allows errors.Is(err, ErrRateLimited) to match
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+// tokenBucket is a token bucket that refills its capacity evenly over a window
+type tokenBucket struct {
+	capacity float64
+	tokens   float64
+	window   time.Duration
+	updated  time.Time
+}
+
+// newTokenBucket creates a full bucket allowing limit requests per window
+func newTokenBucket(limit int, window time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(limit),
+		tokens:   float64(limit),
+		window:   window,
+		updated:  now,
+	}
+}
+
+// refill adds the tokens earned since the last update
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updated)
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(b.capacity, b.tokens+b.capacity*elapsed.Seconds()/b.window.Seconds())
+	b.updated = now
+}
+
+// timeUntil returns how long it takes for the bucket to hold the given number of tokens
+func (b *tokenBucket) timeUntil(tokens float64) time.Duration {
+	if b.tokens >= tokens {
+		return 0
+	}
+	seconds := (tokens - b.tokens) * b.window.Seconds() / b.capacity
+	return time.Duration(math.Ceil(seconds * float64(time.Second)))
+}
+
+// RateLimiter enforces per-minute, per-hour and per-day request budgets for each platform.
+// The buckets are kept in memory, so each replica has its own budget: with several replicas,
+// a platform can receive up to the configured limits from each of them.
+type RateLimiter struct {
+	mu      sync.Mutex
+	buckets map[string][]*tokenBucket
+}
+
+// NewRateLimiter creates a RateLimiter from the configured platform limits
+func NewRateLimiter(limits map[string]PlatformRateLimits) *RateLimiter {
+	l := &RateLimiter{
+		buckets: make(map[string][]*tokenBucket),
+	}
+	for platform, limit := range limits {
+		l.SetLimits(platform, limit)
+	}
+	return l
+}
+
+// SetLimits replaces the budgets for a platform. Windows with a zero limit are not enforced.
+func (l *RateLimiter) SetLimits(platform string, limits PlatformRateLimits) {
+	now := time.Now()
+
+	var buckets []*tokenBucket
+	if limits.RequestsPerMinute > 0 {
+		buckets = append(buckets, newTokenBucket(limits.RequestsPerMinute, time.Minute, now))
+	}
+	if limits.RequestsPerHour > 0 {
+		buckets = append(buckets, newTokenBucket(limits.RequestsPerHour, time.Hour, now))
+	}
+	if limits.RequestsPerDay > 0 {
+		buckets = append(buckets, newTokenBucket(limits.RequestsPerDay, 24*time.Hour, now))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.buckets[platform] = buckets
+}
+
+// Allow takes one request from every window of the platform's budget.
+// If any window is empty nothing is taken and the time of the next available request is returned.
+func (l *RateLimiter) Allow(platform string) (bool, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	buckets := l.buckets[platform]
+
+	var wait time.Duration
+	for _, b := range buckets {
+		b.refill(now)
+		if d := b.timeUntil(1); d > wait {
+			wait = d
+		}
+	}
+
+	if wait > 0 {
+		return false, now.Add(wait)
+	}
+
+	for _, b := range buckets {
+		b.tokens--
+	}
+	return true, now
+}
+
+// Status returns the number of requests that can be made right now and when the
+// most constrained window will be back to its full budget. limited is false for
+// platforms without any enforced window, whose requests are never rejected.
+func (l *RateLimiter) Status(platform string) (available int, resetAt time.Time, limited bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	buckets := l.buckets[platform]
+	if len(buckets) == 0 {
+		return 0, time.Time{}, false
+	}
+
+	available = math.MaxInt
+	for _, b := range buckets {
+		b.refill(now)
+		remaining := int(math.Floor(b.tokens))
+		if remaining < available {
+			available = remaining
+			resetAt = now.Add(b.timeUntil(b.capacity))
+		}
+	}
+
+	return available, resetAt, true
+}
+
+// rateLimitedAPI wraps a PlatformAPI so every call is counted against the platform's budget
+type rateLimitedAPI struct {
+	platform string
+	limiter  *RateLimiter
+	api      PlatformAPI
+}
+
+// take reserves a request or returns a RateLimitError
+func (a *rateLimitedAPI) take() error {
+	if ok, retryAt := a.limiter.Allow(a.platform); !ok {
+		return &RateLimitError{Platform: a.platform, RetryAt: retryAt}
+	}
+	return nil
+}
+
+func (a *rateLimitedAPI) GetProfile(ctx context.Context, targetID string) (*platform.Profile, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetProfile(ctx, targetID)
+}
+
+func (a *rateLimitedAPI) GetPosts(ctx context.Context, targetID string, count int) ([]platform.Post, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetPosts(ctx, targetID, count)
+}
+
+func (a *rateLimitedAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]platform.Post, string, error) {
+	if err := a.take(); err != nil {
+		return nil, "", err
+	}
+	return a.api.GetPostsPage(ctx, targetID, cursor, count)
+}
+
+func (a *rateLimitedAPI) GetPostsIfModified(ctx context.Context, targetID string, count int,
+	validators platform.Validators) ([]platform.Post, platform.Validators, error) {
+	if err := a.take(); err != nil {
+		return nil, validators, err
+	}
+	return platform.GetPostsIfModified(ctx, a.api, targetID, count, validators)
+}
+
+func (a *rateLimitedAPI) GetEngagement(ctx context.Context, targetID, postID string) (*platform.Engagement, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetEngagement(ctx, targetID, postID)
+}
+
+func (a *rateLimitedAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]platform.Follower, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetFollowers(ctx, targetID, count)
+}
+
+func (a *rateLimitedAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]platform.Comment, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetComments(ctx, targetID, postID, count)
+}
+
+func (a *rateLimitedAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]platform.Post, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.SearchPosts(ctx, keyword, count)
+}
+
+func (a *rateLimitedAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]platform.Post, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetHashtagPosts(ctx, hashtag, count)
+}
+
+func (a *rateLimitedAPI) GetMentions(ctx context.Context, targetID string, count int) ([]platform.Post, error) {
+	if err := a.take(); err != nil {
+		return nil, err
+	}
+	return a.api.GetMentions(ctx, targetID, count)
+}
+
+// countingAPI wraps a PlatformAPI and counts the requests that were not rejected by the rate limiter
+type countingAPI struct {
+	api      PlatformAPI
+	requests int
+}
+
+// count records a request unless it was rejected by the rate limiter
+func (a *countingAPI) count(err error) {
+	if !errors.Is(err, ErrRateLimited) {
+		a.requests++
+	}
+}
+
+func (a *countingAPI) GetProfile(ctx context.Context, targetID string) (*platform.Profile, error) {
+	profile, err := a.api.GetProfile(ctx, targetID)
+	a.count(err)
+	return profile, err
+}
+
+func (a *countingAPI) GetPosts(ctx context.Context, targetID string, count int) ([]platform.Post, error) {
+	posts, err := a.api.GetPosts(ctx, targetID, count)
+	a.count(err)
+	return posts, err
+}
+
+func (a *countingAPI) GetPostsPage(ctx context.Context, targetID, cursor string, count int) ([]platform.Post, string, error) {
+	posts, next, err := a.api.GetPostsPage(ctx, targetID, cursor, count)
+	a.count(err)
+	return posts, next, err
+}
+
+func (a *countingAPI) GetPostsIfModified(ctx context.Context, targetID string, count int,
+	validators platform.Validators) ([]platform.Post, platform.Validators, error) {
+	posts, latest, err := platform.GetPostsIfModified(ctx, a.api, targetID, count, validators)
+	a.count(err)
+	return posts, latest, err
+}
+
+func (a *countingAPI) GetEngagement(ctx context.Context, targetID, postID string) (*platform.Engagement, error) {
+	engagement, err := a.api.GetEngagement(ctx, targetID, postID)
+	a.count(err)
+	return engagement, err
+}
+
+func (a *countingAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]platform.Follower, error) {
+	followers, err := a.api.GetFollowers(ctx, targetID, count)
+	a.count(err)
+	return followers, err
+}
+
+func (a *countingAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]platform.Comment, error) {
+	comments, err := a.api.GetComments(ctx, targetID, postID, count)
+	a.count(err)
+	return comments, err
+}
+
+func (a *countingAPI) SearchPosts(ctx context.Context, keyword string, count int) ([]platform.Post, error) {
+	posts, err := a.api.SearchPosts(ctx, keyword, count)
+	a.count(err)
+	return posts, err
+}
+
+func (a *countingAPI) GetHashtagPosts(ctx context.Context, hashtag string, count int) ([]platform.Post, error) {
+	posts, err := a.api.GetHashtagPosts(ctx, hashtag, count)
+	a.count(err)
+	return posts, err
+}
+
+func (a *countingAPI) GetMentions(ctx context.Context, targetID string, count int) ([]platform.Post, error) {
+	posts, err := a.api.GetMentions(ctx, targetID, count)
+	a.count(err)
+	return posts, err
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+const (
+	// MaxRetentionDays bounds retention policies
+	MaxRetentionDays = 3650
+
+	// purgeSpec is how often the purger deletes data that has outlived its retention policy
+	purgeSpec = "0 30 * * * *"
+
+	// purgeTimeout bounds a single purge across all tenants
+	purgeTimeout = 10 * time.Minute
+)
+
+// Retention of data types a tenant hasn't set a policy for, in days
+var defaultRetentionDays = map[repository.DataType]int{
+	repository.DataTypeProfile:  365,
+	repository.DataTypePost:     730,
+	repository.DataTypeStory:    30,
+	repository.DataTypeComment:  365,
+	repository.DataTypeFollower: 30,
+	repository.DataTypeMention:  365,
+}
+
+// RetentionPolicy is how long a tenant keeps one type of scraped data
+type RetentionPolicy struct {
+	DataType      repository.DataType
+	RetentionDays int  // 0 keeps the data forever
+	Default       bool // The tenant hasn't set a policy, so the default applies
+	UpdatedAt     time.Time
+}
+
+// ListRetentionPolicies returns the retention of every data type for a tenant,
+// falling back to the defaults for data types the tenant hasn't set a policy for
+func (s *ScraperService) ListRetentionPolicies(ctx context.Context, tenantID string) ([]RetentionPolicy, error) {
+	stored, err := s.repo.GetRetentionPolicies(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[repository.DataType]repository.RetentionPolicy, len(stored))
+	for _, policy := range stored {
+		byType[policy.DataType] = policy
+	}
+
+	policies := make([]RetentionPolicy, 0, len(defaultRetentionDays))
+	for dataType := repository.DataTypeProfile; dataType <= repository.DataTypeMention; dataType++ {
+		if policy, exists := byType[dataType]; exists {
+			policies = append(policies, RetentionPolicy{
+				DataType:      dataType,
+				RetentionDays: policy.RetentionDays,
+				UpdatedAt:     policy.UpdatedAt,
+			})
+			continue
+		}
+
+		policies = append(policies, RetentionPolicy{
+			DataType:      dataType,
+			RetentionDays: defaultRetentionDays[dataType],
+			Default:       true,
+		})
+	}
+
+	return policies, nil
+}
+
+// SetRetentionPolicy sets how many days a tenant keeps a type of scraped data. 0 keeps it forever.
+func (s *ScraperService) SetRetentionPolicy(ctx context.Context, tenantID string, dataType repository.DataType,
+	retentionDays int) (*RetentionPolicy, error) {
+
+	if _, exists := defaultRetentionDays[dataType]; !exists {
+		return nil, fmt.Errorf("unsupported data type: %s", dataType.String())
+	}
+
+	if retentionDays < 0 || retentionDays > MaxRetentionDays {
+		return nil, fmt.Errorf("retention must be between 0 and %d days", MaxRetentionDays)
+	}
+
+	policy, err := s.repo.SaveRetentionPolicy(ctx, &repository.RetentionPolicy{
+		TenantID:      tenantID,
+		DataType:      dataType,
+		RetentionDays: retentionDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &RetentionPolicy{
+		DataType:      policy.DataType,
+		RetentionDays: policy.RetentionDays,
+		UpdatedAt:     policy.UpdatedAt,
+	}, nil
+}
+
+// GetStorageUsage reports how many rows a tenant stores in each scraper table
+func (s *ScraperService) GetStorageUsage(ctx context.Context, tenantID string) ([]repository.StorageUsage, error) {
+	return s.repo.GetStorageUsage(ctx, tenantID)
+}
+
+// purgeExpiredData deletes the data of every tenant that has outlived the tenant's retention policies.
+// Tenants are found through their jobs, since deleting a job already deletes its data.
+// Webhook deliveries too old to be redelivered are forgotten as well.
+func (s *ScraperService) purgeExpiredData() {
+	ctx, cancel := context.WithTimeout(context.Background(), purgeTimeout)
+	defer cancel()
+
+	if purged, err := s.repo.PurgeWebhookDeliveries(ctx, time.Now().Add(-webhookDeliveryRetention)); err != nil {
+		log.Printf("Error purging webhook deliveries: %v", err)
+	} else if purged > 0 {
+		log.Printf("Purged %d webhook deliveries", purged)
+	}
+
+	jobs, err := s.repo.ListScraperJobsForWorker(ctx, repository.JobStatusUnspecified)
+	if err != nil {
+		log.Printf("Error loading tenants to purge: %v", err)
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, job := range jobs {
+		if seen[job.TenantID] {
+			continue
+		}
+		seen[job.TenantID] = true
+
+		purged, err := s.purgeTenant(ctx, job.TenantID)
+		if err != nil {
+			log.Printf("Error purging scraped data of tenant %s: %v", job.TenantID, err)
+		}
+		if purged > 0 {
+			log.Printf("Purged %d expired rows of tenant %s", purged, job.TenantID)
+		}
+	}
+}
+
+// purgeTenant applies a tenant's retention policies and returns the number of rows deleted
+func (s *ScraperService) purgeTenant(ctx context.Context, tenantID string) (int, error) {
+	policies, err := s.ListRetentionPolicies(ctx, tenantID)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, policy := range policies {
+		if policy.RetentionDays == 0 {
+			continue
+		}
+
+		cutoff := time.Now().AddDate(0, 0, -policy.RetentionDays)
+		deleted, err := s.repo.PurgeScrapedData(ctx, tenantID, policy.DataType, cutoff)
+		purged += deleted
+		if err != nil {
+			return purged, fmt.Errorf("failed to purge %s data: %w", policy.DataType.String(), err)
+		}
+
+		// Captured media belongs to the posts it was attached to
+		if policy.DataType == repository.DataTypePost {
+			deleted, err := s.deleteMedia(ctx, tenantID, "", cutoff)
+			purged += deleted
+			if err != nil {
+				return purged, fmt.Errorf("failed to purge media: %w", err)
+			}
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func TestPurgeExpiredDataCoversEveryTenant(t *testing.T) {
+	repo := newFakeRepository(
+		repository.ScraperJob{ID: "a1", TenantID: "tenant-a", Status: repository.JobStatusCompleted},
+		repository.ScraperJob{ID: "a2", TenantID: "tenant-a", Status: repository.JobStatusPending},
+		repository.ScraperJob{ID: "b1", TenantID: "tenant-b", Status: repository.JobStatusScheduled},
+	)
+	s := newTestService(t, repo)
+
+	s.purgeExpiredData()
+
+	// Every data type has a default retention, so each tenant is purged once per type
+	for _, tenantID := range []string{"tenant-a", "tenant-b"} {
+		if got, want := repo.purged[tenantID], len(defaultRetentionDays); got != want {
+			t.Errorf("tenant %s purged %d data types, want %d", tenantID, got, want)
+		}
+	}
+	if len(repo.purged) != 2 {
+		t.Errorf("purged tenants %v, want tenant-a and tenant-b", repo.purged)
+	}
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// RetryPolicy controls how often and how quickly a failed job is retried
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64 // Fraction of the backoff that is randomized, between 0 and 1
+}
+
+// defaultRetryPolicy is used for platforms that don't define their own
+var defaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 30 * time.Second,
+	MaxBackoff:     30 * time.Minute,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// Backoff returns how long to wait before the given retry, counting from 1
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(retry-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	// Spread retries so jobs that failed together don't retry together
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	return time.Duration(backoff)
+}
+
+// retryPolicy returns the retry policy of a job: the platform's policy with any overrides from the job metadata
+func (s *ScraperService) retryPolicy(job *repository.ScraperJob) (RetryPolicy, error) {
+	policy := defaultRetryPolicy
+	if info, exists := s.lookupPlatform(job.Platform); exists {
+		// Platforms only set the parts of the policy they change, for example from the platform config file
+		platformPolicy := info.RetryPolicy
+		if platformPolicy.MaxAttempts > 0 {
+			policy.MaxAttempts = platformPolicy.MaxAttempts
+		}
+		if platformPolicy.InitialBackoff > 0 {
+			policy.InitialBackoff = platformPolicy.InitialBackoff
+		}
+		if platformPolicy.MaxBackoff > 0 {
+			policy.MaxBackoff = platformPolicy.MaxBackoff
+		}
+		if platformPolicy.Multiplier > 0 {
+			policy.Multiplier = platformPolicy.Multiplier
+		}
+		if platformPolicy.Jitter > 0 {
+			policy.Jitter = platformPolicy.Jitter
+		}
+	}
+
+	if value := job.Metadata["max_attempts"]; value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil || attempts < 1 {
+			return policy, fmt.Errorf("max_attempts must be a positive number: %s", value)
+		}
+		policy.MaxAttempts = attempts
+	}
+
+	if value := job.Metadata["retry_backoff"]; value != "" {
+		backoff, err := time.ParseDuration(value)
+		if err != nil || backoff <= 0 {
+			return policy, fmt.Errorf("retry_backoff must be a positive duration: %s", value)
+		}
+		policy.InitialBackoff = backoff
+	}
+
+	if value := job.Metadata["retry_max_backoff"]; value != "" {
+		backoff, err := time.ParseDuration(value)
+		if err != nil || backoff <= 0 {
+			return policy, fmt.Errorf("retry_max_backoff must be a positive duration: %s", value)
+		}
+		policy.MaxBackoff = backoff
+	}
+
+	if value := job.Metadata["retry_jitter"]; value != "" {
+		jitter, err := strconv.ParseFloat(value, 64)
+		if err != nil || jitter < 0 || jitter > 1 {
+			return policy, fmt.Errorf("retry_jitter must be between 0 and 1: %s", value)
+		}
+		policy.Jitter = jitter
+	}
+
+	return policy, nil
+}
+
+// isTransient reports whether an error is likely to go away on its own, such as a timeout,
+// a dropped connection or a 429 or 5xx response. Everything else, like a target that
+// doesn't exist or a response that can't be parsed, is permanent and not retried.
+func isTransient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var httpErr *platform.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusTooManyRequests ||
+			httpErr.StatusCode == http.StatusRequestTimeout ||
+			httpErr.StatusCode >= 500
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// retryDelay returns how long to wait before retrying after an error, honouring any Retry-After sent by the platform
+func retryDelay(policy RetryPolicy, retry int, err error) time.Duration {
+	delay := policy.Backoff(retry)
+
+	var httpErr *platform.HTTPError
+	if errors.As(err, &httpErr) && httpErr.RetryAfter > delay {
+		delay = httpErr.RetryAfter
+	}
+
+	return delay
+}
+
+// RequeueScraperJob moves a dead-lettered job back into the queue with a fresh set of attempts
+func (s *ScraperService) RequeueScraperJob(ctx context.Context, tenantID, jobID string) (*repository.ScraperJob, error) {
+	job, err := s.repo.GetScraperJob(ctx, tenantID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != repository.JobStatusDeadLetter {
+		return nil, fmt.Errorf("only dead-lettered jobs can be requeued, job is %s", job.Status.String())
+	}
+
+	job.Attempts = 0
+	job.NextRunAt = time.Now()
+	job.Status = repository.JobStatusScheduled
+
+	job, err = s.repo.UpdateScraperJob(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishJobEvent(job, nil)
+	s.scheduleJob(job)
+
+	return job, nil
+}
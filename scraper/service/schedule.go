@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+	"github.com/robfig/cron/v3"
+)
+
+// cronParser parses the six-field cron expressions accepted in job schedules
+var cronParser = cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// jobSchedule implements cron.Schedule for a ScraperSchedule, honouring its start and end dates
+type jobSchedule struct {
+	start  time.Time
+	end    time.Time
+	cron   cron.Schedule
+	period time.Duration
+	once   bool
+}
+
+// newJobSchedule builds a jobSchedule from a cron expression or, when there is none, a frequency
+func newJobSchedule(schedule repository.ScraperSchedule) (*jobSchedule, error) {
+	js := &jobSchedule{
+		start: schedule.StartDate,
+		end:   schedule.EndDate,
+	}
+
+	if !js.start.IsZero() && !js.end.IsZero() && js.end.Before(js.start) {
+		return nil, fmt.Errorf("end date %s is before start date %s", js.end.Format(time.RFC3339), js.start.Format(time.RFC3339))
+	}
+
+	// If there's a cron expression, use it
+	if schedule.CronExpression != "" {
+		parsed, err := cronParser.Parse(schedule.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression: %w", err)
+		}
+		js.cron = parsed
+		return js, nil
+	}
+
+	// Otherwise, use the frequency
+	switch schedule.Frequency {
+	case repository.FrequencyOnce:
+		js.once = true
+	case repository.FrequencyHourly:
+		js.period = time.Hour
+	case repository.FrequencyDaily:
+		js.period = 24 * time.Hour
+	case repository.FrequencyWeekly:
+		js.period = 7 * 24 * time.Hour
+	default:
+		return nil, fmt.Errorf("unsupported frequency: %s", schedule.Frequency.String())
+	}
+
+	return js, nil
+}
+
+// Next returns the first run time after t, or the zero time once the schedule has ended.
+// Runs never happen before the start date or after the end date.
+func (js *jobSchedule) Next(t time.Time) time.Time {
+	var next time.Time
+
+	switch {
+	case js.cron != nil:
+		// The start date itself is a valid run time
+		from := t
+		if !js.start.IsZero() && from.Before(js.start) {
+			from = js.start.Add(-time.Nanosecond)
+		}
+		next = js.cron.Next(from)
+
+	case js.once:
+		// Run at the start date, or immediately if it has passed
+		next = t
+		if js.start.After(t) {
+			next = js.start
+		}
+
+	case js.start.IsZero():
+		next = t.Add(js.period)
+
+	case js.start.After(t):
+		next = js.start
+
+	default:
+		// Keep runs aligned to the start date
+		periods := int64(t.Sub(js.start)/js.period) + 1
+		next = js.start.Add(time.Duration(periods) * js.period)
+	}
+
+	if !js.end.IsZero() && next.After(js.end) {
+		return time.Time{}
+	}
+
+	return next
+}
+
+// calculateNextRunTime calculates the first run time after the given time based on the schedule.
+// The zero time is returned when the schedule has no runs left.
+func (s *ScraperService) calculateNextRunTime(schedule repository.ScraperSchedule, after time.Time) (time.Time, error) {
+	js, err := newJobSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return js.Next(after), nil
+}
+
+// nextRunAfterRun returns when a job that has just run should run again, or the zero time if it shouldn't
+func (s *ScraperService) nextRunAfterRun(job *repository.ScraperJob) time.Time {
+	js, err := newJobSchedule(job.Schedule)
+	if err != nil || js.once {
+		return time.Time{}
+	}
+
+	// Recurring runs are spaced from when the last run started, not when it finished
+	from := job.LastRunAt
+	if from.IsZero() {
+		from = time.Now()
+	}
+
+	next := js.Next(from)
+	if !next.IsZero() && next.Before(time.Now()) {
+		// The run overlapped one or more slots, skip to the next one in the future
+		next = js.Next(time.Now())
+	}
+
+	return next
+}
+
+// statusForNextRun returns the status of a job waiting for its next run
+func statusForNextRun(nextRun time.Time) repository.JobStatus {
+	if nextRun.Before(time.Now().Add(5 * time.Minute)) {
+		return repository.JobStatusScheduled
+	}
+	return repository.JobStatusPending
+}
+
+// ResumeJobs reloads unfinished jobs after a restart. Jobs without a next run time get one.
+// Running jobs may belong to another replica, so they are left alone until their lease expires
+// and the dispatcher requeues them. Only running jobs without a lease, which were started before
+// leases existed, are rescheduled immediately.
+func (s *ScraperService) ResumeJobs(ctx context.Context) error {
+	now := time.Now()
+
+	for _, jobStatus := range []repository.JobStatus{
+		repository.JobStatusRunning,
+		repository.JobStatusPending,
+		repository.JobStatusScheduled,
+	} {
+		jobs, err := s.repo.ListScraperJobsForWorker(ctx, jobStatus)
+		if err != nil {
+			return fmt.Errorf("failed to load %s jobs: %w", jobStatus.String(), err)
+		}
+
+		for i := range jobs {
+			job := &jobs[i]
+
+			switch {
+			case job.Status == repository.JobStatusRunning && job.ClaimedBy != "":
+				continue
+			case job.Status == repository.JobStatusRunning:
+				job.NextRunAt = now
+			case job.NextRunAt.IsZero():
+				nextRun, err := s.calculateNextRunTime(job.Schedule, now)
+				if err != nil {
+					log.Printf("Skipping scraper job %s with invalid schedule: %v", job.ID, err)
+					continue
+				}
+				job.NextRunAt = nextRun
+			default:
+				continue
+			}
+
+			if job.NextRunAt.IsZero() {
+				job.Status = repository.JobStatusCompleted
+			} else {
+				job.Status = statusForNextRun(job.NextRunAt)
+			}
+
+			if _, err := s.repo.UpdateScraperJob(ctx, job); err != nil {
+				log.Printf("Error resuming scraper job %s: %v", job.ID, err)
+			}
+		}
+	}
+
+	// Queue everything that is already due
+	s.dispatchDueJobs()
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+func TestResumeJobsCoversEveryTenant(t *testing.T) {
+	repo := newFakeRepository(
+		// Started before leases existed
+		repository.ScraperJob{ID: "a1", TenantID: "tenant-a", Status: repository.JobStatusRunning},
+		repository.ScraperJob{ID: "b1", TenantID: "tenant-b", Status: repository.JobStatusRunning},
+		// Leased to a live worker
+		repository.ScraperJob{
+			ID: "b2", TenantID: "tenant-b", Status: repository.JobStatusRunning,
+			ClaimedBy: "other-worker", LeaseExpiresAt: time.Now().Add(time.Minute),
+		},
+	)
+	s := newTestService(t, repo)
+
+	if err := s.ResumeJobs(context.Background()); err != nil {
+		t.Fatalf("ResumeJobs() error = %v", err)
+	}
+
+	for _, jobID := range []string{"a1", "b1"} {
+		job := repo.job(jobID)
+		if job.Status != repository.JobStatusScheduled || job.NextRunAt.IsZero() {
+			t.Errorf("job %s resumed as %s at %v, want scheduled now", jobID, job.Status.String(), job.NextRunAt)
+		}
+	}
+	if job := repo.job("b2"); job.Status != repository.JobStatusRunning {
+		t.Errorf("leased job b2 resumed as %s, want it left running", job.Status.String())
+	}
+
+	queued := make(map[string]int)
+	for _, status := range s.queue.status() {
+		queued[status.TenantID] = status.Queued
+	}
+	if queued["tenant-a"] != 1 || queued["tenant-b"] != 1 {
+		t.Errorf("queued jobs per tenant = %v, want one each for tenant-a and tenant-b", queued)
+	}
+}
@@ -2,94 +2,19 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/donaldnash/go-competitor/common/db"
+	"github.com/donaldnash/go-competitor/scraper/platform"
 	"github.com/donaldnash/go-competitor/scraper/repository"
 	"github.com/robfig/cron/v3"
 )
 
-// Platforms supported by the scraper
-var supportedPlatforms = map[string]PlatformInfo{
-	"instagram": {
-		Name:        "instagram",
-		DisplayName: "Instagram",
-		Description: "Meta's photo and video sharing social network",
-		SupportedJobTypes: []repository.JobType{
-			repository.JobTypeProfile,
-			repository.JobTypePosts,
-			repository.JobTypeEngagement,
-			repository.JobTypeFollowers,
-		},
-		RateLimits: PlatformRateLimits{
-			RequestsPerMinute: 30,
-			RequestsPerHour:   500,
-			RequestsPerDay:    5000,
-		},
-	},
-	"twitter": {
-		Name:        "twitter",
-		DisplayName: "Twitter / X",
-		Description: "Short-form microblogging social network",
-		SupportedJobTypes: []repository.JobType{
-			repository.JobTypeProfile,
-			repository.JobTypePosts,
-			repository.JobTypeEngagement,
-			repository.JobTypeFollowers,
-		},
-		RateLimits: PlatformRateLimits{
-			RequestsPerMinute: 50,
-			RequestsPerHour:   1500,
-			RequestsPerDay:    10000,
-		},
-	},
-	"facebook": {
-		Name:        "facebook",
-		DisplayName: "Facebook",
-		Description: "Meta's social networking platform",
-		SupportedJobTypes: []repository.JobType{
-			repository.JobTypeProfile,
-			repository.JobTypePosts,
-			repository.JobTypeEngagement,
-		},
-		RateLimits: PlatformRateLimits{
-			RequestsPerMinute: 20,
-			RequestsPerHour:   200,
-			RequestsPerDay:    2000,
-		},
-	},
-	"linkedin": {
-		Name:        "linkedin",
-		DisplayName: "LinkedIn",
-		Description: "Professional networking and career development platform",
-		SupportedJobTypes: []repository.JobType{
-			repository.JobTypeProfile,
-			repository.JobTypePosts,
-			repository.JobTypeEngagement,
-		},
-		RateLimits: PlatformRateLimits{
-			RequestsPerMinute: 10,
-			RequestsPerHour:   100,
-			RequestsPerDay:    1000,
-		},
-	},
-	"tiktok": {
-		Name:        "tiktok",
-		DisplayName: "TikTok",
-		Description: "Short-form video hosting service",
-		SupportedJobTypes: []repository.JobType{
-			repository.JobTypeProfile,
-			repository.JobTypePosts,
-			repository.JobTypeEngagement,
-		},
-		RateLimits: PlatformRateLimits{
-			RequestsPerMinute: 15,
-			RequestsPerHour:   150,
-			RequestsPerDay:    1500,
-		},
-	},
-}
-
 // PlatformInfo contains information about a supported platform
 type PlatformInfo struct {
 	Name              string
@@ -97,8 +22,28 @@ type PlatformInfo struct {
 	Description       string
 	SupportedJobTypes []repository.JobType
 	RateLimits        PlatformRateLimits
+	RetryPolicy       RetryPolicy // Zero fields fall back to defaultRetryPolicy
 }
 
+// platformInfo converts a platform's registry metadata to a PlatformInfo
+func platformInfo(info platform.Info) PlatformInfo {
+	return PlatformInfo{
+		Name:              info.Name,
+		DisplayName:       info.DisplayName,
+		Description:       info.Description,
+		SupportedJobTypes: info.SupportedJobTypes,
+		RateLimits: PlatformRateLimits{
+			RequestsPerMinute: info.RateLimits.RequestsPerMinute,
+			RequestsPerHour:   info.RateLimits.RequestsPerHour,
+			RequestsPerDay:    info.RateLimits.RequestsPerDay,
+		},
+		RetryPolicy: RetryPolicy(info.RetryPolicy),
+	}
+}
+
+// UnlimitedRequests is the AvailableRequests of platforms without rate limits
+const UnlimitedRequests = -1
+
 // PlatformRateLimits contains rate limit information for a platform
 type PlatformRateLimits struct {
 	RequestsPerMinute int
@@ -121,78 +66,159 @@ type PlatformStatus struct {
 type ScraperService struct {
 	repo        repository.ScraperRepository
 	scheduler   *cron.Cron
+	platforms   *platform.Registry
 	platformAPI map[string]PlatformAPI
+	limiter     *RateLimiter
+	normalizer  *Normalizer
+	media       *MediaCapturer
+	tenants     TenantDirectory
+
+	// Executor state
+	queue       *jobQueue
+	workerCount int
+	workers     sync.WaitGroup
+	workerID    string // Identifies this replica in job leases
+	events      *jobBroker
+
+	// Tenant tiers looked up from the auth service
+	tierCache map[string]cachedTier
+	tierMu    sync.Mutex
 }
 
 // PlatformAPI defines the interface for platform-specific scrapers
-type PlatformAPI interface {
-	GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error)
-	GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error)
-	GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error)
-	GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error)
-	GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error)
-}
+type PlatformAPI = platform.API
 
-// NewScraperService creates a new ScraperService
-func NewScraperService(repo repository.ScraperRepository) *ScraperService {
-	scheduler := cron.New(cron.WithSeconds())
+// NewScraperService creates a new ScraperService that scrapes the platforms in the given registry,
+// usually created with platform.NewRegistry. The normalizer is optional; without it scraped posts
+// are only kept in scraped_data. The media capturer is optional too; without it post media isn't
+// downloaded. The tenant directory is also optional; without it every tenant gets the queue limits
+// of the default tier.
+func NewScraperService(repo repository.ScraperRepository, normalizer *Normalizer, capturer *MediaCapturer,
+	tenants TenantDirectory, platforms *platform.Registry) *ScraperService {
 
-	// Start the scheduler
-	scheduler.Start()
+	scheduler := cron.New(cron.WithSeconds())
 
-	// Initialize platform APIs
+	// Route every platform call through the rate limiter
+	limits := make(map[string]PlatformRateLimits)
 	platformAPI := make(map[string]PlatformAPI)
+	for _, info := range platforms.Platforms() {
+		limits[info.Name] = platformInfo(info).RateLimits
+	}
+	limiter := NewRateLimiter(limits)
+	for name := range limits {
+		api, _ := platforms.API(name)
+		platformAPI[name] = &rateLimitedAPI{platform: name, limiter: limiter, api: api}
+	}
 
-	// For now, we'll just have placeholder implementations
-	platformAPI["instagram"] = &InstagramAPI{}
-	platformAPI["twitter"] = &TwitterAPI{}
-	platformAPI["facebook"] = &FacebookAPI{}
-	platformAPI["linkedin"] = &LinkedInAPI{}
-	platformAPI["tiktok"] = &TikTokAPI{}
-
-	return &ScraperService{
+	s := &ScraperService{
 		repo:        repo,
 		scheduler:   scheduler,
+		platforms:   platforms,
 		platformAPI: platformAPI,
+		limiter:     limiter,
+		normalizer:  normalizer,
+		media:       capturer,
+		tenants:     tenants,
+		queue:       newJobQueue(tenantQueueSize),
+		workerCount: defaultWorkerCount,
+		workerID:    newWorkerID(),
+		events:      newJobBroker(),
+		tierCache:   make(map[string]cachedTier),
+	}
+
+	// Start the worker pool and poll for due jobs
+	s.startWorkers(s.workerCount)
+	if _, err := scheduler.AddFunc(dispatchSpec, s.dispatchDueJobs); err != nil {
+		log.Printf("Failed to register job dispatcher: %v", err)
+	}
+
+	// Delete data that has outlived its tenant's retention policies
+	if _, err := scheduler.AddFunc(purgeSpec, s.purgeExpiredData); err != nil {
+		log.Printf("Failed to register data purger: %v", err)
 	}
+
+	// Start the scheduler
+	scheduler.Start()
+
+	return s
+}
+
+// Stop stops the scheduler and waits for running jobs to finish
+func (s *ScraperService) Stop() {
+	<-s.scheduler.Stop().Done()
+	s.queue.close()
+	s.workers.Wait()
 }
 
-// GetSupportedPlatforms returns a list of supported platforms
+// GetSupportedPlatforms returns the platforms in the registry, sorted by name
 func (s *ScraperService) GetSupportedPlatforms(ctx context.Context) []PlatformInfo {
-	platforms := make([]PlatformInfo, 0, len(supportedPlatforms))
-	for _, platform := range supportedPlatforms {
-		platforms = append(platforms, platform)
+	registered := s.platforms.Platforms()
+	platforms := make([]PlatformInfo, 0, len(registered))
+	for _, info := range registered {
+		platforms = append(platforms, platformInfo(info))
 	}
 	return platforms
 }
 
+// lookupPlatform returns the metadata of a platform in the registry
+func (s *ScraperService) lookupPlatform(name string) (PlatformInfo, bool) {
+	info, exists := s.platforms.Lookup(name)
+	if !exists {
+		return PlatformInfo{}, false
+	}
+	return platformInfo(info), true
+}
+
 // GetPlatformStatus returns the status of a platform
 func (s *ScraperService) GetPlatformStatus(ctx context.Context, platform string) (*PlatformStatus, error) {
-	info, exists := supportedPlatforms[platform]
+	info, exists := s.lookupPlatform(platform)
 	if !exists {
 		return nil, fmt.Errorf("platform not supported: %s", platform)
 	}
 
-	// In a real implementation, we would check the actual status of the platform
-	// For now, we'll just return a mock status
+	// Report the remaining request budget
+	rateLimits := info.RateLimits
+	available, resetAt, limited := s.limiter.Status(platform)
+	rateLimits.AvailableRequests, rateLimits.ResetAt = available, resetAt
+	if !limited {
+		rateLimits.AvailableRequests = UnlimitedRequests
+	}
+
+	statusMessage := "Platform is operational"
+	if limited && available == 0 {
+		statusMessage = "Rate limit reached, requests resume at " + rateLimits.ResetAt.Format(time.RFC3339)
+	}
+
 	return &PlatformStatus{
 		Platform:      platform,
 		Available:     true,
-		StatusMessage: "Platform is operational",
-		RateLimits:    info.RateLimits,
+		StatusMessage: statusMessage,
+		RateLimits:    rateLimits,
 		LastChecked:   time.Now(),
 	}, nil
 }
 
-// CreateScraperJob creates a new scraper job
+// CreateScraperJob creates a new scraper job. The priority orders the job among the tenant's
+// other due jobs and must be between MinJobPriority and MaxJobPriority. A non-zero backfillUntil makes
+// a posts job walk back through the target's post history to that date before following its schedule.
 func (s *ScraperService) CreateScraperJob(ctx context.Context, tenantID, platform, targetID string,
-	jobType repository.JobType, schedule repository.ScraperSchedule, metadata map[string]string) (*repository.ScraperJob, error) {
+	jobType repository.JobType, schedule repository.ScraperSchedule, priority int, metadata map[string]string,
+	backfillUntil time.Time) (*repository.ScraperJob, error) {
 
 	// Validate platform
-	if _, exists := supportedPlatforms[platform]; !exists {
+	info, exists := s.lookupPlatform(platform)
+	if !exists {
 		return nil, fmt.Errorf("platform not supported: %s", platform)
 	}
 
+	if !slices.Contains(info.SupportedJobTypes, jobType) {
+		return nil, fmt.Errorf("%s does not support %s jobs", platform, jobType.String())
+	}
+
+	if priority < MinJobPriority || priority > MaxJobPriority {
+		return nil, fmt.Errorf("priority must be between %d and %d", MinJobPriority, MaxJobPriority)
+	}
+
 	// Create the job
 	job := &repository.ScraperJob{
 		TenantID:  tenantID,
@@ -201,28 +227,48 @@ func (s *ScraperService) CreateScraperJob(ctx context.Context, tenantID, platfor
 		JobType:   jobType,
 		Status:    repository.JobStatusPending,
 		Schedule:  schedule,
+		Priority:  priority,
 		Metadata:  metadata,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
 
+	if _, err := s.retryPolicy(job); err != nil {
+		return nil, fmt.Errorf("invalid retry policy: %w", err)
+	}
+
+	if _, err := s.pipelineSteps(job); err != nil {
+		return nil, fmt.Errorf("invalid pipeline: %w", err)
+	}
+
+	// Backfills walk the post history back to a date before picking up the schedule
+	if !backfillUntil.IsZero() {
+		backfill, err := newBackfill(jobType, backfillUntil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backfill: %w", err)
+		}
+		job.Backfill = backfill
+	}
+
 	// Calculate next run time based on schedule
-	nextRun, err := s.calculateNextRunTime(schedule)
+	nextRun, err := s.calculateNextRunTime(schedule, time.Now())
 	if err != nil {
 		return nil, fmt.Errorf("invalid schedule: %w", err)
 	}
+	if nextRun.IsZero() {
+		return nil, errors.New("invalid schedule: end date is before the first run")
+	}
 	job.NextRunAt = nextRun
 
-	// If the job should run immediately, set its status to scheduled
-	if nextRun.Before(time.Now().Add(5 * time.Minute)) {
-		job.Status = repository.JobStatusScheduled
-	}
+	// If the job should run soon, set its status to scheduled
+	job.Status = statusForNextRun(nextRun)
 
 	// Save to repository
 	job, err = s.repo.CreateScraperJob(ctx, job)
 	if err != nil {
 		return nil, err
 	}
+	s.publishJobEvent(job, nil)
 
 	// Schedule the job
 	s.scheduleJob(job)
@@ -235,11 +281,11 @@ func (s *ScraperService) GetScraperJob(ctx context.Context, tenantID, jobID stri
 	return s.repo.GetScraperJob(ctx, tenantID, jobID)
 }
 
-// GetScraperJobs retrieves all scraper jobs matching the filters
+// GetScraperJobs retrieves a page of the scraper jobs matching the filters
 func (s *ScraperService) GetScraperJobs(ctx context.Context, tenantID, platform string,
-	jobType repository.JobType, status repository.JobStatus) ([]repository.ScraperJob, error) {
+	jobType repository.JobType, status repository.JobStatus, page db.Page) ([]repository.ScraperJob, db.PageInfo, error) {
 
-	return s.repo.GetScraperJobs(ctx, tenantID, platform, jobType, status)
+	return s.repo.GetScraperJobs(ctx, tenantID, platform, jobType, status, page)
 }
 
 // CancelScraperJob cancels a scraper job
@@ -257,174 +303,59 @@ func (s *ScraperService) CancelScraperJob(ctx context.Context, tenantID, jobID s
 	job.Status = repository.JobStatusCancelled
 	job.UpdatedAt = time.Now()
 
-	return s.repo.UpdateScraperJob(ctx, job)
-}
-
-// DeleteScraperJob deletes a scraper job
-func (s *ScraperService) DeleteScraperJob(ctx context.Context, tenantID, jobID string) error {
-	return s.repo.DeleteScraperJob(ctx, tenantID, jobID)
-}
-
-// GetScrapedData retrieves scraped data for a specific job
-func (s *ScraperService) GetScrapedData(ctx context.Context, tenantID, jobID string,
-	startDate, endDate time.Time) ([]repository.ScrapedDataItem, error) {
-
-	return s.repo.GetScrapedData(ctx, tenantID, jobID, startDate, endDate)
-}
-
-// calculateNextRunTime calculates the next run time based on the schedule
-func (s *ScraperService) calculateNextRunTime(schedule repository.ScraperSchedule) (time.Time, error) {
-	// If there's a cron expression, use it
-	if schedule.CronExpression != "" {
-		parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-		schedule, err := parser.Parse(schedule.CronExpression)
-		if err != nil {
-			return time.Time{}, fmt.Errorf("invalid cron expression: %w", err)
-		}
-		return schedule.Next(time.Now()), nil
-	}
-
-	// Otherwise, use the frequency
-	now := time.Now()
-	switch schedule.Frequency {
-	case repository.FrequencyOnce:
-		// If start date is provided and it's in the future, use it
-		if !schedule.StartDate.IsZero() && schedule.StartDate.After(now) {
-			return schedule.StartDate, nil
-		}
-		// Otherwise, run immediately
-		return now, nil
-	case repository.FrequencyHourly:
-		return now.Add(1 * time.Hour), nil
-	case repository.FrequencyDaily:
-		return now.Add(24 * time.Hour), nil
-	case repository.FrequencyWeekly:
-		return now.Add(7 * 24 * time.Hour), nil
-	default:
-		return time.Time{}, fmt.Errorf("unsupported frequency: %s", schedule.Frequency.String())
+	job, err = s.repo.UpdateScraperJob(ctx, job)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// scheduleJob schedules a job to be executed
-func (s *ScraperService) scheduleJob(job *repository.ScraperJob) {
-	// In a real implementation, we would use the scheduler to run the job
-	// For now, we'll just log that the job is scheduled
-	fmt.Printf("Job %s scheduled to run at %s\n", job.ID, job.NextRunAt.Format(time.RFC3339))
-}
-
-// Placeholder implementations for platform APIs
-
-// InstagramAPI is a placeholder implementation for Instagram
-type InstagramAPI struct{}
-
-func (a *InstagramAPI) GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"id": targetID, "platform": "instagram"}, nil
-}
-
-func (a *InstagramAPI) GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "post1", "platform": "instagram"}}, nil
-}
-
-func (a *InstagramAPI) GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"likes": 100, "comments": 20}, nil
-}
-
-func (a *InstagramAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "user1", "platform": "instagram"}}, nil
-}
-
-func (a *InstagramAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "comment1", "text": "Great post!"}}, nil
-}
-
-// TwitterAPI is a placeholder implementation for Twitter
-type TwitterAPI struct{}
-
-func (a *TwitterAPI) GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"id": targetID, "platform": "twitter"}, nil
-}
-
-func (a *TwitterAPI) GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "tweet1", "platform": "twitter"}}, nil
-}
-
-func (a *TwitterAPI) GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"likes": 50, "retweets": 10}, nil
-}
-
-func (a *TwitterAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "user1", "platform": "twitter"}}, nil
-}
-
-func (a *TwitterAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "reply1", "text": "Interesting!"}}, nil
-}
-
-// FacebookAPI is a placeholder implementation for Facebook
-type FacebookAPI struct{}
-
-func (a *FacebookAPI) GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"id": targetID, "platform": "facebook"}, nil
-}
-
-func (a *FacebookAPI) GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "post1", "platform": "facebook"}}, nil
-}
-
-func (a *FacebookAPI) GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"likes": 200, "shares": 30}, nil
-}
-
-func (a *FacebookAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "user1", "platform": "facebook"}}, nil
-}
-
-func (a *FacebookAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "comment1", "text": "Awesome!"}}, nil
-}
 
-// LinkedInAPI is a placeholder implementation for LinkedIn
-type LinkedInAPI struct{}
-
-func (a *LinkedInAPI) GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"id": targetID, "platform": "linkedin"}, nil
+	s.publishJobEvent(job, nil)
+	return job, nil
 }
 
-func (a *LinkedInAPI) GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "post1", "platform": "linkedin"}}, nil
+// DeleteScraperJob deletes a scraper job along with its runs, scraped data, captured media and the jobs
+// spawned by its pipeline
+func (s *ScraperService) DeleteScraperJob(ctx context.Context, tenantID, jobID string) error {
+	if err := s.deleteChildJobs(ctx, tenantID, jobID); err != nil {
+		return fmt.Errorf("failed to delete child jobs: %w", err)
+	}
+	return s.deleteJob(ctx, tenantID, jobID)
 }
 
-func (a *LinkedInAPI) GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"likes": 150, "comments": 25}, nil
+// deleteJob deletes a job and its rows, releasing the copies of its captured media first
+func (s *ScraperService) deleteJob(ctx context.Context, tenantID, jobID string) error {
+	if _, err := s.deleteMedia(ctx, tenantID, jobID, time.Time{}); err != nil {
+		return fmt.Errorf("failed to delete media: %w", err)
+	}
+	return s.repo.DeleteScraperJob(ctx, tenantID, jobID)
 }
 
-func (a *LinkedInAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "user1", "platform": "linkedin"}}, nil
-}
+// GetScrapedData retrieves a page of the scraped data for a specific job
+func (s *ScraperService) GetScrapedData(ctx context.Context, tenantID, jobID string,
+	startDate, endDate time.Time, page db.Page) ([]repository.ScrapedDataItem, db.PageInfo, error) {
 
-func (a *LinkedInAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "comment1", "text": "Insightful!"}}, nil
+	return s.repo.GetScrapedData(ctx, tenantID, jobID, startDate, endDate, page)
 }
 
-// TikTokAPI is a placeholder implementation for TikTok
-type TikTokAPI struct{}
-
-func (a *TikTokAPI) GetProfile(ctx context.Context, targetID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"id": targetID, "platform": "tiktok"}, nil
-}
+// ListComments retrieves a page of the comments scraped from a post, oldest first
+func (s *ScraperService) ListComments(ctx context.Context, tenantID, platform, postID string,
+	startDate, endDate time.Time, page db.Page) ([]repository.Comment, db.PageInfo, error) {
 
-func (a *TikTokAPI) GetPosts(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "video1", "platform": "tiktok"}}, nil
+	return s.repo.GetComments(ctx, tenantID, platform, postID, startDate, endDate, page)
 }
 
-func (a *TikTokAPI) GetEngagement(ctx context.Context, targetID, postID string) (map[string]interface{}, error) {
-	return map[string]interface{}{"likes": 500, "shares": 100}, nil
+// ListJobRuns retrieves a page of the run history of a job, most recent first
+func (s *ScraperService) ListJobRuns(ctx context.Context, tenantID, jobID string, page db.Page) ([]repository.JobRun, db.PageInfo, error) {
+	return s.repo.GetJobRuns(ctx, tenantID, jobID, page)
 }
 
-func (a *TikTokAPI) GetFollowers(ctx context.Context, targetID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "user1", "platform": "tiktok"}}, nil
-}
+// scheduleJob schedules a job to be executed
+func (s *ScraperService) scheduleJob(job *repository.ScraperJob) {
+	// Jobs that are already due go straight to the workers, the rest are
+	// picked up by the dispatcher once their next run time has passed
+	if !job.NextRunAt.After(time.Now()) {
+		s.enqueue(job)
+		return
+	}
 
-func (a *TikTokAPI) GetComments(ctx context.Context, targetID, postID string, count int) ([]map[string]interface{}, error) {
-	return []map[string]interface{}{{"id": "comment1", "text": "Amazing video!"}}, nil
+	log.Printf("Job %s scheduled to run at %s", job.ID, job.NextRunAt.Format(time.RFC3339))
 }
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// PostSnapshot is a post's counters as they were when it was scraped. Rates are per hour since the
+// previous snapshot, or since the post was published for the first one.
+type PostSnapshot struct {
+	ScrapedAt       time.Time
+	Likes           int
+	Shares          int
+	Comments        int
+	Views           int
+	LikesPerHour    float64
+	SharesPerHour   float64
+	CommentsPerHour float64
+}
+
+// PostVelocity is how fast a post has gained engagement since it was published
+type PostVelocity struct {
+	Platform        string
+	TargetID        string
+	PostID          string
+	PostedAt        time.Time // Zero when the platform didn't report it, in which case rates start from the first snapshot
+	Likes           int       // Counters of the latest snapshot
+	Shares          int
+	Comments        int
+	Views           int
+	LikesPerHour    float64
+	SharesPerHour   float64
+	CommentsPerHour float64
+	Snapshots       []PostSnapshot // Oldest first
+}
+
+// changedSnapshots drops the posts whose counters haven't changed since their latest stored
+// snapshot, so scraping a post again only stores a snapshot when it has gained engagement.
+// Items other than posts are kept. When the snapshots can't be looked up every item is kept.
+func (s *ScraperService) changedSnapshots(ctx context.Context, job *repository.ScraperJob,
+	items []repository.ScrapedDataItem) []repository.ScrapedDataItem {
+
+	var postIDs []string
+	for _, item := range items {
+		if item.DataType == repository.DataTypePost && item.PostID != "" {
+			postIDs = append(postIDs, item.PostID)
+		}
+	}
+	if len(postIDs) == 0 {
+		return items
+	}
+
+	snapshots, err := s.repo.GetLatestPostSnapshots(ctx, job.TenantID, job.Platform, job.TargetID, postIDs)
+	if err != nil {
+		log.Printf("Error looking up post snapshots for scraper job %s: %v", job.ID, err)
+		return items
+	}
+
+	latest := make(map[string]repository.ScrapedDataItem, len(snapshots))
+	for _, snapshot := range snapshots {
+		latest[snapshot.PostID] = snapshot
+	}
+
+	changed := make([]repository.ScrapedDataItem, 0, len(items))
+	for _, item := range items {
+		if item.DataType != repository.DataTypePost || item.PostID == "" {
+			changed = append(changed, item)
+			continue
+		}
+
+		// A post listed twice in one run is compared against the copy kept before it
+		if previous, exists := latest[item.PostID]; exists && sameCounters(previous, item) {
+			continue
+		}
+		latest[item.PostID] = item
+		changed = append(changed, item)
+	}
+
+	return changed
+}
+
+// sameCounters reports whether two snapshots of a post have the same metrics
+func sameCounters(a, b repository.ScrapedDataItem) bool {
+	return a.Likes == b.Likes &&
+		a.Shares == b.Shares &&
+		a.Comments == b.Comments &&
+		a.CTR == b.CTR &&
+		a.AvgWatchTime == b.AvgWatchTime &&
+		a.EngagementRate == b.EngagementRate &&
+		a.ContentAttributes["views"] == b.ContentAttributes["views"]
+}
+
+// GetPostVelocity retrieves the snapshots of a target's posts scraped within a date range and how fast
+// each post gained likes, shares and comments. The post ID is optional. Posts are ordered newest first.
+func (s *ScraperService) GetPostVelocity(ctx context.Context, tenantID, platformName, targetID, postID string,
+	startDate, endDate time.Time) ([]PostVelocity, error) {
+
+	items, err := s.repo.GetPostSnapshots(ctx, tenantID, platformName, targetID, postID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Group the snapshots by post, keeping them oldest first
+	var order []string
+	byPost := make(map[string][]repository.ScrapedDataItem)
+	for _, item := range items {
+		if item.PostID == "" {
+			continue
+		}
+		if _, exists := byPost[item.PostID]; !exists {
+			order = append(order, item.PostID)
+		}
+		byPost[item.PostID] = append(byPost[item.PostID], item)
+	}
+
+	velocities := make([]PostVelocity, 0, len(order))
+	for _, id := range order {
+		velocities = append(velocities, postVelocity(byPost[id]))
+	}
+
+	sort.SliceStable(velocities, func(i, j int) bool {
+		return velocities[i].PostedAt.After(velocities[j].PostedAt)
+	})
+
+	return velocities, nil
+}
+
+// postVelocity computes the velocity of a post from its snapshots, oldest first
+func postVelocity(items []repository.ScrapedDataItem) PostVelocity {
+	first := items[0]
+	velocity := PostVelocity{
+		Platform: first.Platform,
+		TargetID: first.TargetID,
+		PostID:   first.PostID,
+	}
+
+	// Engagement jobs don't report when a post was published, so take it from any snapshot that does
+	for _, item := range items {
+		if !item.PostedAt.IsZero() && (velocity.PostedAt.IsZero() || item.PostedAt.Before(velocity.PostedAt)) {
+			velocity.PostedAt = item.PostedAt
+		}
+	}
+
+	// Counters start from zero when the post was published, or from the first snapshot when that isn't known
+	previous := PostSnapshot{ScrapedAt: velocity.PostedAt}
+	if velocity.PostedAt.IsZero() {
+		previous = snapshotOf(first)
+	}
+	baseline := previous
+
+	velocity.Snapshots = make([]PostSnapshot, len(items))
+	for i, item := range items {
+		snapshot := snapshotOf(item)
+		if i > 0 || !velocity.PostedAt.IsZero() {
+			hours := snapshot.ScrapedAt.Sub(previous.ScrapedAt).Hours()
+			snapshot.LikesPerHour = perHour(snapshot.Likes-previous.Likes, hours)
+			snapshot.SharesPerHour = perHour(snapshot.Shares-previous.Shares, hours)
+			snapshot.CommentsPerHour = perHour(snapshot.Comments-previous.Comments, hours)
+		}
+		velocity.Snapshots[i] = snapshot
+		previous = snapshot
+	}
+
+	hours := previous.ScrapedAt.Sub(baseline.ScrapedAt).Hours()
+	velocity.Likes = previous.Likes
+	velocity.Shares = previous.Shares
+	velocity.Comments = previous.Comments
+	velocity.Views = previous.Views
+	velocity.LikesPerHour = perHour(previous.Likes-baseline.Likes, hours)
+	velocity.SharesPerHour = perHour(previous.Shares-baseline.Shares, hours)
+	velocity.CommentsPerHour = perHour(previous.Comments-baseline.Comments, hours)
+
+	return velocity
+}
+
+// snapshotOf converts a stored post to a snapshot without rates
+func snapshotOf(item repository.ScrapedDataItem) PostSnapshot {
+	views, _ := strconv.Atoi(item.ContentAttributes["views"])
+	return PostSnapshot{
+		ScrapedAt: item.ScrapedAt,
+		Likes:     item.Likes,
+		Shares:    item.Shares,
+		Comments:  item.Comments,
+		Views:     views,
+	}
+}
+
+// perHour divides a gain by a number of hours, which is 0 when no time has passed
+func perHour(gain int, hours float64) float64 {
+	if hours <= 0 {
+		return 0
+	}
+	return float64(gain) / hours
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// watchBufferSize is how many events a watcher can fall behind by before it is disconnected
+const watchBufferSize = 64
+
+// ErrWatchLagged is returned by a JobWatch whose reader fell too far behind the events.
+// Watchers that get it should reload the jobs they care about and watch again.
+var ErrWatchLagged = errors.New("job watch fell behind and was disconnected")
+
+// JobFilter selects the jobs a watch receives events for. Empty fields match every job.
+type JobFilter struct {
+	TenantID string
+	Platform string
+	JobID    string
+}
+
+// matches returns whether a job passes the filter
+func (f JobFilter) matches(job *repository.ScraperJob) bool {
+	return (f.TenantID == "" || job.TenantID == f.TenantID) &&
+		(f.Platform == "" || job.Platform == f.Platform) &&
+		(f.JobID == "" || job.ID == f.JobID)
+}
+
+// JobEvent is a change to a job, such as a status transition or the outcome of a run
+type JobEvent struct {
+	Job          repository.ScraperJob // The job after the change
+	RunID        string                // Run the change belongs to, if any
+	ItemsScraped int                   // Items stored by the run, once it has finished
+	Error        string
+	OccurredAt   time.Time
+}
+
+// JobWatch receives the events of the jobs matching a filter
+type JobWatch struct {
+	filter    JobFilter
+	events    chan JobEvent
+	delivered bool
+	err       error
+}
+
+// Events returns the channel events are delivered on. It is closed when the watch ends.
+func (w *JobWatch) Events() <-chan JobEvent {
+	return w.events
+}
+
+// Err returns why the watch ended once Events has been closed, or nil if its context was cancelled
+func (w *JobWatch) Err() error {
+	return w.err
+}
+
+// jobBroker fans job events out to watches. Events are only published by the replica that
+// made the change, so a watch sees the jobs run and changed through its own replica.
+type jobBroker struct {
+	mu      sync.Mutex
+	watches map[*JobWatch]struct{}
+}
+
+// newJobBroker creates a jobBroker without any watches
+func newJobBroker() *jobBroker {
+	return &jobBroker{watches: make(map[*JobWatch]struct{})}
+}
+
+// subscribe starts a watch for the jobs matching a filter
+func (b *jobBroker) subscribe(filter JobFilter) *JobWatch {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	watch := &JobWatch{filter: filter, events: make(chan JobEvent, watchBufferSize)}
+	b.watches[watch] = struct{}{}
+	return watch
+}
+
+// unsubscribe ends a watch unless it has already ended
+func (b *jobBroker) unsubscribe(watch *JobWatch, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.end(watch, err)
+}
+
+// end removes a watch and closes its channel. It must be called with the lock held.
+func (b *jobBroker) end(watch *JobWatch, err error) {
+	if _, exists := b.watches[watch]; !exists {
+		return
+	}
+	delete(b.watches, watch)
+
+	watch.err = err
+	close(watch.events)
+}
+
+// publish delivers an event to every watch whose filter matches the job. Publishing never
+// blocks the workers, so a watch whose buffer is full is ended instead.
+func (b *jobBroker) publish(event JobEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for watch := range b.watches {
+		if watch.filter.matches(&event.Job) {
+			b.deliver(watch, event)
+		}
+	}
+}
+
+// deliver sends an event to a watch. It must be called with the lock held.
+func (b *jobBroker) deliver(watch *JobWatch, event JobEvent) {
+	select {
+	case watch.events <- event:
+		watch.delivered = true
+	default:
+		b.end(watch, ErrWatchLagged)
+	}
+}
+
+// deliverInitial sends a job's current state to a watch, unless a newer event has already been delivered
+func (b *jobBroker) deliverInitial(watch *JobWatch, event JobEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.watches[watch]; exists && !watch.delivered {
+		b.deliver(watch, event)
+	}
+}
+
+// WatchScraperJobs streams changes to the jobs matching a filter until ctx is cancelled. Watching
+// a single job starts with its current state, so a run that finished before the watch started isn't missed.
+func (s *ScraperService) WatchScraperJobs(ctx context.Context, filter JobFilter) (*JobWatch, error) {
+	watch := s.events.subscribe(filter)
+
+	if filter.JobID != "" {
+		job, err := s.repo.GetScraperJob(ctx, filter.TenantID, filter.JobID)
+		if err != nil {
+			s.events.unsubscribe(watch, nil)
+			return nil, err
+		}
+		s.events.deliverInitial(watch, JobEvent{Job: *job, Error: job.LastError, OccurredAt: job.UpdatedAt})
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.events.unsubscribe(watch, nil)
+	}()
+
+	return watch, nil
+}
+
+// publishJobEvent tells watchers about a change to a job. Runs are passed once they have
+// started, so watchers can follow the run through to its item count and error.
+func (s *ScraperService) publishJobEvent(job *repository.ScraperJob, run *repository.JobRun) {
+	event := JobEvent{
+		Job:        *job,
+		Error:      job.LastError,
+		OccurredAt: time.Now(),
+	}
+
+	if run != nil {
+		event.RunID = run.ID
+		event.ItemsScraped = run.ItemsScraped
+		if run.Error != "" {
+			event.Error = run.Error
+		}
+	}
+
+	s.events.publish(event)
+}
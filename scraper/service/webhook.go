@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/repository"
+)
+
+// webhookDeliveryRetention is how long webhook deliveries are remembered for deduplication.
+// Platforms give up redelivering an event well before then.
+const webhookDeliveryRetention = 7 * 24 * time.Hour
+
+// WebhookEventKind is what a webhook event reports
+type WebhookEventKind int
+
+const (
+	WebhookEventPost    WebhookEventKind = iota // A post published by the account
+	WebhookEventComment                         // A comment left on one of the account's posts
+	WebhookEventMention                         // A post by someone else mentioning the account
+)
+
+// WebhookEvent is a post, comment or mention a platform pushed by webhook
+type WebhookEvent struct {
+	ID         string // Identifies the event across redeliveries
+	Kind       WebhookEventKind
+	AccountIDs []string         // IDs and usernames of the account the event belongs to, matched against job targets
+	Post       platform.Post    // Set for posts and mentions
+	Comment    platform.Comment // Set for comments
+}
+
+// webhookJobTypes are the jobs whose tenants receive each kind of event, in order of preference
+var webhookJobTypes = map[WebhookEventKind][]repository.JobType{
+	WebhookEventPost:    {repository.JobTypePosts},
+	WebhookEventComment: {repository.JobTypeComments, repository.JobTypePosts},
+	WebhookEventMention: {repository.JobTypeMentions},
+}
+
+// IngestWebhookEvents stores the events a platform pushed by webhook for every tenant with a job tracking
+// the account they belong to. Each event's delivery is recorded before the event is stored, so when the
+// platform redelivers an event, even while the first delivery is still being stored, it isn't stored
+// twice. It returns the number of items stored.
+func (s *ScraperService) IngestWebhookEvents(ctx context.Context, platformName string, events []WebhookEvent) (int, error) {
+	if _, exists := s.lookupPlatform(platformName); !exists {
+		return 0, fmt.Errorf("platform not supported: %s", platformName)
+	}
+
+	stored := 0
+	for _, event := range events {
+		delivery, err := s.repo.SaveWebhookDelivery(ctx, &repository.WebhookDelivery{
+			Platform:   platformName,
+			EventID:    event.ID,
+			ReceivedAt: time.Now(),
+		})
+		if errors.Is(err, repository.ErrDuplicateDelivery) {
+			continue
+		}
+		if err != nil {
+			return stored, err
+		}
+
+		items, err := s.ingestWebhookEvent(ctx, platformName, event)
+		stored += items
+		if err != nil {
+			// Forget the delivery so the event is stored when the platform retries it
+			if err := s.repo.DeleteWebhookDelivery(ctx, delivery.ID); err != nil {
+				log.Printf("Error forgetting failed webhook delivery of %s event %s: %v", platformName, event.ID, err)
+			}
+			return stored, err
+		}
+
+		if err := s.repo.CompleteWebhookDelivery(ctx, delivery.ID, items); err != nil {
+			log.Printf("Error completing webhook delivery of %s event %s: %v", platformName, event.ID, err)
+		}
+	}
+
+	return stored, nil
+}
+
+// ingestWebhookEvent stores an event for each tenant tracking its account, attributed to the
+// tenant's job that prefers the event's kind. It returns the number of items stored.
+func (s *ScraperService) ingestWebhookEvent(ctx context.Context, platformName string, event WebhookEvent) (int, error) {
+	jobs, err := s.repo.GetScraperJobsByTarget(ctx, platformName, event.AccountIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	// Pick one job per tenant
+	chosen := make(map[string]*repository.ScraperJob)
+	rank := make(map[string]int)
+	for i := range jobs {
+		job := &jobs[i]
+		for preference, jobType := range webhookJobTypes[event.Kind] {
+			if job.JobType != jobType {
+				continue
+			}
+			if current, exists := rank[job.TenantID]; !exists || preference < current {
+				chosen[job.TenantID] = job
+				rank[job.TenantID] = preference
+			}
+		}
+	}
+
+	stored := 0
+	for _, job := range chosen {
+		var items []repository.ScrapedDataItem
+		switch event.Kind {
+		case WebhookEventPost:
+			items = postItems(job, []platform.Post{event.Post})
+		case WebhookEventComment:
+			items = commentItems(job, []platform.Comment{event.Comment})
+		case WebhookEventMention:
+			items = mentionItems(job, []platform.Post{event.Post})
+		}
+		for i := range items {
+			items[i].ContentAttributes["source"] = "webhook"
+		}
+
+		if changed := s.changedSnapshots(ctx, job, items); len(changed) > 0 {
+			s.captureMedia(ctx, job, changed)
+			saved, err := s.repo.SaveScrapedData(ctx, job.TenantID, changed)
+			stored += saved
+			if err != nil {
+				return stored, err
+			}
+		}
+
+		if comments := commentsFromItems(items); len(comments) > 0 {
+			if _, err := s.repo.SaveComments(ctx, job.TenantID, comments); err != nil {
+				return stored, err
+			}
+		}
+		if mentions := mentionsFromItems(job, items); len(mentions) > 0 {
+			if _, err := s.repo.SaveMentions(ctx, job.TenantID, mentions); err != nil {
+				return stored, err
+			}
+		}
+
+		// A failure here doesn't fail the event since the raw data has already been stored
+		if event.Kind == WebhookEventPost && s.normalizer != nil {
+			if _, err := s.normalizer.Normalize(ctx, job, items); err != nil {
+				log.Printf("Error normalizing webhook data for scraper job %s: %v", job.ID, err)
+			}
+		}
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestIngestWebhookEventsStoresConcurrentRedeliveriesOnce(t *testing.T) {
+	repo := newFakeRepository()
+	s := newTestService(t, repo)
+
+	event := WebhookEvent{ID: "1790000000000000001", Kind: WebhookEventPost, AccountIDs: []string{"acme"}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := s.IngestWebhookEvents(context.Background(), "twitter", []WebhookEvent{event}); err != nil {
+				t.Errorf("IngestWebhookEvents() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if repo.targetLookups != 1 {
+		t.Errorf("event was ingested %d times, want once", repo.targetLookups)
+	}
+}
+
+func TestIngestWebhookEventsRetriesFailedEvents(t *testing.T) {
+	repo := newFakeRepository()
+	repo.targetErr = errors.New("database unavailable")
+	s := newTestService(t, repo)
+
+	events := []WebhookEvent{{ID: "1790000000000000001", Kind: WebhookEventPost, AccountIDs: []string{"acme"}}}
+
+	if _, err := s.IngestWebhookEvents(context.Background(), "twitter", events); err == nil {
+		t.Fatal("IngestWebhookEvents() error = nil, want the storage error")
+	}
+	if _, err := s.IngestWebhookEvents(context.Background(), "twitter", events); err != nil {
+		t.Fatalf("IngestWebhookEvents() on redelivery error = %v", err)
+	}
+
+	if repo.targetLookups != 2 {
+		t.Errorf("event was ingested %d times, want it retried on redelivery", repo.targetLookups)
+	}
+	if len(repo.deliveries) != 1 {
+		t.Errorf("recorded deliveries = %v, want the redelivery", repo.deliveries)
+	}
+}
@@ -0,0 +1,224 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/service"
+)
+
+// MetaReceiver receives the webhooks of Meta's Graph API, which Facebook pages and Instagram
+// accounts share. Each platform is a separate app with its own secret.
+type MetaReceiver struct {
+	platform    string
+	appSecret   string
+	verifyToken string
+}
+
+// NewMetaReceiver creates a new MetaReceiver for "facebook" or "instagram"
+func NewMetaReceiver(platformName, appSecret, verifyToken string) *MetaReceiver {
+	return &MetaReceiver{platform: platformName, appSecret: appSecret, verifyToken: verifyToken}
+}
+
+// Challenge echoes hub.challenge when hub.verify_token matches the configured verify token
+func (m *MetaReceiver) Challenge(r *http.Request) ([]byte, string, error) {
+	query := r.URL.Query()
+	token := query.Get("hub.verify_token")
+	if query.Get("hub.mode") != "subscribe" || m.verifyToken == "" ||
+		subtle.ConstantTimeCompare([]byte(token), []byte(m.verifyToken)) != 1 {
+		return nil, "", ErrInvalidChallenge
+	}
+
+	return []byte(query.Get("hub.challenge")), "text/plain", nil
+}
+
+// Verify checks the X-Hub-Signature-256 header, a hex HMAC-SHA256 of the body keyed with the app secret
+func (m *MetaReceiver) Verify(header http.Header, body []byte) error {
+	signature, found := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+	if !found {
+		return ErrInvalidSignature
+	}
+
+	mac, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, sign(m.appSecret, body)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+type metaPayload struct {
+	Object string `json:"object"`
+	Entry  []struct {
+		ID      string `json:"id"` // Page or Instagram account the changes belong to
+		Time    int64  `json:"time"`
+		Changes []struct {
+			Field string          `json:"field"`
+			Value json.RawMessage `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+// facebookFeedChange is a change to a page's feed
+type facebookFeedChange struct {
+	Item        string `json:"item"`
+	Verb        string `json:"verb"`
+	PostID      string `json:"post_id"`
+	CommentID   string `json:"comment_id"`
+	ParentID    string `json:"parent_id"`
+	Message     string `json:"message"`
+	Photo       string `json:"photo"`
+	CreatedTime int64  `json:"created_time"`
+	From        struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"from"`
+}
+
+// instagramComment is a comment on an Instagram account's media
+type instagramComment struct {
+	ID       string `json:"id"`
+	ParentID string `json:"parent_id"`
+	Text     string `json:"text"`
+	From     struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+	} `json:"from"`
+	Media struct {
+		ID string `json:"id"`
+	} `json:"media"`
+}
+
+// Events parses the feed changes of Facebook pages and the comments on Instagram media.
+// Meta doesn't identify deliveries, so events are identified by the object, the change and its time.
+func (m *MetaReceiver) Events(body []byte) ([]service.WebhookEvent, error) {
+	var payload metaPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, err
+	}
+
+	var events []service.WebhookEvent
+	for _, entry := range payload.Entry {
+		for _, change := range entry.Changes {
+			var event *service.WebhookEvent
+			var err error
+
+			switch {
+			case payload.Object == "page" && change.Field == "feed":
+				event, err = facebookFeedEvent(change.Value)
+			case payload.Object == "instagram" && (change.Field == "comments" || change.Field == "live_comments"):
+				event, err = instagramCommentEvent(change.Value, unixTime(entry.Time))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s change: %w", change.Field, err)
+			}
+			if event == nil {
+				continue
+			}
+
+			event.ID = fmt.Sprintf("%s:%s:%d", change.Field, event.ID, entry.Time)
+			event.AccountIDs = []string{entry.ID}
+			events = append(events, *event)
+		}
+	}
+
+	return events, nil
+}
+
+// facebookFeedEvent converts a new or edited post or comment in a page's feed to an event.
+// Removals, reactions and other changes return nil.
+func facebookFeedEvent(value json.RawMessage) (*service.WebhookEvent, error) {
+	var change facebookFeedChange
+	if err := json.Unmarshal(value, &change); err != nil {
+		return nil, err
+	}
+	if change.Verb != "add" && change.Verb != "edited" {
+		return nil, nil
+	}
+
+	switch change.Item {
+	case "comment":
+		parentID := change.ParentID
+		if parentID == change.PostID {
+			parentID = ""
+		}
+		return &service.WebhookEvent{
+			ID:   change.CommentID + ":" + change.Verb,
+			Kind: service.WebhookEventComment,
+			Comment: platform.Comment{
+				ID:         change.CommentID,
+				PostID:     change.PostID,
+				ParentID:   parentID,
+				AuthorID:   change.From.ID,
+				AuthorName: change.From.Name,
+				Text:       change.Message,
+				PostedAt:   unixTime(change.CreatedTime),
+			},
+		}, nil
+
+	case "status", "post", "photo", "video", "share", "link":
+		contentType := "text"
+		switch change.Item {
+		case "photo":
+			contentType = "image"
+		case "video":
+			contentType = "video"
+		case "share", "link":
+			contentType = "link"
+		}
+		return &service.WebhookEvent{
+			ID:   change.PostID + ":" + change.Verb,
+			Kind: service.WebhookEventPost,
+			Post: platform.Post{
+				ID:          change.PostID,
+				AuthorID:    change.From.ID,
+				AuthorName:  change.From.Name,
+				URL:         "https://www.facebook.com/" + change.PostID,
+				Text:        change.Message,
+				ContentType: contentType,
+				MediaURL:    change.Photo,
+				PostedAt:    unixTime(change.CreatedTime),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// instagramCommentEvent converts a comment on an Instagram account's media to an event.
+// Instagram doesn't send when the comment was left, so the time of the change is used.
+func instagramCommentEvent(value json.RawMessage, changedAt time.Time) (*service.WebhookEvent, error) {
+	var comment instagramComment
+	if err := json.Unmarshal(value, &comment); err != nil {
+		return nil, err
+	}
+
+	return &service.WebhookEvent{
+		ID:   comment.ID,
+		Kind: service.WebhookEventComment,
+		Comment: platform.Comment{
+			ID:         comment.ID,
+			PostID:     comment.Media.ID,
+			ParentID:   comment.ParentID,
+			AuthorID:   comment.From.ID,
+			AuthorName: comment.From.Username,
+			Text:       comment.Text,
+			PostedAt:   changedAt,
+		},
+	}, nil
+}
+
+// unixTime converts a Meta timestamp, which is in seconds but sometimes in milliseconds, to a time
+func unixTime(value int64) time.Time {
+	if value > 1e12 {
+		return time.UnixMilli(value)
+	}
+	return time.Unix(value, 0)
+}
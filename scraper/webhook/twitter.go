@@ -0,0 +1,169 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/donaldnash/go-competitor/scraper/platform"
+	"github.com/donaldnash/go-competitor/scraper/service"
+)
+
+// TwitterReceiver receives the webhooks of Twitter's Account Activity API
+type TwitterReceiver struct {
+	consumerSecret string
+}
+
+// NewTwitterReceiver creates a new TwitterReceiver
+func NewTwitterReceiver(consumerSecret string) *TwitterReceiver {
+	return &TwitterReceiver{consumerSecret: consumerSecret}
+}
+
+// Challenge answers the challenge-response check (CRC) with the crc_token signed by the consumer secret
+func (t *TwitterReceiver) Challenge(r *http.Request) ([]byte, string, error) {
+	token := r.URL.Query().Get("crc_token")
+	if token == "" {
+		return nil, "", ErrInvalidChallenge
+	}
+
+	response, err := json.Marshal(map[string]string{
+		"response_token": "sha256=" + base64.StdEncoding.EncodeToString(sign(t.consumerSecret, []byte(token))),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response, "application/json", nil
+}
+
+// Verify checks the X-Twitter-Webhooks-Signature header, a base64 HMAC-SHA256 of the body keyed with the consumer secret
+func (t *TwitterReceiver) Verify(header http.Header, body []byte) error {
+	signature, found := strings.CutPrefix(header.Get("X-Twitter-Webhooks-Signature"), "sha256=")
+	if !found {
+		return ErrInvalidSignature
+	}
+
+	mac, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, sign(t.consumerSecret, body)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+type twitterActivity struct {
+	ForUserID         string                 `json:"for_user_id"` // Subscribed account the activity belongs to
+	TweetCreateEvents []twitterActivityTweet `json:"tweet_create_events"`
+}
+
+// twitterActivityTweet is a tweet in the v1.1 format the Account Activity API sends
+type twitterActivityTweet struct {
+	ID            string `json:"id_str"`
+	CreatedAt     string `json:"created_at"`
+	Text          string `json:"text"`
+	ExtendedTweet struct {
+		FullText string `json:"full_text"`
+	} `json:"extended_tweet"`
+	InReplyToStatusID string          `json:"in_reply_to_status_id_str"`
+	InReplyToUserID   string          `json:"in_reply_to_user_id_str"`
+	RetweetedStatus   json.RawMessage `json:"retweeted_status"`
+	RetweetCount      int             `json:"retweet_count"`
+	FavoriteCount     int             `json:"favorite_count"`
+	ReplyCount        int             `json:"reply_count"`
+	User              struct {
+		ID         string `json:"id_str"`
+		ScreenName string `json:"screen_name"`
+		Name       string `json:"name"`
+	} `json:"user"`
+	Entities struct {
+		UserMentions []struct {
+			ID         string `json:"id_str"`
+			ScreenName string `json:"screen_name"`
+		} `json:"user_mentions"`
+	} `json:"entities"`
+}
+
+// text returns the full text of a tweet, which longer tweets only carry in extended_tweet
+func (t twitterActivityTweet) text() string {
+	if t.ExtendedTweet.FullText != "" {
+		return t.ExtendedTweet.FullText
+	}
+	return t.Text
+}
+
+// toPost converts a tweet to a post
+func (t twitterActivityTweet) toPost() platform.Post {
+	postedAt, _ := time.Parse(time.RubyDate, t.CreatedAt)
+	return platform.Post{
+		ID:          t.ID,
+		AuthorID:    t.User.ID,
+		AuthorName:  t.User.ScreenName,
+		URL:         "https://x.com/i/web/status/" + t.ID,
+		Text:        t.text(),
+		ContentType: "text",
+		PostedAt:    postedAt,
+		Likes:       t.FavoriteCount,
+		Shares:      t.RetweetCount,
+		Comments:    t.ReplyCount,
+	}
+}
+
+// Events parses the tweets in an activity. Tweets by the subscribed account are posts, replies to
+// it are comments and other tweets mentioning it are mentions. Retweets by the account are left out.
+func (t *TwitterReceiver) Events(body []byte) ([]service.WebhookEvent, error) {
+	var activity twitterActivity
+	if err := json.Unmarshal(body, &activity); err != nil {
+		return nil, err
+	}
+
+	var events []service.WebhookEvent
+	for _, tweet := range activity.TweetCreateEvents {
+		event := service.WebhookEvent{ID: tweet.ID}
+
+		// Jobs track accounts by ID or username, so match both
+		accountIDs := []string{activity.ForUserID}
+		if tweet.User.ID == activity.ForUserID {
+			accountIDs = append(accountIDs, tweet.User.ScreenName)
+		}
+		for _, mention := range tweet.Entities.UserMentions {
+			if mention.ID == activity.ForUserID {
+				accountIDs = append(accountIDs, mention.ScreenName)
+				break
+			}
+		}
+		event.AccountIDs = accountIDs
+
+		switch {
+		case tweet.User.ID == activity.ForUserID:
+			if len(tweet.RetweetedStatus) > 0 {
+				continue
+			}
+			event.Kind = service.WebhookEventPost
+			event.Post = tweet.toPost()
+
+		case tweet.InReplyToUserID == activity.ForUserID && tweet.InReplyToStatusID != "":
+			post := tweet.toPost()
+			event.Kind = service.WebhookEventComment
+			event.Comment = platform.Comment{
+				ID:         tweet.ID,
+				PostID:     tweet.InReplyToStatusID,
+				AuthorID:   tweet.User.ID,
+				AuthorName: tweet.User.ScreenName,
+				Text:       post.Text,
+				Likes:      post.Likes,
+				PostedAt:   post.PostedAt,
+			}
+
+		default:
+			event.Kind = service.WebhookEventMention
+			event.Post = tweet.toPost()
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,161 @@
+// Package webhook receives the events platforms push by webhook. Deliveries are authenticated with
+// the HMAC signature each platform adds, parsed into posts, comments and mentions, and handed to the
+// scraper service to store for the tenants tracking the account they belong to.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/donaldnash/go-competitor/scraper/service"
+)
+
+// maxPayloadSize caps how much of a delivery is read
+const maxPayloadSize = 1 << 20
+
+// ErrInvalidSignature is returned when a delivery isn't signed with the platform's secret
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// ErrInvalidChallenge is returned when a verification request isn't one or carries the wrong token
+var ErrInvalidChallenge = errors.New("invalid verification challenge")
+
+// Ingester stores the events received by webhook, usually a *service.ScraperService
+type Ingester interface {
+	IngestWebhookEvents(ctx context.Context, platform string, events []service.WebhookEvent) (int, error)
+}
+
+// Receiver authenticates and parses the webhooks of one platform
+type Receiver interface {
+	// Challenge answers the request a platform sends to verify the webhook URL, returning
+	// the response body and its content type
+	Challenge(r *http.Request) ([]byte, string, error)
+
+	// Verify checks that a delivery was signed with the platform's secret
+	Verify(header http.Header, body []byte) error
+
+	// Events parses a delivery into events. Changes that aren't posts, comments or mentions are left out.
+	Events(body []byte) ([]service.WebhookEvent, error)
+}
+
+// Config contains the secrets webhooks are verified with. A platform's webhooks are only
+// accepted once its secret is set.
+type Config struct {
+	FacebookAppSecret     string
+	InstagramAppSecret    string
+	TwitterConsumerSecret string
+	VerifyToken           string // Token Meta sends back when it verifies the webhook URL
+}
+
+// ConfigFromEnv builds a Config from the environment
+func ConfigFromEnv() Config {
+	return Config{
+		FacebookAppSecret:     os.Getenv("FACEBOOK_APP_SECRET"),
+		InstagramAppSecret:    os.Getenv("INSTAGRAM_APP_SECRET"),
+		TwitterConsumerSecret: os.Getenv("TWITTER_CONSUMER_SECRET"),
+		VerifyToken:           os.Getenv("WEBHOOK_VERIFY_TOKEN"),
+	}
+}
+
+// Handler serves the webhook of each platform with a secret under /webhooks/<platform>.
+// GET requests answer verification challenges and POST requests deliver events.
+type Handler struct {
+	ingester  Ingester
+	receivers map[string]Receiver
+}
+
+// NewHandler creates a Handler for the platforms whose secrets are set in cfg
+func NewHandler(ingester Ingester, cfg Config) *Handler {
+	receivers := make(map[string]Receiver)
+	if cfg.FacebookAppSecret != "" {
+		receivers["facebook"] = NewMetaReceiver("facebook", cfg.FacebookAppSecret, cfg.VerifyToken)
+	}
+	if cfg.InstagramAppSecret != "" {
+		receivers["instagram"] = NewMetaReceiver("instagram", cfg.InstagramAppSecret, cfg.VerifyToken)
+	}
+	if cfg.TwitterConsumerSecret != "" {
+		receivers["twitter"] = NewTwitterReceiver(cfg.TwitterConsumerSecret)
+	}
+
+	return &Handler{ingester: ingester, receivers: receivers}
+}
+
+// ServeHTTP implements http.Handler
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := strings.Trim(strings.TrimPrefix(r.URL.Path, "/webhooks/"), "/")
+	receiver, exists := h.receivers[name]
+	if !exists {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.challenge(w, r, receiver)
+	case http.MethodPost:
+		h.deliver(w, r, name, receiver)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// challenge answers a platform's verification request
+func (h *Handler) challenge(w http.ResponseWriter, r *http.Request, receiver Receiver) {
+	body, contentType, err := receiver.Challenge(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// deliver verifies a delivery and stores its events. Platforms redeliver events that fail
+// with a server error, so only failures to store them get one.
+func (h *Handler) deliver(w http.ResponseWriter, r *http.Request, name string, receiver Receiver) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := receiver.Verify(r.Header, body); err != nil {
+		log.Printf("Rejected %s webhook delivery: %v", name, err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	events, err := receiver.Events(body)
+	if err != nil {
+		log.Printf("Failed to parse %s webhook delivery: %v", name, err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	stored, err := h.ingester.IngestWebhookEvents(r.Context(), name, events)
+	if err != nil {
+		log.Printf("Failed to store %s webhook events: %v", name, err)
+		http.Error(w, "failed to store events", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{"events": len(events), "stored": stored})
+}
+
+// sign returns the HMAC-SHA256 of a message
+func sign(secret string, message []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(message)
+	return mac.Sum(nil)
+}